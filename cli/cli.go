@@ -3,8 +3,10 @@ package cli
 import (
 	"flag"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
@@ -26,6 +28,10 @@ type Flags struct {
 	targetIds          targetIds
 	parallel           bool
 	force              bool
+	dryRun             bool
+	repair             bool
+	sample             int64
+	distributed        bool
 }
 
 type targetIds []int64
@@ -57,8 +63,12 @@ func (c *Flags) Setup() {
 	flag.Int64Var(&c.batchSize, "batch_size", 0, "pipeline batch size")
 	flag.BoolVar(&c.parallel, "parallel", false, "should backfill be run in parallel with indexing")
 	flag.BoolVar(&c.force, "force", false, "remove existing reindexing reports")
-	flag.Int64Var(&c.startReindexHeight, "start_height", 0, "start height for reindex cmd")
-	flag.Int64Var(&c.endReindexHeight, "end_height", 0, "end height for reindex cmd")
+	flag.Int64Var(&c.startReindexHeight, "start_height", 0, "start height for reindex, verify, audit, cache warm and record cmd")
+	flag.Int64Var(&c.endReindexHeight, "end_height", 0, "end height for reindex, rollback, verify, audit, cache warm and record cmd")
+	flag.BoolVar(&c.dryRun, "dry_run", false, "only print number of rows affected by rollback cmd")
+	flag.BoolVar(&c.repair, "repair", false, "reindex heights found by verify cmd")
+	flag.Int64Var(&c.sample, "sample", 0, "number of random heights checked by audit cmd, all heights when 0")
+	flag.BoolVar(&c.distributed, "distributed", false, "split reindex into jobs processed by workers")
 	flag.Var(&c.targetIds, "target_ids", "comma separated list of integers")
 
 }
@@ -113,6 +123,7 @@ func startCommand(cfg *config.Config, flags Flags) error {
 func terminate(err error) {
 	if err != nil {
 		logger.Error(err)
+		os.Exit(1)
 	}
 }
 
@@ -141,7 +152,53 @@ func initLogger(cfg *config.Config) error {
 }
 
 func initClient(cfg *config.Config) (*client.Client, error) {
-	return client.New(cfg.ProxyUrl)
+	if cfg.ProxyReplayFile != "" {
+		bundle, err := client.LoadBundle(cfg.ProxyReplayFile)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("using responses recorded in %s instead of proxy [start=%d] [end=%d]", cfg.ProxyReplayFile, bundle.StartHeight, bundle.EndHeight))
+		return client.NewReplayClient(bundle), nil
+	}
+
+	timeouts, err := proxyTimeouts(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewWithOptions(client.Options{
+		Urls:     cfg.ProxyUrlList(),
+		Quorum:   cfg.ProxyQuorum,
+		Timeouts: timeouts,
+		TLS: client.TLSOptions{
+			Enabled:  cfg.ProxyTLS || cfg.ProxyTLSCAFile != "",
+			CAFile:   cfg.ProxyTLSCAFile,
+			CertFile: cfg.ProxyTLSCertFile,
+			KeyFile:  cfg.ProxyTLSKeyFile,
+		},
+	})
+}
+
+// proxyTimeouts parses timeouts of proxy requests from config
+func proxyTimeouts(cfg *config.Config) (client.Timeouts, error) {
+	timeouts := client.Timeouts{Methods: map[string]time.Duration{}}
+
+	if cfg.ProxyTimeout != "" {
+		timeout, err := time.ParseDuration(cfg.ProxyTimeout)
+		if err != nil {
+			return timeouts, err
+		}
+		timeouts.Default = timeout
+	}
+
+	for method, value := range cfg.ProxyMethodTimeouts {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return timeouts, errors.Wrapf(err, "invalid timeout of %s", method)
+		}
+		timeouts.Methods[method] = timeout
+	}
+	return timeouts, nil
 }
 
 func initStore(cfg *config.Config) (*store.Store, error) {
@@ -32,10 +32,22 @@ func runCmd(cfg *config.Config, flags Flags) error {
 		cmdHandlers.GetStatus.Handle(ctx)
 	case "indexer:index":
 		cmdHandlers.IndexerIndex.Handle(ctx, flags.batchSize)
+	case "indexer:follow":
+		return cmdHandlers.IndexerFollow.Handle(ctx)
 	case "indexer:backfill":
 		cmdHandlers.IndexerBackfill.Handle(ctx, flags.parallel, flags.force)
 	case "indexer:reindex":
-		cmdHandlers.IndexerReindex.Handle(ctx, flags.parallel, flags.startReindexHeight, flags.endReindexHeight, flags.targetIds)
+		cmdHandlers.IndexerReindex.Handle(ctx, flags.parallel, flags.startReindexHeight, flags.endReindexHeight, flags.targetIds, flags.distributed)
+	case "indexer:cache:warm":
+		cmdHandlers.IndexerCacheWarm.Handle(ctx, flags.startReindexHeight, flags.endReindexHeight)
+	case "indexer:record":
+		cmdHandlers.IndexerRecord.Handle(ctx, flags.startReindexHeight, flags.endReindexHeight, flags.filePath)
+	case "indexer:rollback":
+		return cmdHandlers.IndexerRollback.Handle(ctx, flags.endReindexHeight, flags.dryRun)
+	case "indexer:verify":
+		cmdHandlers.IndexerVerify.Handle(ctx, flags.startReindexHeight, flags.endReindexHeight, flags.repair)
+	case "indexer:audit":
+		return cmdHandlers.IndexerAudit.Handle(ctx, flags.startReindexHeight, flags.endReindexHeight, flags.sample, flags.filePath)
 	case "indexer:summarize":
 		cmdHandlers.IndexerSummarize.Handle(ctx)
 	case "indexer:purge":
@@ -1,6 +1,11 @@
 package cli
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/server"
 	"github.com/figment-networks/oasishub-indexer/usecase"
@@ -18,10 +23,26 @@ func startServer(cfg *config.Config) error {
 	}
 	defer db.Close()
 
-	httpHandlers := usecase.NewHttpHandlers(cfg, db, client)
+	// Stop server and long running handlers on interrupt
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	httpHandlers := usecase.NewHttpHandlers(ctx, cfg, db, client)
 
 	a := server.New(cfg, httpHandlers)
-	if err := a.Start(cfg.ListenAddr()); err != nil {
+	if err := a.Start(ctx, cfg.ListenAddr()); err != nil {
 		return err
 	}
 	return nil
@@ -26,7 +26,7 @@ func startWorker(cfg *config.Config) error {
 
 	workerHandlers := usecase.NewWorkerHandlers(cfg, db, client)
 
-	w, err := worker.New(cfg, workerHandlers)
+	w, err := worker.New(cfg, workerHandlers, db.NewAdvisoryLock(cfg.WorkerLeaderLockKey))
 	if err != nil {
 		return err
 	}
@@ -11,21 +11,25 @@ var (
 )
 
 type AccountClient interface {
-	GetByAddress(string, int64) (*accountpb.GetByAddressResponse, error)
+	GetByAddress(context.Context, string, int64) (*accountpb.GetByAddressResponse, error)
 }
 
-func NewAccountClient(conn *grpc.ClientConn) *accountClient {
+func NewAccountClient(conn *grpc.ClientConn, timeouts Timeouts) *accountClient {
 	return &accountClient{
-		client: accountpb.NewAccountServiceClient(conn),
+		client:   accountpb.NewAccountServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type accountClient struct {
-	client accountpb.AccountServiceClient
+	client   accountpb.AccountServiceClient
+	timeouts Timeouts
 }
 
-func (r *accountClient) GetByAddress(address string, height int64) (*accountpb.GetByAddressResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByAddress(ctx, &accountpb.GetByAddressRequest{Address: address, Height: height})
-}
\ No newline at end of file
+func (r *accountClient) GetByAddress(ctx context.Context, address string, height int64) (res *accountpb.GetByAddressResponse, err error) {
+	err = call(ctx, r.timeouts, "Account.GetByAddress", func(ctx context.Context) error {
+		res, err = r.client.GetByAddress(ctx, &accountpb.GetByAddressRequest{Address: address, Height: height})
+		return err
+	})
+	return
+}
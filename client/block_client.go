@@ -12,21 +12,25 @@ var (
 )
 
 type BlockClient interface {
-	GetByHeight(int64) (*blockpb.GetByHeightResponse, error)
+	GetByHeight(context.Context, int64) (*blockpb.GetByHeightResponse, error)
 }
 
-func NewBlockClient(conn *grpc.ClientConn) *blockClient {
+func NewBlockClient(conn *grpc.ClientConn, timeouts Timeouts) *blockClient {
 	return &blockClient{
-		client: blockpb.NewBlockServiceClient(conn),
+		client:   blockpb.NewBlockServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type blockClient struct {
-	client blockpb.BlockServiceClient
+	client   blockpb.BlockServiceClient
+	timeouts Timeouts
 }
 
-func (r *blockClient) GetByHeight(h int64) (*blockpb.GetByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByHeight(ctx, &blockpb.GetByHeightRequest{Height: h})
+func (r *blockClient) GetByHeight(ctx context.Context, h int64) (res *blockpb.GetByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Block.GetByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetByHeight(ctx, &blockpb.GetByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+const (
+	methodChainGetHead                    = "Chain.GetHead"
+	methodChainGetStatus                  = "Chain.GetStatus"
+	methodChainGetMetaByHeight            = "Chain.GetMetaByHeight"
+	methodChainGetConstants               = "Chain.GetConstants"
+	methodAccountGetByAddress             = "Account.GetByAddress"
+	methodBlockGetByHeight                = "Block.GetByHeight"
+	methodEventGetEscrowEventsByHeight    = "Event.GetEscrowEventsByHeight"
+	methodEventGetTransferEventsByHeight  = "Event.GetTransferEventsByHeight"
+	methodStateGetByHeight                = "State.GetByHeight"
+	methodStateGetStakingByHeight         = "State.GetStakingByHeight"
+	methodValidatorGetByHeight            = "Validator.GetByHeight"
+	methodTransactionGetByHeight          = "Transaction.GetByHeight"
+	methodDelegationGetByAddress          = "Delegation.GetByAddress"
+	methodDebondingDelegationGetByAddress = "DebondingDelegation.GetByAddress"
+)
+
+var (
+	ErrNotRecorded = errors.New("response not recorded in bundle")
+)
+
+// NewBundle creates empty bundle for responses of given height range
+func NewBundle(startHeight int64, endHeight int64) *Bundle {
+	return &Bundle{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Responses:   map[string][]byte{},
+	}
+}
+
+// LoadBundle reads bundle saved in gzipped JSON file
+func LoadBundle(path string) (*Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var bundle Bundle
+	if err := json.NewDecoder(r).Decode(&bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Responses == nil {
+		bundle.Responses = map[string][]byte{}
+	}
+	return &bundle, nil
+}
+
+// Bundle holds recorded proxy responses keyed by request method and its arguments
+type Bundle struct {
+	StartHeight int64             `json:"start_height"`
+	EndHeight   int64             `json:"end_height"`
+	Responses   map[string][]byte `json:"responses"`
+
+	mu sync.RWMutex
+}
+
+// Save writes bundle to gzipped JSON file
+func (b *Bundle) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := gzip.NewWriter(f)
+
+	b.mu.RLock()
+	err = json.NewEncoder(w).Encode(b)
+	b.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// Len returns number of recorded responses
+func (b *Bundle) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.Responses)
+}
+
+func (b *Bundle) get(key string, msg proto.Message) error {
+	b.mu.RLock()
+	data, ok := b.Responses[key]
+	b.mu.RUnlock()
+	if !ok {
+		return errors.Wrap(ErrNotRecorded, key)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+func (b *Bundle) put(key string, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	b.Responses[key] = data
+	b.mu.Unlock()
+	return nil
+}
+
+// bundleKey returns key of request with given arguments
+func bundleKey(method string, args ...interface{}) string {
+	key := method
+	for _, arg := range args {
+		key += fmt.Sprintf(":%v", arg)
+	}
+	return key
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/pkg/errors"
+)
+
+func TestBundle_SaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bundle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	bundle := NewBundle(10, 20)
+	if err := bundle.put(bundleKey(methodBlockGetByHeight, 10), &timestamp.Timestamp{Seconds: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "bundle.json.gz")
+	if err := bundle.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadBundle(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if loaded.StartHeight != 10 || loaded.EndHeight != 20 {
+		t.Errorf("unexpected height range, want [10, 20]; got [%d, %d]", loaded.StartHeight, loaded.EndHeight)
+	}
+
+	var msg timestamp.Timestamp
+	if err := loaded.get(bundleKey(methodBlockGetByHeight, 10), &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Seconds != 100 {
+		t.Errorf("unexpected response, want %v; got %v", 100, msg.Seconds)
+	}
+
+	if err := loaded.get(bundleKey(methodBlockGetByHeight, 11), &msg); errors.Cause(err) != ErrNotRecorded {
+		t.Errorf("unexpected error, want %v; got %v", ErrNotRecorded, err)
+	}
+}
+
+func TestReplayClient(t *testing.T) {
+	c := NewReplayClient(NewBundle(10, 20))
+
+	head, err := c.Chain.GetHead(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head.GetHeight() != 20 {
+		t.Errorf("unexpected head, want %v; got %v", 20, head.GetHeight())
+	}
+
+	if _, err := c.Block.GetByHeight(context.Background(), 21); errors.Cause(err) != ErrNotRecorded {
+		t.Errorf("unexpected error, want %v; got %v", ErrNotRecorded, err)
+	}
+
+	if _, err := c.Transaction.Broadcast(context.Background(), "tx"); err != ErrReplayBroadcast {
+		t.Errorf("unexpected error, want %v; got %v", ErrReplayBroadcast, err)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	CacheKindMeta           = "meta"
+	CacheKindBlock          = "block"
+	CacheKindState          = "state"
+	CacheKindStakingState   = "staking_state"
+	CacheKindValidators     = "validators"
+	CacheKindTransactions   = "transactions"
+	CacheKindEscrowEvents   = "escrow_events"
+	CacheKindTransferEvents = "transfer_events"
+)
+
+var (
+	_ ChainClient       = (*cachedChainClient)(nil)
+	_ BlockClient       = (*cachedBlockClient)(nil)
+	_ StateClient       = (*cachedStateClient)(nil)
+	_ ValidatorClient   = (*cachedValidatorClient)(nil)
+	_ TransactionClient = (*cachedTransactionClient)(nil)
+	_ EventClient       = (*cachedEventClient)(nil)
+)
+
+// NewCachedClient returns client which reads responses for a given height from disk cache first
+// and stores responses fetched from proxy in it. Requests not bound to a height are not cached.
+func NewCachedClient(c *Client, cache *DiskCache) *Client {
+	return &Client{
+		conn:    c.conn,
+		proxies: c.proxies,
+
+		Account:             c.Account,
+		Chain:               &cachedChainClient{ChainClient: c.Chain, cache: cache},
+		Block:               &cachedBlockClient{client: c.Block, cache: cache},
+		Event:               &cachedEventClient{client: c.Event, cache: cache},
+		State:               &cachedStateClient{client: c.State, cache: cache},
+		Validator:           &cachedValidatorClient{client: c.Validator, cache: cache},
+		Transaction:         &cachedTransactionClient{TransactionClient: c.Transaction, cache: cache},
+		Delegation:          c.Delegation,
+		DebondingDelegation: c.DebondingDelegation,
+	}
+}
+
+// getCached reads response from cache, falling back to fetch and storing its result
+func getCached(cache *DiskCache, kind string, height int64, msg proto.Message, fetch func() (proto.Message, error)) (proto.Message, error) {
+	if cache.Get(kind, height, msg) {
+		return msg, nil
+	}
+
+	res, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Put(kind, height, res); err != nil {
+		// Response is still valid, failing to cache it should not stop indexing
+		logger.Error(fmt.Errorf("could not cache %s response [height=%d]: %w", kind, height, err))
+	}
+	return res, nil
+}
+
+type cachedChainClient struct {
+	ChainClient
+
+	cache *DiskCache
+}
+
+func (r *cachedChainClient) GetMetaByHeight(ctx context.Context, h int64) (*chainpb.GetMetaByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindMeta, h, &chainpb.GetMetaByHeightResponse{}, func() (proto.Message, error) {
+		return r.ChainClient.GetMetaByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*chainpb.GetMetaByHeightResponse), nil
+}
+
+type cachedBlockClient struct {
+	client BlockClient
+	cache  *DiskCache
+}
+
+func (r *cachedBlockClient) GetByHeight(ctx context.Context, h int64) (*blockpb.GetByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindBlock, h, &blockpb.GetByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*blockpb.GetByHeightResponse), nil
+}
+
+type cachedStateClient struct {
+	client StateClient
+	cache  *DiskCache
+}
+
+func (r *cachedStateClient) GetByHeight(ctx context.Context, h int64) (*statepb.GetByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindState, h, &statepb.GetByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*statepb.GetByHeightResponse), nil
+}
+
+func (r *cachedStateClient) GetStakingByHeight(ctx context.Context, h int64) (*statepb.GetStakingByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindStakingState, h, &statepb.GetStakingByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetStakingByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*statepb.GetStakingByHeightResponse), nil
+}
+
+type cachedValidatorClient struct {
+	client ValidatorClient
+	cache  *DiskCache
+}
+
+func (r *cachedValidatorClient) GetByHeight(ctx context.Context, h int64) (*validatorpb.GetByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindValidators, h, &validatorpb.GetByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*validatorpb.GetByHeightResponse), nil
+}
+
+type cachedTransactionClient struct {
+	TransactionClient
+
+	cache *DiskCache
+}
+
+func (r *cachedTransactionClient) GetByHeight(ctx context.Context, h int64) (*transactionpb.GetByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindTransactions, h, &transactionpb.GetByHeightResponse{}, func() (proto.Message, error) {
+		return r.TransactionClient.GetByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*transactionpb.GetByHeightResponse), nil
+}
+
+type cachedEventClient struct {
+	client EventClient
+	cache  *DiskCache
+}
+
+func (r *cachedEventClient) GetEscrowEventsByHeight(ctx context.Context, h int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindEscrowEvents, h, &eventpb.GetEscrowEventsByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetEscrowEventsByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*eventpb.GetEscrowEventsByHeightResponse), nil
+}
+
+func (r *cachedEventClient) GetTransferEventsByHeight(ctx context.Context, h int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
+	res, err := getCached(r.cache, CacheKindTransferEvents, h, &eventpb.GetTransferEventsByHeightResponse{}, func() (proto.Message, error) {
+		return r.client.GetTransferEventsByHeight(ctx, h)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*eventpb.GetTransferEventsByHeightResponse), nil
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	proxyRequestDuration = metrics.MustNewHistogramWithTags(metrics.HistogramOptions{
+		Namespace: "indexers",
+		Subsystem: "oasishub_proxy",
+		Name:      "request_duration",
+		Desc:      "The total time required to execute request to proxy",
+		Tags:      []string{"method", "code"},
+	})
+
+	proxyRequestErrors = metrics.MustNewCounterWithTags(metrics.Options{
+		Namespace: "indexers",
+		Subsystem: "oasishub_proxy",
+		Name:      "request_errors",
+		Desc:      "The number of failed requests to proxy",
+		Tags:      []string{"method", "code"},
+	})
+)
+
+// Timeouts holds deadlines of requests to proxy
+type Timeouts struct {
+	// Default is used for methods without own timeout, zero means no deadline
+	Default time.Duration
+	// Methods holds timeouts keyed by method name, for example "Block.GetByHeight"
+	Methods map[string]time.Duration
+}
+
+func (t Timeouts) get(method string) time.Duration {
+	if timeout, ok := t.Methods[method]; ok {
+		return timeout
+	}
+	return t.Default
+}
+
+// call executes request with deadline configured for method and records its duration and status code
+func call(ctx context.Context, timeouts Timeouts, method string, fn func(ctx context.Context) error) error {
+	if timeout := timeouts.get(method); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := fn(ctx)
+	code := status.Code(err).String()
+
+	proxyRequestDuration.WithLabels(method, code).Observe(time.Since(start).Seconds())
+	if err != nil {
+		proxyRequestErrors.WithLabels(method, code).Inc()
+	}
+	return err
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCall_Timeouts(t *testing.T) {
+	timeouts := Timeouts{
+		Default: time.Minute,
+		Methods: map[string]time.Duration{"Block.GetByHeight": time.Second, "Transaction.Broadcast": 0},
+	}
+
+	tests := []struct {
+		description string
+		method      string
+		expected    time.Duration
+	}{
+		{"uses default timeout", "State.GetByHeight", time.Minute},
+		{"uses method timeout", "Block.GetByHeight", time.Second},
+		{"has no deadline when method timeout is 0", "Transaction.Broadcast", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := call(context.Background(), timeouts, tt.method, func(ctx context.Context) error {
+				deadline, ok := ctx.Deadline()
+				if tt.expected == 0 {
+					if ok {
+						t.Errorf("unexpected deadline %v", deadline)
+					}
+					return nil
+				}
+
+				if !ok {
+					t.Fatal("expected deadline to be set")
+				}
+				if remaining := time.Until(deadline); remaining > tt.expected || remaining < tt.expected-time.Second {
+					t.Errorf("unexpected timeout, want %v; got %v", tt.expected, remaining)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCall_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := call(ctx, Timeouts{Default: time.Minute}, "Chain.GetHead", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if err != context.Canceled {
+		t.Errorf("unexpected error, want %v; got %v", context.Canceled, err)
+	}
+}
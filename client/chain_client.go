@@ -13,42 +13,52 @@ var (
 
 type ChainClient interface {
 	//Queries
-	GetHead() (*chainpb.GetHeadResponse, error)
-	GetStatus() (*chainpb.GetStatusResponse, error)
-	GetMetaByHeight(int64) (*chainpb.GetMetaByHeightResponse, error)
-	GetConstants() (*chainpb.GetConstantsResponse, error)
+	GetHead(context.Context) (*chainpb.GetHeadResponse, error)
+	GetStatus(context.Context) (*chainpb.GetStatusResponse, error)
+	GetMetaByHeight(context.Context, int64) (*chainpb.GetMetaByHeightResponse, error)
+	GetConstants(context.Context) (*chainpb.GetConstantsResponse, error)
 }
 
-func NewChainClient(conn *grpc.ClientConn) *chainClient {
+func NewChainClient(conn *grpc.ClientConn, timeouts Timeouts) *chainClient {
 	return &chainClient{
-		client: chainpb.NewChainServiceClient(conn),
+		client:   chainpb.NewChainServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type chainClient struct {
-	client chainpb.ChainServiceClient
+	client   chainpb.ChainServiceClient
+	timeouts Timeouts
 }
 
-func (r *chainClient) GetHead() (*chainpb.GetHeadResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetHead(ctx, &chainpb.GetHeadRequest{})
+func (r *chainClient) GetHead(ctx context.Context) (res *chainpb.GetHeadResponse, err error) {
+	err = call(ctx, r.timeouts, "Chain.GetHead", func(ctx context.Context) error {
+		res, err = r.client.GetHead(ctx, &chainpb.GetHeadRequest{})
+		return err
+	})
+	return
 }
 
-func (r *chainClient) GetStatus() (*chainpb.GetStatusResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetStatus(ctx, &chainpb.GetStatusRequest{})
+func (r *chainClient) GetStatus(ctx context.Context) (res *chainpb.GetStatusResponse, err error) {
+	err = call(ctx, r.timeouts, "Chain.GetStatus", func(ctx context.Context) error {
+		res, err = r.client.GetStatus(ctx, &chainpb.GetStatusRequest{})
+		return err
+	})
+	return
 }
 
-func (r *chainClient) GetMetaByHeight(h int64) (*chainpb.GetMetaByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetMetaByHeight(ctx, &chainpb.GetMetaByHeightRequest{Height: h})
+func (r *chainClient) GetMetaByHeight(ctx context.Context, h int64) (res *chainpb.GetMetaByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Chain.GetMetaByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetMetaByHeight(ctx, &chainpb.GetMetaByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
 
-func (r *chainClient) GetConstants() (*chainpb.GetConstantsResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetConstants(ctx, &chainpb.GetConstantsRequest{})
+func (r *chainClient) GetConstants(ctx context.Context) (res *chainpb.GetConstantsResponse, err error) {
+	err = call(ctx, r.timeouts, "Chain.GetConstants", func(ctx context.Context) error {
+		res, err = r.client.GetConstants(ctx, &chainpb.GetConstantsRequest{})
+		return err
+	})
+	return
 }
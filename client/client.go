@@ -1,32 +1,86 @@
 package client
 
 import (
+	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 )
 
+var (
+	ErrProxyUrlsRequired = errors.New("at least one proxy url is required")
+	ErrQuorumProxies     = errors.New("quorum mode requires at least two proxy urls")
+)
+
+// Options holds settings of client connecting to one or more proxies
+type Options struct {
+	Urls []string
+	// Quorum makes block requests go to two proxies and fail when their responses differ
+	Quorum   bool
+	TLS      TLSOptions
+	Timeouts Timeouts
+}
+
 func New(connStr string) (*Client, error) {
 	conn, err := grpc.Dial(connStr, grpc.WithInsecure())
 	if err != nil {
 		return nil, err
 	}
 
+	return newClient(conn, Timeouts{}), nil
+}
+
+// NewWithOptions creates client for given proxies. With more than one proxy, read requests are spread
+// across healthy proxies in round-robin and fail over to next proxy when a proxy is unavailable.
+func NewWithOptions(opts Options) (*Client, error) {
+	if len(opts.Urls) == 0 {
+		return nil, ErrProxyUrlsRequired
+	}
+	if opts.Quorum && len(opts.Urls) < 2 {
+		return nil, ErrQuorumProxies
+	}
+
+	dialOption, err := opts.TLS.dialOption()
+	if err != nil {
+		return nil, err
+	}
+
+	var proxies []*proxy
+	for _, url := range opts.Urls {
+		conn, err := grpc.Dial(url, dialOption)
+		if err != nil {
+			for _, p := range proxies {
+				p.client.Close()
+			}
+			return nil, err
+		}
+		proxies = append(proxies, &proxy{url: url, conn: conn, client: newClient(conn, opts.Timeouts)})
+	}
+
+	if len(proxies) == 1 {
+		return proxies[0].client, nil
+	}
+	return newMultiClient(proxies, opts.Quorum), nil
+}
+
+func newClient(conn *grpc.ClientConn, timeouts Timeouts) *Client {
 	return &Client{
 		conn: conn,
 
-		Account:             NewAccountClient(conn),
-		Chain:               NewChainClient(conn),
-		Block:               NewBlockClient(conn),
-		Event:               NewEventClient(conn),
-		State:               NewStateClient(conn),
-		Validator:           NewValidatorClient(conn),
-		Transaction:         NewTransactionClient(conn),
-		Delegation:          NewDelegationClient(conn),
-		DebondingDelegation: NewDebondingDelegationClient(conn),
-	}, nil
+		Account:             NewAccountClient(conn, timeouts),
+		Chain:               NewChainClient(conn, timeouts),
+		Block:               NewBlockClient(conn, timeouts),
+		Event:               NewEventClient(conn, timeouts),
+		State:               NewStateClient(conn, timeouts),
+		Validator:           NewValidatorClient(conn, timeouts),
+		Transaction:         NewTransactionClient(conn, timeouts),
+		Delegation:          NewDelegationClient(conn, timeouts),
+		DebondingDelegation: NewDebondingDelegationClient(conn, timeouts),
+	}
 }
 
 type Client struct {
 	conn *grpc.ClientConn
+	// proxies holds clients of all proxies used by multi-proxy client
+	proxies []*proxy
 
 	Account             AccountClient
 	Chain               ChainClient
@@ -40,5 +94,15 @@ type Client struct {
 }
 
 func (c *Client) Close() error {
+	for _, p := range c.proxies {
+		if err := p.client.Close(); err != nil {
+			return err
+		}
+	}
+
+	// Replay client has no connection to close
+	if c.conn == nil {
+		return nil
+	}
 	return c.conn.Close()
 }
@@ -12,22 +12,25 @@ var (
 )
 
 type DebondingDelegationClient interface {
-	GetByAddress(string, int64) (*debondingdelegationpb.GetByAddressResponse, error)
+	GetByAddress(context.Context, string, int64) (*debondingdelegationpb.GetByAddressResponse, error)
 }
 
-func NewDebondingDelegationClient(conn *grpc.ClientConn) DebondingDelegationClient {
+func NewDebondingDelegationClient(conn *grpc.ClientConn, timeouts Timeouts) DebondingDelegationClient {
 	return &debondingDelegationClient{
-		client: debondingdelegationpb.NewDebondingDelegationServiceClient(conn),
+		client:   debondingdelegationpb.NewDebondingDelegationServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type debondingDelegationClient struct {
-	client debondingdelegationpb.DebondingDelegationServiceClient
+	client   debondingdelegationpb.DebondingDelegationServiceClient
+	timeouts Timeouts
 }
 
-func (r *debondingDelegationClient) GetByAddress(address string, h int64) (*debondingdelegationpb.GetByAddressResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByAddress(ctx, &debondingdelegationpb.GetByAddressRequest{Address: address, Height: h})
+func (r *debondingDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (res *debondingdelegationpb.GetByAddressResponse, err error) {
+	err = call(ctx, r.timeouts, "DebondingDelegation.GetByAddress", func(ctx context.Context) error {
+		res, err = r.client.GetByAddress(ctx, &debondingdelegationpb.GetByAddressRequest{Address: address, Height: h})
+		return err
+	})
+	return
 }
-
@@ -12,22 +12,25 @@ var (
 )
 
 type DelegationClient interface {
-	GetByAddress(string, int64) (*delegationpb.GetByAddressResponse, error)
+	GetByAddress(context.Context, string, int64) (*delegationpb.GetByAddressResponse, error)
 }
 
-func NewDelegationClient(conn *grpc.ClientConn) DelegationClient {
+func NewDelegationClient(conn *grpc.ClientConn, timeouts Timeouts) DelegationClient {
 	return &delegationClient{
-		client: delegationpb.NewDelegationServiceClient(conn),
+		client:   delegationpb.NewDelegationServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type delegationClient struct {
-	client delegationpb.DelegationServiceClient
+	client   delegationpb.DelegationServiceClient
+	timeouts Timeouts
 }
 
-func (r *delegationClient) GetByAddress(address string, h int64) (*delegationpb.GetByAddressResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByAddress(ctx, &delegationpb.GetByAddressRequest{Address: address, Height: h})
+func (r *delegationClient) GetByAddress(ctx context.Context, address string, h int64) (res *delegationpb.GetByAddressResponse, err error) {
+	err = call(ctx, r.timeouts, "Delegation.GetByAddress", func(ctx context.Context) error {
+		res, err = r.client.GetByAddress(ctx, &delegationpb.GetByAddressRequest{Address: address, Height: h})
+		return err
+	})
+	return
 }
-
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// diskCacheLowWaterRatio is part of max size cache is shrunk to on eviction,
+	// so that eviction does not run again on every following Put
+	diskCacheLowWaterRatio = 0.9
+)
+
+// NewDiskCache creates cache storing raw proxy responses in given directory.
+// When total size of cached files goes over maxSize bytes, least recently used files are evicted.
+func NewDiskCache(dir string, maxSize int64) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	c := &DiskCache{
+		dir:     dir,
+		maxSize: maxSize,
+
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DiskCache is a content-addressed cache of raw proxy responses.
+// Each response is stored in a file named after hash of its request kind and height.
+// Recency of files is tracked in memory, it is restored from file modification times when cache is opened.
+type DiskCache struct {
+	dir     string
+	maxSize int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List // of *cacheFile, most recently used first
+	entries map[string]*list.Element
+}
+
+type cacheFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// Get reads cached response into msg, it returns false if response is not cached
+func (c *DiskCache) Get(kind string, height int64, msg proto.Message) bool {
+	path := c.path(kind, height)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return false
+	}
+
+	// Mark file as recently used so it is evicted last, also after cache is opened again
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+
+	c.mu.Lock()
+	if e, ok := c.entries[path]; ok {
+		c.lru.MoveToFront(e)
+	}
+	c.mu.Unlock()
+	return true
+}
+
+// Put stores response in cache and evicts old responses if cache is over its max size
+func (c *DiskCache) Put(kind string, height int64, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	path := c.path(kind, height)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// Write to temp file first so that readers never see partially written response
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	c.add(cacheFile{path: path, size: int64(len(data)), modTime: time.Now()})
+
+	if c.maxSize > 0 && c.size > c.maxSize {
+		return c.evict()
+	}
+	return nil
+}
+
+// Size returns total size of cached responses in bytes
+func (c *DiskCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+// init builds index of cached files ordered by modification time
+func (c *DiskCache) init() error {
+	files, err := c.files()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+	for _, f := range files {
+		c.add(f)
+	}
+	return nil
+}
+
+// add puts file at front of index, replacing previous entry of the same file
+func (c *DiskCache) add(f cacheFile) {
+	if e, ok := c.entries[f.path]; ok {
+		c.size -= e.Value.(*cacheFile).size
+		c.lru.Remove(e)
+	}
+	c.entries[f.path] = c.lru.PushFront(&f)
+	c.size += f.size
+}
+
+// evict removes least recently used files until cache size is under low water mark
+func (c *DiskCache) evict() error {
+	lowWater := int64(float64(c.maxSize) * diskCacheLowWaterRatio)
+
+	for c.size > lowWater {
+		e := c.lru.Back()
+		if e == nil {
+			break
+		}
+		f := e.Value.(*cacheFile)
+		if err := os.Remove(f.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		c.lru.Remove(e)
+		delete(c.entries, f.path)
+		c.size -= f.size
+	}
+	return nil
+}
+
+func (c *DiskCache) files() ([]cacheFile, error) {
+	var files []cacheFile
+	err := filepath.Walk(c.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Base(path)[0] == '.' {
+			return nil
+		}
+		files = append(files, cacheFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	return files, err
+}
+
+// path returns location of response file, files are spread across subdirectories by hash prefix
+func (c *DiskCache) path(kind string, height int64) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", kind, height)))
+	key := hex.EncodeToString(sum[:])
+	return filepath.Join(c.dir, key[:2], key)
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestDiskCache_GetPut(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msg timestamp.Timestamp
+	if cache.Get(CacheKindBlock, 10, &msg) {
+		t.Errorf("expected cache miss")
+	}
+
+	if err := cache.Put(CacheKindBlock, 10, &timestamp.Timestamp{Seconds: 100}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !cache.Get(CacheKindBlock, 10, &msg) {
+		t.Fatalf("expected cache hit")
+	}
+	if msg.Seconds != 100 {
+		t.Errorf("unexpected cached value, want %v; got %v", 100, msg.Seconds)
+	}
+
+	if cache.Get(CacheKindState, 10, &msg) {
+		t.Errorf("expected cache miss for different kind")
+	}
+
+	// Cache size is restored when cache is opened again
+	reopened, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reopened.Size() != cache.Size() {
+		t.Errorf("unexpected size, want %v; got %v", cache.Size(), reopened.Size())
+	}
+}
+
+func TestDiskCache_Evict(t *testing.T) {
+	msg := &timestamp.Timestamp{Seconds: 100, Nanos: 100}
+	size := int64(len(mustMarshal(t, msg)))
+
+	tests := []struct {
+		description   string
+		maxSize       int64
+		puts          []int64
+		gets          []int64
+		expectCached  []int64
+		expectEvicted []int64
+	}{
+		{
+			description:   "evicts least recently put responses down to low water mark",
+			maxSize:       10 * size,
+			puts:          []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expectCached:  []int64{3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expectEvicted: []int64{1, 2},
+		},
+		{
+			description:   "does not evict again until max size is exceeded",
+			maxSize:       10 * size,
+			puts:          []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			expectCached:  []int64{3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+			expectEvicted: []int64{1, 2},
+		},
+		{
+			description:   "keeps recently read responses",
+			maxSize:       4 * size,
+			puts:          []int64{1, 2, 3, 4},
+			gets:          []int64{1},
+			expectCached:  []int64{1, 4, 5},
+			expectEvicted: []int64{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "cache")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			cache, err := NewDiskCache(dir, tt.maxSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got timestamp.Timestamp
+			for _, height := range tt.puts {
+				if err := cache.Put(CacheKindBlock, height, msg); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, height := range tt.gets {
+				if !cache.Get(CacheKindBlock, height, &got) {
+					t.Fatalf("expected height %d to be cached", height)
+				}
+			}
+			if len(tt.gets) > 0 {
+				if err := cache.Put(CacheKindBlock, 5, msg); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if cache.Size() > tt.maxSize {
+				t.Errorf("expected size to be at most %v, got %v", tt.maxSize, cache.Size())
+			}
+			for _, height := range tt.expectEvicted {
+				if cache.Get(CacheKindBlock, height, &got) {
+					t.Errorf("expected height %d to be evicted", height)
+				}
+			}
+			for _, height := range tt.expectCached {
+				if !cache.Get(CacheKindBlock, height, &got) {
+					t.Errorf("expected height %d to be cached", height)
+				}
+			}
+		})
+	}
+}
+
+func TestDiskCache_EvictAfterReopen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	msg := &timestamp.Timestamp{Seconds: 100, Nanos: 100}
+	size := int64(len(mustMarshal(t, msg)))
+
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Height 2 was used least recently
+	ages := map[int64]time.Duration{1: 2 * time.Minute, 2: 3 * time.Minute, 3: time.Minute}
+	for height, age := range ages {
+		if err := cache.Put(CacheKindBlock, height, msg); err != nil {
+			t.Fatal(err)
+		}
+		past := time.Now().Add(-age)
+		os.Chtimes(cache.path(CacheKindBlock, height), past, past)
+	}
+
+	reopened, err := NewDiskCache(dir, 3*size+size/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := reopened.Put(CacheKindBlock, 4, msg); err != nil {
+		t.Fatal(err)
+	}
+
+	var got timestamp.Timestamp
+	if reopened.Get(CacheKindBlock, 2, &got) {
+		t.Errorf("expected least recently modified response to be evicted")
+	}
+	for _, height := range []int64{1, 3, 4} {
+		if !reopened.Get(CacheKindBlock, height, &got) {
+			t.Errorf("expected height %d to be cached", height)
+		}
+	}
+}
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -13,28 +13,34 @@ var (
 )
 
 type EventClient interface {
-	GetEscrowEventsByHeight(int64) (*eventpb.GetEscrowEventsByHeightResponse, error)
-	GetTransferEventsByHeight(int64) (*eventpb.GetTransferEventsByHeightResponse, error)
+	GetEscrowEventsByHeight(context.Context, int64) (*eventpb.GetEscrowEventsByHeightResponse, error)
+	GetTransferEventsByHeight(context.Context, int64) (*eventpb.GetTransferEventsByHeightResponse, error)
 }
 
-func NewEventClient(conn *grpc.ClientConn) EventClient {
+func NewEventClient(conn *grpc.ClientConn, timeouts Timeouts) EventClient {
 	return &eventClient{
-		client: eventpb.NewEventServiceClient(conn),
+		client:   eventpb.NewEventServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type eventClient struct {
-	client eventpb.EventServiceClient
+	client   eventpb.EventServiceClient
+	timeouts Timeouts
 }
 
-func (r *eventClient) GetEscrowEventsByHeight(h int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetEscrowEventsByHeight(ctx, &eventpb.GetEscrowEventsByHeightRequest{Height: h})
+func (r *eventClient) GetEscrowEventsByHeight(ctx context.Context, h int64) (res *eventpb.GetEscrowEventsByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Event.GetEscrowEventsByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetEscrowEventsByHeight(ctx, &eventpb.GetEscrowEventsByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
 
-func (r *eventClient) GetTransferEventsByHeight(h int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetTransferEventsByHeight(ctx, &eventpb.GetTransferEventsByHeightRequest{Height: h})
+func (r *eventClient) GetTransferEventsByHeight(ctx context.Context, h int64) (res *eventpb.GetTransferEventsByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Event.GetTransferEventsByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetTransferEventsByHeight(ctx, &eventpb.GetTransferEventsByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
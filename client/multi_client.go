@@ -0,0 +1,361 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/debondingdelegation/debondingdelegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// proxyCooldown is time for which proxy is skipped after it was unavailable
+	proxyCooldown = 30 * time.Second
+)
+
+var (
+	_ AccountClient             = (*multiAccountClient)(nil)
+	_ ChainClient               = (*multiChainClient)(nil)
+	_ BlockClient               = (*multiBlockClient)(nil)
+	_ EventClient               = (*multiEventClient)(nil)
+	_ StateClient               = (*multiStateClient)(nil)
+	_ ValidatorClient           = (*multiValidatorClient)(nil)
+	_ TransactionClient         = (*multiTransactionClient)(nil)
+	_ DelegationClient          = (*multiDelegationClient)(nil)
+	_ DebondingDelegationClient = (*multiDebondingDelegationClient)(nil)
+
+	ErrQuorumNotReached = errors.New("could not get response from two proxies")
+	ErrQuorumMismatch   = errors.New("proxies returned different blocks")
+)
+
+// proxy is a single proxy used by multi-proxy client
+type proxy struct {
+	url    string
+	conn   *grpc.ClientConn
+	client *Client
+
+	mu             sync.Mutex
+	unhealthyUntil time.Time
+}
+
+// healthy returns false if proxy connection failed or proxy was recently unavailable
+func (p *proxy) healthy() bool {
+	if p.conn != nil {
+		state := p.conn.GetState()
+		if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+			return false
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return time.Now().After(p.unhealthyUntil)
+}
+
+func (p *proxy) markUnhealthy(err error) {
+	logger.Warn(fmt.Sprintf("proxy unavailable, failing over to next one [url=%s] [err=%+v]", p.url, err))
+
+	p.mu.Lock()
+	p.unhealthyUntil = time.Now().Add(proxyCooldown)
+	p.mu.Unlock()
+}
+
+// proxyPool picks proxies for requests
+type proxyPool struct {
+	proxies []*proxy
+	next    uint64
+}
+
+// order returns proxies starting from next one in round-robin, healthy proxies first
+func (p *proxyPool) order() []*proxy {
+	start := int(atomic.AddUint64(&p.next, 1)-1) % len(p.proxies)
+
+	var healthy, unhealthy []*proxy
+	for i := range p.proxies {
+		proxy := p.proxies[(start+i)%len(p.proxies)]
+		if proxy.healthy() {
+			healthy = append(healthy, proxy)
+		} else {
+			unhealthy = append(unhealthy, proxy)
+		}
+	}
+	// Unhealthy proxies are still tried when all of them fail
+	return append(healthy, unhealthy...)
+}
+
+// do calls fn with proxies until one of them is not unavailable or context is done
+func (p *proxyPool) do(ctx context.Context, fn func(c *Client) error) error {
+	var err error
+	for _, proxy := range p.order() {
+		if err = fn(proxy.client); err == nil || !isUnavailable(err) || ctx.Err() != nil {
+			return err
+		}
+		proxy.markUnhealthy(err)
+	}
+	return err
+}
+
+// doOnce calls fn with a single proxy, healthy one if possible. It is used for requests which are not safe to repeat,
+// e.g. broadcasting transaction which could have reached the chain before proxy timed out.
+func (p *proxyPool) doOnce(ctx context.Context, fn func(c *Client) error) error {
+	proxy := p.order()[0]
+	err := fn(proxy.client)
+	if err != nil && isUnavailable(err) && ctx.Err() == nil {
+		proxy.markUnhealthy(err)
+	}
+	return err
+}
+
+// doQuorum calls fn with proxies until it succeeds with two of them
+func (p *proxyPool) doQuorum(ctx context.Context, fn func(c *Client) error) error {
+	var err error
+	var successCount int
+	for _, proxy := range p.order() {
+		if err = fn(proxy.client); err != nil {
+			if !isUnavailable(err) || ctx.Err() != nil {
+				return err
+			}
+			proxy.markUnhealthy(err)
+			continue
+		}
+
+		successCount++
+		if successCount == 2 {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrQuorumNotReached, "%+v", err)
+}
+
+// isUnavailable returns true if error means that proxy could not serve request, so other proxy should be tried
+func isUnavailable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+func newMultiClient(proxies []*proxy, quorum bool) *Client {
+	pool := &proxyPool{proxies: proxies}
+
+	return &Client{
+		proxies: proxies,
+
+		Account:             &multiAccountClient{pool: pool},
+		Chain:               &multiChainClient{pool: pool},
+		Block:               &multiBlockClient{pool: pool, quorum: quorum},
+		Event:               &multiEventClient{pool: pool},
+		State:               &multiStateClient{pool: pool},
+		Validator:           &multiValidatorClient{pool: pool},
+		Transaction:         &multiTransactionClient{pool: pool},
+		Delegation:          &multiDelegationClient{pool: pool},
+		DebondingDelegation: &multiDebondingDelegationClient{pool: pool},
+	}
+}
+
+type multiAccountClient struct {
+	pool *proxyPool
+}
+
+func (r *multiAccountClient) GetByAddress(ctx context.Context, address string, h int64) (res *accountpb.GetByAddressResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Account.GetByAddress(ctx, address, h)
+		return err
+	})
+	return
+}
+
+type multiChainClient struct {
+	pool *proxyPool
+}
+
+func (r *multiChainClient) GetHead(ctx context.Context) (res *chainpb.GetHeadResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Chain.GetHead(ctx)
+		return err
+	})
+	return
+}
+
+func (r *multiChainClient) GetStatus(ctx context.Context) (res *chainpb.GetStatusResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Chain.GetStatus(ctx)
+		return err
+	})
+	return
+}
+
+func (r *multiChainClient) GetMetaByHeight(ctx context.Context, h int64) (res *chainpb.GetMetaByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Chain.GetMetaByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+func (r *multiChainClient) GetConstants(ctx context.Context) (res *chainpb.GetConstantsResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Chain.GetConstants(ctx)
+		return err
+	})
+	return
+}
+
+type multiBlockClient struct {
+	pool   *proxyPool
+	quorum bool
+}
+
+func (r *multiBlockClient) GetByHeight(ctx context.Context, h int64) (res *blockpb.GetByHeightResponse, err error) {
+	if !r.quorum {
+		err = r.pool.do(ctx, func(c *Client) error {
+			res, err = c.Block.GetByHeight(ctx, h)
+			return err
+		})
+		return
+	}
+
+	var responses []*blockpb.GetByHeightResponse
+	err = r.pool.doQuorum(ctx, func(c *Client) error {
+		res, err := c.Block.GetByHeight(ctx, h)
+		if err == nil {
+			responses = append(responses, res)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if blockHashes(responses[0].GetBlock()) != blockHashes(responses[1].GetBlock()) {
+		return nil, errors.Wrapf(ErrQuorumMismatch, "height %d", h)
+	}
+	return responses[0], nil
+}
+
+// blockHashes returns hashes from block header identifying block contents and chain state
+func blockHashes(block *blockpb.Block) string {
+	header := block.GetHeader()
+	return strings.Join([]string{
+		header.GetLastBlockId().GetHash(),
+		header.GetDataHash(),
+		header.GetValidatorsHash(),
+		header.GetAppHash(),
+		header.GetLastResultsHash(),
+	}, ":")
+}
+
+type multiEventClient struct {
+	pool *proxyPool
+}
+
+func (r *multiEventClient) GetEscrowEventsByHeight(ctx context.Context, h int64) (res *eventpb.GetEscrowEventsByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Event.GetEscrowEventsByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+func (r *multiEventClient) GetTransferEventsByHeight(ctx context.Context, h int64) (res *eventpb.GetTransferEventsByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Event.GetTransferEventsByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+type multiStateClient struct {
+	pool *proxyPool
+}
+
+func (r *multiStateClient) GetByHeight(ctx context.Context, h int64) (res *statepb.GetByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.State.GetByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+func (r *multiStateClient) GetStakingByHeight(ctx context.Context, h int64) (res *statepb.GetStakingByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.State.GetStakingByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+type multiValidatorClient struct {
+	pool *proxyPool
+}
+
+func (r *multiValidatorClient) GetByHeight(ctx context.Context, h int64) (res *validatorpb.GetByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Validator.GetByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+type multiTransactionClient struct {
+	pool *proxyPool
+}
+
+func (r *multiTransactionClient) GetByHeight(ctx context.Context, h int64) (res *transactionpb.GetByHeightResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Transaction.GetByHeight(ctx, h)
+		return err
+	})
+	return
+}
+
+// Broadcast sends transaction through a single proxy without failover, so transaction is never broadcast twice
+func (r *multiTransactionClient) Broadcast(ctx context.Context, txRaw string) (res *transactionpb.BroadcastResponse, err error) {
+	err = r.pool.doOnce(ctx, func(c *Client) error {
+		res, err = c.Transaction.Broadcast(ctx, txRaw)
+		return err
+	})
+	return
+}
+
+type multiDelegationClient struct {
+	pool *proxyPool
+}
+
+func (r *multiDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (res *delegationpb.GetByAddressResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.Delegation.GetByAddress(ctx, address, h)
+		return err
+	})
+	return
+}
+
+type multiDebondingDelegationClient struct {
+	pool *proxyPool
+}
+
+func (r *multiDebondingDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (res *debondingdelegationpb.GetByAddressResponse, err error) {
+	err = r.pool.do(ctx, func(c *Client) error {
+		res, err = c.DebondingDelegation.GetByAddress(ctx, address, h)
+		return err
+	})
+	return
+}
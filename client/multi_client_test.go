@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	mock "github.com/figment-networks/oasishub-indexer/mock/client"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testProxy(url string, chain ChainClient, block BlockClient) *proxy {
+	return &proxy{url: url, client: &Client{Chain: chain, Block: block}}
+}
+
+func testBlockResponse(appHash string) *blockpb.GetByHeightResponse {
+	return &blockpb.GetByHeightResponse{Block: &blockpb.Block{Header: &blockpb.Header{AppHash: appHash}}}
+}
+
+func TestMultiClient_Failover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	unavailable := mock.NewMockChainClient(ctrl)
+	unavailable.EXPECT().GetHead(gomock.Any()).Return(nil, status.Error(codes.Unavailable, "proxy down")).Times(1)
+
+	available := mock.NewMockChainClient(ctrl)
+	available.EXPECT().GetHead(gomock.Any()).Return(&chainpb.GetHeadResponse{Height: 10}, nil).Times(2)
+
+	c := newMultiClient([]*proxy{testProxy("a", unavailable, nil), testProxy("b", available, nil)}, false)
+
+	for i := 0; i < 2; i++ {
+		res, err := c.Chain.GetHead(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetHeight() != 10 {
+			t.Errorf("unexpected height, want %v; got %v", 10, res.GetHeight())
+		}
+	}
+}
+
+func TestMultiClient_RoundRobin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := mock.NewMockChainClient(ctrl)
+	first.EXPECT().GetHead(gomock.Any()).Return(&chainpb.GetHeadResponse{Height: 1}, nil).Times(2)
+
+	second := mock.NewMockChainClient(ctrl)
+	second.EXPECT().GetHead(gomock.Any()).Return(&chainpb.GetHeadResponse{Height: 2}, nil).Times(2)
+
+	c := newMultiClient([]*proxy{testProxy("a", first, nil), testProxy("b", second, nil)}, false)
+
+	for _, want := range []int64{1, 2, 1, 2} {
+		res, err := c.Chain.GetHead(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.GetHeight() != want {
+			t.Errorf("unexpected height, want %v; got %v", want, res.GetHeight())
+		}
+	}
+}
+
+func TestMultiClient_NoFailoverOnRequestError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	requestErr := status.Error(codes.NotFound, "height not found")
+
+	first := mock.NewMockChainClient(ctrl)
+	first.EXPECT().GetHead(gomock.Any()).Return(nil, requestErr).Times(1)
+
+	second := mock.NewMockChainClient(ctrl)
+
+	c := newMultiClient([]*proxy{testProxy("a", first, nil), testProxy("b", second, nil)}, false)
+
+	if _, err := c.Chain.GetHead(context.Background()); err != requestErr {
+		t.Errorf("unexpected error, want %v; got %v", requestErr, err)
+	}
+}
+
+func TestMultiClient_BroadcastNoFailover(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+	}{
+		{"does not retry when proxy times out", status.Error(codes.DeadlineExceeded, "timeout")},
+		{"does not retry when proxy is unavailable", status.Error(codes.Unavailable, "proxy down")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			first := mock.NewMockTransactionClient(ctrl)
+			first.EXPECT().Broadcast(gomock.Any(), "tx").Return(nil, tt.err).Times(1)
+
+			second := mock.NewMockTransactionClient(ctrl)
+			second.EXPECT().Broadcast(gomock.Any(), gomock.Any()).Times(0)
+
+			proxies := []*proxy{
+				{url: "a", client: &Client{Transaction: first}},
+				{url: "b", client: &Client{Transaction: second}},
+			}
+			c := newMultiClient(proxies, false)
+
+			if _, err := c.Transaction.Broadcast(context.Background(), "tx"); status.Code(err) != status.Code(tt.err) {
+				t.Errorf("unexpected error, want %v; got %v", tt.err, err)
+			}
+			if proxies[0].healthy() {
+				t.Error("expected proxy to be marked unhealthy")
+			}
+		})
+	}
+}
+
+func TestMultiClient_BroadcastSkipsUnhealthyProxy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	unhealthy := mock.NewMockTransactionClient(ctrl)
+	unhealthy.EXPECT().Broadcast(gomock.Any(), gomock.Any()).Times(0)
+
+	healthy := mock.NewMockTransactionClient(ctrl)
+	healthy.EXPECT().Broadcast(gomock.Any(), "tx").Return(&transactionpb.BroadcastResponse{}, nil).Times(1)
+
+	proxies := []*proxy{
+		{url: "a", client: &Client{Transaction: unhealthy}, unhealthyUntil: time.Now().Add(time.Minute)},
+		{url: "b", client: &Client{Transaction: healthy}},
+	}
+	c := newMultiClient(proxies, false)
+
+	if _, err := c.Transaction.Broadcast(context.Background(), "tx"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestMultiClient_Quorum(t *testing.T) {
+	tests := []struct {
+		description string
+		firstHash   string
+		secondHash  string
+		expectedErr error
+	}{
+		{"returns block when hashes match", "hash", "hash", nil},
+		{"fails when hashes differ", "hash", "other", ErrQuorumMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			first := mock.NewMockBlockClient(ctrl)
+			first.EXPECT().GetByHeight(gomock.Any(), int64(10)).Return(testBlockResponse(tt.firstHash), nil).Times(1)
+
+			second := mock.NewMockBlockClient(ctrl)
+			second.EXPECT().GetByHeight(gomock.Any(), int64(10)).Return(testBlockResponse(tt.secondHash), nil).Times(1)
+
+			c := newMultiClient([]*proxy{testProxy("a", nil, first), testProxy("b", nil, second)}, true)
+
+			res, err := c.Block.GetByHeight(context.Background(), 10)
+			if errors.Cause(err) != tt.expectedErr {
+				t.Fatalf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+			if tt.expectedErr == nil && res.GetBlock().GetHeader().GetAppHash() != tt.firstHash {
+				t.Errorf("unexpected block, want app hash %v; got %v", tt.firstHash, res.GetBlock().GetHeader().GetAppHash())
+			}
+		})
+	}
+}
+
+func TestMultiClient_QuorumNotReached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := mock.NewMockBlockClient(ctrl)
+	first.EXPECT().GetByHeight(gomock.Any(), int64(10)).Return(testBlockResponse("hash"), nil).Times(1)
+
+	second := mock.NewMockBlockClient(ctrl)
+	second.EXPECT().GetByHeight(gomock.Any(), int64(10)).Return(nil, status.Error(codes.Unavailable, "proxy down")).Times(1)
+
+	c := newMultiClient([]*proxy{testProxy("a", nil, first), testProxy("b", nil, second)}, true)
+
+	if _, err := c.Block.GetByHeight(context.Background(), 10); errors.Cause(err) != ErrQuorumNotReached {
+		t.Errorf("unexpected error, want %v; got %v", ErrQuorumNotReached, err)
+	}
+}
+
+func TestNewWithOptions(t *testing.T) {
+	caFile, err := ioutil.TempFile("", "ca")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(caFile.Name())
+	caFile.WriteString("not a certificate")
+	caFile.Close()
+
+	tests := []struct {
+		description string
+		opts        Options
+		expectedErr error
+	}{
+		{"fails without urls", Options{}, ErrProxyUrlsRequired},
+		{"fails with quorum and single url", Options{Urls: []string{"localhost:50051"}, Quorum: true}, ErrQuorumProxies},
+		{"fails with invalid CA file", Options{Urls: []string{"localhost:50051"}, TLS: TLSOptions{Enabled: true, CAFile: caFile.Name()}}, ErrInvalidCAFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if _, err := NewWithOptions(tt.opts); err != tt.expectedErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectedErr, err)
+			}
+		})
+	}
+}
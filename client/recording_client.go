@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/debondingdelegation/debondingdelegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	_ AccountClient             = (*recordingAccountClient)(nil)
+	_ ChainClient               = (*recordingChainClient)(nil)
+	_ BlockClient               = (*recordingBlockClient)(nil)
+	_ EventClient               = (*recordingEventClient)(nil)
+	_ StateClient               = (*recordingStateClient)(nil)
+	_ ValidatorClient           = (*recordingValidatorClient)(nil)
+	_ TransactionClient         = (*recordingTransactionClient)(nil)
+	_ DelegationClient          = (*recordingDelegationClient)(nil)
+	_ DebondingDelegationClient = (*recordingDebondingDelegationClient)(nil)
+)
+
+// NewRecordingClient returns client which passes requests to given client and records all successful responses in bundle.
+// Broadcasting transactions is passed through without being recorded.
+func NewRecordingClient(c *Client, bundle *Bundle) *Client {
+	return &Client{
+		conn:    c.conn,
+		proxies: c.proxies,
+
+		Account:             &recordingAccountClient{client: c.Account, bundle: bundle},
+		Chain:               &recordingChainClient{client: c.Chain, bundle: bundle},
+		Block:               &recordingBlockClient{client: c.Block, bundle: bundle},
+		Event:               &recordingEventClient{client: c.Event, bundle: bundle},
+		State:               &recordingStateClient{client: c.State, bundle: bundle},
+		Validator:           &recordingValidatorClient{client: c.Validator, bundle: bundle},
+		Transaction:         &recordingTransactionClient{TransactionClient: c.Transaction, bundle: bundle},
+		Delegation:          &recordingDelegationClient{client: c.Delegation, bundle: bundle},
+		DebondingDelegation: &recordingDebondingDelegationClient{client: c.DebondingDelegation, bundle: bundle},
+	}
+}
+
+// record stores response in bundle unless request failed
+func record(bundle *Bundle, key string, res proto.Message, err error) error {
+	if err != nil {
+		return err
+	}
+	return bundle.put(key, res)
+}
+
+type recordingAccountClient struct {
+	client AccountClient
+	bundle *Bundle
+}
+
+func (r *recordingAccountClient) GetByAddress(ctx context.Context, address string, h int64) (*accountpb.GetByAddressResponse, error) {
+	res, err := r.client.GetByAddress(ctx, address, h)
+	if err := record(r.bundle, bundleKey(methodAccountGetByAddress, address, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingChainClient struct {
+	client ChainClient
+	bundle *Bundle
+}
+
+func (r *recordingChainClient) GetHead(ctx context.Context) (*chainpb.GetHeadResponse, error) {
+	res, err := r.client.GetHead(ctx)
+	if err := record(r.bundle, bundleKey(methodChainGetHead), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *recordingChainClient) GetStatus(ctx context.Context) (*chainpb.GetStatusResponse, error) {
+	res, err := r.client.GetStatus(ctx)
+	if err := record(r.bundle, bundleKey(methodChainGetStatus), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *recordingChainClient) GetMetaByHeight(ctx context.Context, h int64) (*chainpb.GetMetaByHeightResponse, error) {
+	res, err := r.client.GetMetaByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodChainGetMetaByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *recordingChainClient) GetConstants(ctx context.Context) (*chainpb.GetConstantsResponse, error) {
+	res, err := r.client.GetConstants(ctx)
+	if err := record(r.bundle, bundleKey(methodChainGetConstants), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingBlockClient struct {
+	client BlockClient
+	bundle *Bundle
+}
+
+func (r *recordingBlockClient) GetByHeight(ctx context.Context, h int64) (*blockpb.GetByHeightResponse, error) {
+	res, err := r.client.GetByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodBlockGetByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingEventClient struct {
+	client EventClient
+	bundle *Bundle
+}
+
+func (r *recordingEventClient) GetEscrowEventsByHeight(ctx context.Context, h int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
+	res, err := r.client.GetEscrowEventsByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodEventGetEscrowEventsByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *recordingEventClient) GetTransferEventsByHeight(ctx context.Context, h int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
+	res, err := r.client.GetTransferEventsByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodEventGetTransferEventsByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingStateClient struct {
+	client StateClient
+	bundle *Bundle
+}
+
+func (r *recordingStateClient) GetByHeight(ctx context.Context, h int64) (*statepb.GetByHeightResponse, error) {
+	res, err := r.client.GetByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodStateGetByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *recordingStateClient) GetStakingByHeight(ctx context.Context, h int64) (*statepb.GetStakingByHeightResponse, error) {
+	res, err := r.client.GetStakingByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodStateGetStakingByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingValidatorClient struct {
+	client ValidatorClient
+	bundle *Bundle
+}
+
+func (r *recordingValidatorClient) GetByHeight(ctx context.Context, h int64) (*validatorpb.GetByHeightResponse, error) {
+	res, err := r.client.GetByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodValidatorGetByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingTransactionClient struct {
+	TransactionClient
+
+	bundle *Bundle
+}
+
+func (r *recordingTransactionClient) GetByHeight(ctx context.Context, h int64) (*transactionpb.GetByHeightResponse, error) {
+	res, err := r.TransactionClient.GetByHeight(ctx, h)
+	if err := record(r.bundle, bundleKey(methodTransactionGetByHeight, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingDelegationClient struct {
+	client DelegationClient
+	bundle *Bundle
+}
+
+func (r *recordingDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (*delegationpb.GetByAddressResponse, error) {
+	res, err := r.client.GetByAddress(ctx, address, h)
+	if err := record(r.bundle, bundleKey(methodDelegationGetByAddress, address, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type recordingDebondingDelegationClient struct {
+	client DebondingDelegationClient
+	bundle *Bundle
+}
+
+func (r *recordingDebondingDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (*debondingdelegationpb.GetByAddressResponse, error) {
+	res, err := r.client.GetByAddress(ctx, address, h)
+	if err := record(r.bundle, bundleKey(methodDebondingDelegationGetByAddress, address, h), res, err); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/debondingdelegation/debondingdelegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ AccountClient             = (*replayAccountClient)(nil)
+	_ ChainClient               = (*replayChainClient)(nil)
+	_ BlockClient               = (*replayBlockClient)(nil)
+	_ EventClient               = (*replayEventClient)(nil)
+	_ StateClient               = (*replayStateClient)(nil)
+	_ ValidatorClient           = (*replayValidatorClient)(nil)
+	_ TransactionClient         = (*replayTransactionClient)(nil)
+	_ DelegationClient          = (*replayDelegationClient)(nil)
+	_ DebondingDelegationClient = (*replayDebondingDelegationClient)(nil)
+
+	ErrReplayBroadcast = errors.New("broadcasting transactions is not supported by replay client")
+)
+
+// NewReplayClient returns client serving responses recorded in bundle, without connecting to proxy.
+// Chain head is reported at the end height of bundle, so that indexing stops at the last recorded height.
+func NewReplayClient(bundle *Bundle) *Client {
+	return &Client{
+		Account:             &replayAccountClient{bundle: bundle},
+		Chain:               &replayChainClient{bundle: bundle},
+		Block:               &replayBlockClient{bundle: bundle},
+		Event:               &replayEventClient{bundle: bundle},
+		State:               &replayStateClient{bundle: bundle},
+		Validator:           &replayValidatorClient{bundle: bundle},
+		Transaction:         &replayTransactionClient{bundle: bundle},
+		Delegation:          &replayDelegationClient{bundle: bundle},
+		DebondingDelegation: &replayDebondingDelegationClient{bundle: bundle},
+	}
+}
+
+type replayAccountClient struct {
+	bundle *Bundle
+}
+
+func (r *replayAccountClient) GetByAddress(ctx context.Context, address string, h int64) (*accountpb.GetByAddressResponse, error) {
+	res := &accountpb.GetByAddressResponse{}
+	if err := r.bundle.get(bundleKey(methodAccountGetByAddress, address, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayChainClient struct {
+	bundle *Bundle
+}
+
+func (r *replayChainClient) GetHead(ctx context.Context) (*chainpb.GetHeadResponse, error) {
+	if r.bundle.EndHeight > 0 {
+		return &chainpb.GetHeadResponse{Height: r.bundle.EndHeight}, nil
+	}
+
+	res := &chainpb.GetHeadResponse{}
+	if err := r.bundle.get(bundleKey(methodChainGetHead), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayChainClient) GetStatus(ctx context.Context) (*chainpb.GetStatusResponse, error) {
+	res := &chainpb.GetStatusResponse{}
+	if err := r.bundle.get(bundleKey(methodChainGetStatus), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayChainClient) GetMetaByHeight(ctx context.Context, h int64) (*chainpb.GetMetaByHeightResponse, error) {
+	res := &chainpb.GetMetaByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodChainGetMetaByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayChainClient) GetConstants(ctx context.Context) (*chainpb.GetConstantsResponse, error) {
+	res := &chainpb.GetConstantsResponse{}
+	if err := r.bundle.get(bundleKey(methodChainGetConstants), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayBlockClient struct {
+	bundle *Bundle
+}
+
+func (r *replayBlockClient) GetByHeight(ctx context.Context, h int64) (*blockpb.GetByHeightResponse, error) {
+	res := &blockpb.GetByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodBlockGetByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayEventClient struct {
+	bundle *Bundle
+}
+
+func (r *replayEventClient) GetEscrowEventsByHeight(ctx context.Context, h int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
+	res := &eventpb.GetEscrowEventsByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodEventGetEscrowEventsByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayEventClient) GetTransferEventsByHeight(ctx context.Context, h int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
+	res := &eventpb.GetTransferEventsByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodEventGetTransferEventsByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayStateClient struct {
+	bundle *Bundle
+}
+
+func (r *replayStateClient) GetByHeight(ctx context.Context, h int64) (*statepb.GetByHeightResponse, error) {
+	res := &statepb.GetByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodStateGetByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayStateClient) GetStakingByHeight(ctx context.Context, h int64) (*statepb.GetStakingByHeightResponse, error) {
+	res := &statepb.GetStakingByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodStateGetStakingByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayValidatorClient struct {
+	bundle *Bundle
+}
+
+func (r *replayValidatorClient) GetByHeight(ctx context.Context, h int64) (*validatorpb.GetByHeightResponse, error) {
+	res := &validatorpb.GetByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodValidatorGetByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayTransactionClient struct {
+	bundle *Bundle
+}
+
+func (r *replayTransactionClient) GetByHeight(ctx context.Context, h int64) (*transactionpb.GetByHeightResponse, error) {
+	res := &transactionpb.GetByHeightResponse{}
+	if err := r.bundle.get(bundleKey(methodTransactionGetByHeight, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *replayTransactionClient) Broadcast(context.Context, string) (*transactionpb.BroadcastResponse, error) {
+	return nil, ErrReplayBroadcast
+}
+
+type replayDelegationClient struct {
+	bundle *Bundle
+}
+
+func (r *replayDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (*delegationpb.GetByAddressResponse, error) {
+	res := &delegationpb.GetByAddressResponse{}
+	if err := r.bundle.get(bundleKey(methodDelegationGetByAddress, address, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type replayDebondingDelegationClient struct {
+	bundle *Bundle
+}
+
+func (r *replayDebondingDelegationClient) GetByAddress(ctx context.Context, address string, h int64) (*debondingdelegationpb.GetByAddressResponse, error) {
+	res := &debondingdelegationpb.GetByAddressResponse{}
+	if err := r.bundle.get(bundleKey(methodDebondingDelegationGetByAddress, address, h), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -12,28 +12,34 @@ var (
 )
 
 type StateClient interface {
-	GetByHeight(int64) (*statepb.GetByHeightResponse, error)
-	GetStakingByHeight(int64) (*statepb.GetStakingByHeightResponse, error)
+	GetByHeight(context.Context, int64) (*statepb.GetByHeightResponse, error)
+	GetStakingByHeight(context.Context, int64) (*statepb.GetStakingByHeightResponse, error)
 }
 
-func NewStateClient(conn *grpc.ClientConn) StateClient {
+func NewStateClient(conn *grpc.ClientConn, timeouts Timeouts) StateClient {
 	return &stateClient{
-		client: statepb.NewStateServiceClient(conn),
+		client:   statepb.NewStateServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type stateClient struct {
-	client statepb.StateServiceClient
+	client   statepb.StateServiceClient
+	timeouts Timeouts
 }
 
-func (r *stateClient) GetByHeight(h int64) (*statepb.GetByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByHeight(ctx, &statepb.GetByHeightRequest{Height: h})
+func (r *stateClient) GetByHeight(ctx context.Context, h int64) (res *statepb.GetByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "State.GetByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetByHeight(ctx, &statepb.GetByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
 
-func (r *stateClient) GetStakingByHeight(h int64) (*statepb.GetStakingByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetStakingByHeight(ctx, &statepb.GetStakingByHeightRequest{Height: h})
+func (r *stateClient) GetStakingByHeight(ctx context.Context, h int64) (res *statepb.GetStakingByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "State.GetStakingByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetStakingByHeight(ctx, &statepb.GetStakingByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	ErrInvalidCAFile = errors.New("no certificates found in CA file")
+)
+
+// TLSOptions holds TLS settings of proxy connections
+type TLSOptions struct {
+	Enabled bool
+	// CAFile is PEM file with certificates used to verify proxy instead of system ones
+	CAFile string
+	// CertFile and KeyFile are PEM files with client certificate presented to proxy
+	CertFile string
+	KeyFile  string
+}
+
+func (o TLSOptions) dialOption() (grpc.DialOption, error) {
+	if !o.Enabled {
+		return grpc.WithInsecure(), nil
+	}
+
+	tlsConfig, err := o.config()
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)), nil
+}
+
+func (o TLSOptions) config() (*tls.Config, error) {
+	tlsConfig := &tls.Config{}
+
+	if o.CAFile != "" {
+		pem, err := ioutil.ReadFile(o.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, ErrInvalidCAFile
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if o.CertFile != "" || o.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -12,29 +12,34 @@ var (
 )
 
 type TransactionClient interface {
-	GetByHeight(int64) (*transactionpb.GetByHeightResponse, error)
-	Broadcast(string) (*transactionpb.BroadcastResponse, error)
+	GetByHeight(context.Context, int64) (*transactionpb.GetByHeightResponse, error)
+	Broadcast(context.Context, string) (*transactionpb.BroadcastResponse, error)
 }
 
-func NewTransactionClient(conn *grpc.ClientConn) TransactionClient {
+func NewTransactionClient(conn *grpc.ClientConn, timeouts Timeouts) TransactionClient {
 	return &transactionClient{
-		client: transactionpb.NewTransactionServiceClient(conn),
+		client:   transactionpb.NewTransactionServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type transactionClient struct {
-	client transactionpb.TransactionServiceClient
+	client   transactionpb.TransactionServiceClient
+	timeouts Timeouts
 }
 
-func (r *transactionClient) GetByHeight(h int64) (*transactionpb.GetByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByHeight(ctx, &transactionpb.GetByHeightRequest{Height: h})
+func (r *transactionClient) GetByHeight(ctx context.Context, h int64) (res *transactionpb.GetByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Transaction.GetByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetByHeight(ctx, &transactionpb.GetByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
 
-func (r *transactionClient) Broadcast(txRaw string) (*transactionpb.BroadcastResponse, error) {
-	ctx := context.Background()
-
-	return r.client.Broadcast(ctx, &transactionpb.BroadcastRequest{TxRaw: txRaw})
+func (r *transactionClient) Broadcast(ctx context.Context, txRaw string) (res *transactionpb.BroadcastResponse, err error) {
+	err = call(ctx, r.timeouts, "Transaction.Broadcast", func(ctx context.Context) error {
+		res, err = r.client.Broadcast(ctx, &transactionpb.BroadcastRequest{TxRaw: txRaw})
+		return err
+	})
+	return
 }
-
@@ -12,22 +12,25 @@ var (
 )
 
 type ValidatorClient interface {
-	GetByHeight(int64) (*validatorpb.GetByHeightResponse, error)
+	GetByHeight(context.Context, int64) (*validatorpb.GetByHeightResponse, error)
 }
 
-func NewValidatorClient(conn *grpc.ClientConn) ValidatorClient {
+func NewValidatorClient(conn *grpc.ClientConn, timeouts Timeouts) ValidatorClient {
 	return &validatorClient{
-		client: validatorpb.NewValidatorServiceClient(conn),
+		client:   validatorpb.NewValidatorServiceClient(conn),
+		timeouts: timeouts,
 	}
 }
 
 type validatorClient struct {
-	client validatorpb.ValidatorServiceClient
+	client   validatorpb.ValidatorServiceClient
+	timeouts Timeouts
 }
 
-func (r *validatorClient) GetByHeight(h int64) (*validatorpb.GetByHeightResponse, error) {
-	ctx := context.Background()
-
-	return r.client.GetByHeight(ctx, &validatorpb.GetByHeightRequest{Height: h})
+func (r *validatorClient) GetByHeight(ctx context.Context, h int64) (res *validatorpb.GetByHeightResponse, err error) {
+	err = call(ctx, r.timeouts, "Validator.GetByHeight", func(ctx context.Context) error {
+		res, err = r.client.GetByHeight(ctx, &validatorpb.GetByHeightRequest{Height: h})
+		return err
+	})
+	return
 }
-
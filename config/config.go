@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -19,38 +20,96 @@ var (
 	errDatabaseRequired            = errors.New("database credentials are required")
 	errIndexWorkerIntervalRequired = errors.New("index worker interval is required")
 	errSyncIntervalInvalid         = errors.New("index worker is invalid")
+	errEscrowBucketsInvalid        = errors.New("escrow balance change buckets must contain 3 ascending boundaries")
+	errCommissionBucketsInvalid    = errors.New("commission change buckets must contain 3 ascending boundaries")
+	errStreamPollIntervalInvalid   = errors.New("stream poll interval is invalid")
 )
 
 // Config holds the configuration data
 type Config struct {
-	AppEnv                       string `json:"app_env" envconfig:"APP_ENV" default:"development"`
-	ProxyUrl                     string `json:"proxy_url" envconfig:"PROXY_URL"`
-	ServerAddr                   string `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
-	ServerPort                   int64  `json:"server_port" envconfig:"SERVER_PORT" default:"8081"`
-	FirstBlockHeight             int64  `json:"first_block_height" envconfig:"FIRST_BLOCK_HEIGHT" default:"1"`
-	IndexWorkerInterval          string `json:"index_worker_interval" envconfig:"INDEX_WORKER_INTERVAL" default:"@every 15m"`
-	SummarizeWorkerInterval      string `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
-	PurgeWorkerInterval          string `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
-	DefaultBatchSize             int64  `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
-	DatabaseDSN                  string `json:"database_dsn" envconfig:"DATABASE_DSN"`
-	Debug                        bool   `json:"debug" envconfig:"DEBUG"`
-	LogLevel                     string `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
-	LogOutput                    string `json:"log_output" envconfig:"LOG_OUTPUT" default:"stdout"`
-	RollbarAccessToken           string `json:"rollbar_access_token" envconfig:"ROLLBAR_ACCESS_TOKEN"`
-	RollbarServerRoot            string `json:"rollbar_server_root" envconfig:"ROLLBAR_SERVER_ROOT"`
-	IndexerMetricAddr            string `json:"indexer_metric_addr" envconfig:"INDEXER_METRIC_ADDR" default:":8080"`
-	ServerMetricAddr             string `json:"server_metric_addr" envconfig:"SERVER_METRIC_ADDR" default:":8090"`
-	MetricServerUrl              string `json:"metric_server_url" envconfig:"METRIC_SERVER_URL" default:"/metrics"`
-	PurgeSequencesInterval       string `json:"purge_sequences_interval" envconfig:"PURGE_SEQUENCES_INTERVAL" default:"24h"`
-	PurgeBalanceEventsInterval   string `json:"purge_balance_events_interval" envconfig:"PURGE_BALANCE_EVENTS_INTERVAL" default:"24h"`
-	PurgeSystemEventsInterval    string `json:"purge_system_events_interval" envconfig:"PURGE_SYSTEM_EVENTS_INTERVAL" default:"24h"`
-	PurgeHourlySummariesInterval string `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"24h"`
-	IndexerConfigFile            string `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
+	AppEnv                       string   `json:"app_env" envconfig:"APP_ENV" default:"development"`
+	ProxyUrl                     string   `json:"proxy_url" envconfig:"PROXY_URL"`
+	ProxyUrls                    []string `json:"proxy_urls" envconfig:"PROXY_URLS"`
+	ProxyQuorum                  bool     `json:"proxy_quorum" envconfig:"PROXY_QUORUM"`
+	ProxyTLS                     bool     `json:"proxy_tls" envconfig:"PROXY_TLS"`
+	ProxyTLSCAFile               string   `json:"proxy_tls_ca_file" envconfig:"PROXY_TLS_CA_FILE"`
+	ProxyTLSCertFile             string   `json:"proxy_tls_cert_file" envconfig:"PROXY_TLS_CERT_FILE"`
+	ProxyTLSKeyFile              string   `json:"proxy_tls_key_file" envconfig:"PROXY_TLS_KEY_FILE"`
+	ServerAddr                   string   `json:"server_addr" envconfig:"SERVER_ADDR" default:"0.0.0.0"`
+	ServerPort                   int64    `json:"server_port" envconfig:"SERVER_PORT" default:"8081"`
+	AdminToken                   string   `json:"admin_token" envconfig:"ADMIN_TOKEN"`
+	FirstBlockHeight             int64    `json:"first_block_height" envconfig:"FIRST_BLOCK_HEIGHT" default:"1"`
+	IndexWorkerInterval          string   `json:"index_worker_interval" envconfig:"INDEX_WORKER_INTERVAL" default:"@every 15m"`
+	SummarizeWorkerInterval      string   `json:"summarize_worker_interval" envconfig:"SUMMARIZE_WORKER_INTERVAL" default:"@every 20m"`
+	PurgeWorkerInterval          string   `json:"purge_worker_interval" envconfig:"PURGE_WORKER_INTERVAL" default:"@every 1h"`
+	WebhookWorkerInterval        string   `json:"webhook_worker_interval" envconfig:"WEBHOOK_WORKER_INTERVAL" default:"@every 1m"`
+	VerifyWorkerInterval         string   `json:"verify_worker_interval" envconfig:"VERIFY_WORKER_INTERVAL" default:"@every 6h"`
+	VerifyRepair                 bool     `json:"verify_repair" envconfig:"VERIFY_REPAIR"`
+	VerifyRunningGracePeriod     string   `json:"verify_running_grace_period" envconfig:"VERIFY_RUNNING_GRACE_PERIOD" default:"1h"`
+	WorkerLeaderLockKey          int64    `json:"worker_leader_lock_key" envconfig:"WORKER_LEADER_LOCK_KEY" default:"1"`
+	WorkerLeaderCheckInterval    string   `json:"worker_leader_check_interval" envconfig:"WORKER_LEADER_CHECK_INTERVAL" default:"5s"`
+	ReindexWorkerInterval        string   `json:"reindex_worker_interval" envconfig:"REINDEX_WORKER_INTERVAL" default:"@every 1m"`
+	ReindexChunkSize             int64    `json:"reindex_chunk_size" envconfig:"REINDEX_CHUNK_SIZE" default:"1000"`
+	ReindexJobHeartbeat          string   `json:"reindex_job_heartbeat" envconfig:"REINDEX_JOB_HEARTBEAT" default:"30s"`
+	ReindexJobTimeout            string   `json:"reindex_job_timeout" envconfig:"REINDEX_JOB_TIMEOUT" default:"5m"`
+	ReindexJobMaxAttempts        int64    `json:"reindex_job_max_attempts" envconfig:"REINDEX_JOB_MAX_ATTEMPTS" default:"3"`
+	FollowPollInterval           string   `json:"follow_poll_interval" envconfig:"FOLLOW_POLL_INTERVAL" default:"1s"`
+	FollowMaxBackoff             string   `json:"follow_max_backoff" envconfig:"FOLLOW_MAX_BACKOFF" default:"1m"`
+	PrefetchWindow               int64    `json:"prefetch_window" envconfig:"PREFETCH_WINDOW" default:"10"`
+	ProxyCacheDir                string   `json:"proxy_cache_dir" envconfig:"PROXY_CACHE_DIR"`
+	ProxyCacheMaxSizeMB          int64    `json:"proxy_cache_max_size_mb" envconfig:"PROXY_CACHE_MAX_SIZE_MB" default:"10240"`
+	ProxyReplayFile              string   `json:"proxy_replay_file" envconfig:"PROXY_REPLAY_FILE"`
+	WebhookBatchSize             int64    `json:"webhook_batch_size" envconfig:"WEBHOOK_BATCH_SIZE" default:"100"`
+	WebhookMaxAttempts           int64    `json:"webhook_max_attempts" envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookBackoff               string   `json:"webhook_backoff" envconfig:"WEBHOOK_BACKOFF" default:"30s"`
+	WebhookTimeout               string   `json:"webhook_timeout" envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookAllowPrivateHosts     bool     `json:"webhook_allow_private_hosts" envconfig:"WEBHOOK_ALLOW_PRIVATE_HOSTS"`
+	WebhookEnqueueLag            string   `json:"webhook_enqueue_lag" envconfig:"WEBHOOK_ENQUEUE_LAG" default:"1m"`
+	StreamPollInterval           string   `json:"stream_poll_interval" envconfig:"STREAM_POLL_INTERVAL" default:"1s"`
+	StreamBufferSize             int64    `json:"stream_buffer_size" envconfig:"STREAM_BUFFER_SIZE" default:"100"`
+	GraphQLMaxDepth              int64    `json:"graphql_max_depth" envconfig:"GRAPHQL_MAX_DEPTH" default:"7"`
+	GraphQLMaxComplexity         int64    `json:"graphql_max_complexity" envconfig:"GRAPHQL_MAX_COMPLEXITY" default:"5000"`
+	DefaultBatchSize             int64    `json:"default_batch_size" envconfig:"DEFAULT_BATCH_SIZE" default:"0"`
+	DatabaseDSN                  string   `json:"database_dsn" envconfig:"DATABASE_DSN"`
+	Debug                        bool     `json:"debug" envconfig:"DEBUG"`
+	LogLevel                     string   `json:"log_level" envconfig:"LOG_LEVEL" default:"info"`
+	LogOutput                    string   `json:"log_output" envconfig:"LOG_OUTPUT" default:"stdout"`
+	RollbarAccessToken           string   `json:"rollbar_access_token" envconfig:"ROLLBAR_ACCESS_TOKEN"`
+	RollbarServerRoot            string   `json:"rollbar_server_root" envconfig:"ROLLBAR_SERVER_ROOT"`
+	IndexerMetricAddr            string   `json:"indexer_metric_addr" envconfig:"INDEXER_METRIC_ADDR" default:":8080"`
+	ServerMetricAddr             string   `json:"server_metric_addr" envconfig:"SERVER_METRIC_ADDR" default:":8090"`
+	MetricServerUrl              string   `json:"metric_server_url" envconfig:"METRIC_SERVER_URL" default:"/metrics"`
+	PurgeSequencesInterval       string   `json:"purge_sequences_interval" envconfig:"PURGE_SEQUENCES_INTERVAL" default:"24h"`
+	PurgeBalanceEventsInterval   string   `json:"purge_balance_events_interval" envconfig:"PURGE_BALANCE_EVENTS_INTERVAL" default:"24h"`
+	PurgeSystemEventsInterval    string   `json:"purge_system_events_interval" envconfig:"PURGE_SYSTEM_EVENTS_INTERVAL" default:"24h"`
+	PurgeHourlySummariesInterval string   `json:"purge_hourly_summaries_interval" envconfig:"PURGE_HOURLY_SUMMARIES_INTERVAL" default:"24h"`
+	IndexerConfigFile            string   `json:"indexer_config_file" envconfig:"INDEXER_CONFIG_FILE" default:"indexer_config.json"`
+
+	// Proxy request timeouts, per-method ones are keyed by method name, for example "Block.GetByHeight"
+	ProxyTimeout        string            `json:"proxy_timeout" envconfig:"PROXY_TIMEOUT" default:"30s"`
+	ProxyMethodTimeouts map[string]string `json:"proxy_method_timeouts" envconfig:"PROXY_METHOD_TIMEOUTS"`
+
+	// System events thresholds
+	MaxValidatorSequences      int64     `json:"max_validator_sequences" envconfig:"MAX_VALIDATOR_SEQUENCES" default:"1000"`
+	MissedForMaxThreshold      int64     `json:"missed_for_max_threshold" envconfig:"MISSED_FOR_MAX_THRESHOLD" default:"50"`
+	MissedInRowThreshold       int64     `json:"missed_in_row_threshold" envconfig:"MISSED_IN_ROW_THRESHOLD" default:"50"`
+	EscrowBalanceChangeBuckets []float64 `json:"escrow_balance_change_buckets" envconfig:"ESCROW_BALANCE_CHANGE_BUCKETS" default:"0.1,1,10"`
+	CommissionChangeBuckets    []float64 `json:"commission_change_buckets" envconfig:"COMMISSION_CHANGE_BUCKETS" default:"0.1,1,10"`
+
+	// ValidatorThresholds holds per-validator overrides of missed blocks thresholds keyed by validator address
+	ValidatorThresholds map[string]MissedBlocksThresholds `json:"validator_thresholds" ignored:"true"`
+}
+
+// MissedBlocksThresholds holds thresholds used when creating missed blocks system events
+type MissedBlocksThresholds struct {
+	MaxValidatorSequences int64 `json:"max_validator_sequences"`
+	MissedForMaxThreshold int64 `json:"missed_for_max_threshold"`
+	MissedInRowThreshold  int64 `json:"missed_in_row_threshold"`
 }
 
 // Validate returns an error if config is invalid
 func (c *Config) Validate() error {
-	if c.ProxyUrl == "" {
+	if len(c.ProxyUrlList()) == 0 && c.ProxyReplayFile == "" {
 		return errEndpointRequired
 	}
 
@@ -62,6 +121,57 @@ func (c *Config) Validate() error {
 		return errIndexWorkerIntervalRequired
 	}
 
+	if !validBuckets(c.EscrowBalanceChangeBuckets) {
+		return errEscrowBucketsInvalid
+	}
+
+	if !validBuckets(c.CommissionChangeBuckets) {
+		return errCommissionBucketsInvalid
+	}
+
+	if c.StreamPollInterval != "" {
+		if _, err := time.ParseDuration(c.StreamPollInterval); err != nil {
+			return errStreamPollIntervalInvalid
+		}
+	}
+
+	return nil
+}
+
+// MissedBlocksThresholdsFor returns missed blocks thresholds for given validator address.
+// Values not overridden for validator fall back to global ones.
+func (c *Config) MissedBlocksThresholdsFor(address string) MissedBlocksThresholds {
+	thresholds := MissedBlocksThresholds{
+		MaxValidatorSequences: c.MaxValidatorSequences,
+		MissedForMaxThreshold: c.MissedForMaxThreshold,
+		MissedInRowThreshold:  c.MissedInRowThreshold,
+	}
+
+	override, ok := c.ValidatorThresholds[address]
+	if !ok {
+		return thresholds
+	}
+
+	if override.MaxValidatorSequences > 0 {
+		thresholds.MaxValidatorSequences = override.MaxValidatorSequences
+	}
+	if override.MissedForMaxThreshold > 0 {
+		thresholds.MissedForMaxThreshold = override.MissedForMaxThreshold
+	}
+	if override.MissedInRowThreshold > 0 {
+		thresholds.MissedInRowThreshold = override.MissedInRowThreshold
+	}
+	return thresholds
+}
+
+// ProxyUrlList returns urls of all proxies, proxy_urls take precedence over proxy_url
+func (c *Config) ProxyUrlList() []string {
+	if len(c.ProxyUrls) > 0 {
+		return c.ProxyUrls
+	}
+	if c.ProxyUrl != "" {
+		return []string{c.ProxyUrl}
+	}
 	return nil
 }
 
@@ -94,6 +204,19 @@ func FromFile(path string, config *Config) error {
 	return json.Unmarshal(data, config)
 }
 
+// validBuckets checks if there are 3 ascending bucket boundaries
+func validBuckets(buckets []float64) bool {
+	if len(buckets) != 3 {
+		return false
+	}
+	for i := 1; i < len(buckets); i++ {
+		if buckets[i] <= buckets[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
 // FromEnv reads the config from environment variables
 func FromEnv(config *Config) error {
 	return envconfig.Process("", config)
@@ -17,6 +17,11 @@ func TestFromEnv(t *testing.T) {
 	assert.Equal(t, "@every 15m", config.IndexWorkerInterval)
 	assert.Equal(t, int64(1), config.FirstBlockHeight)
 	assert.Equal(t, false, config.Debug)
+	assert.Equal(t, int64(1000), config.MaxValidatorSequences)
+	assert.Equal(t, int64(50), config.MissedForMaxThreshold)
+	assert.Equal(t, int64(50), config.MissedInRowThreshold)
+	assert.Equal(t, []float64{0.1, 1, 10}, config.EscrowBalanceChangeBuckets)
+	assert.Equal(t, []float64{0.1, 1, 10}, config.CommissionChangeBuckets)
 }
 
 func TestListenAddr(t *testing.T) {
@@ -40,3 +45,53 @@ func TestValidate(t *testing.T) {
 	config.IndexWorkerInterval = ""
 	assert.Equal(t, config.Validate(), errIndexWorkerIntervalRequired)
 }
+
+func TestValidateBuckets(t *testing.T) {
+	config := Config{
+		ProxyUrl:                   "endpoint",
+		DatabaseDSN:                "database",
+		IndexWorkerInterval:        "@every 15m",
+		EscrowBalanceChangeBuckets: []float64{0.1, 1},
+		CommissionChangeBuckets:    []float64{0.1, 1, 10},
+	}
+	assert.Equal(t, errEscrowBucketsInvalid, config.Validate())
+
+	config.EscrowBalanceChangeBuckets = []float64{0.1, 10, 1}
+	assert.Equal(t, errEscrowBucketsInvalid, config.Validate())
+
+	config.EscrowBalanceChangeBuckets = []float64{0.1, 1, 10}
+	config.CommissionChangeBuckets = nil
+	assert.Equal(t, errCommissionBucketsInvalid, config.Validate())
+
+	config.CommissionChangeBuckets = []float64{1, 5, 25}
+	assert.NoError(t, config.Validate())
+}
+
+func TestValidateStreamPollInterval(t *testing.T) {
+	config := Config{
+		ProxyUrl:                   "endpoint",
+		DatabaseDSN:                "database",
+		IndexWorkerInterval:        "@every 15m",
+		EscrowBalanceChangeBuckets: []float64{0.1, 1, 10},
+		CommissionChangeBuckets:    []float64{1, 5, 25},
+		StreamPollInterval:         "every second",
+	}
+	assert.Equal(t, errStreamPollIntervalInvalid, config.Validate())
+
+	config.StreamPollInterval = "500ms"
+	assert.NoError(t, config.Validate())
+}
+
+func TestMissedBlocksThresholdsFor(t *testing.T) {
+	config := Config{
+		MaxValidatorSequences: 1000,
+		MissedForMaxThreshold: 50,
+		MissedInRowThreshold:  50,
+		ValidatorThresholds: map[string]MissedBlocksThresholds{
+			"validator1": {MissedInRowThreshold: 5},
+		},
+	}
+
+	assert.Equal(t, MissedBlocksThresholds{1000, 50, 50}, config.MissedBlocksThresholdsFor("validator2"))
+	assert.Equal(t, MissedBlocksThresholds{1000, 50, 5}, config.MissedBlocksThresholdsFor("validator1"))
+}
@@ -3,8 +3,11 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
@@ -26,97 +29,137 @@ var (
 
 type AccountAggCreatorTaskStore interface {
 	FindByPublicKey(key string) (*model.AccountAgg, error)
-	Create(record interface{}) error
-	Save(record interface{}) error
 }
 
-func NewAccountAggCreatorTask(db AccountAggCreatorTaskStore) *accountAggCreatorTask {
+func NewAccountAggCreatorTask(cfg *config.Config, db AccountAggCreatorTaskStore) *accountAggCreatorTask {
 	return &accountAggCreatorTask{
-		db: db,
+		cfg: cfg,
+		db:  db,
 	}
 }
 
 type accountAggCreatorTask struct {
-	db AccountAggCreatorTaskStore
+	cfg *config.Config
+	db  AccountAggCreatorTaskStore
 }
 
 func (t *accountAggCreatorTask) GetName() string {
 	return TaskNameAccountAggCreator
 }
 
+// Run aggregates accounts touched by transfer and escrow events at current height.
+// At first block height all accounts from the ledger are aggregated, so accounts without any events are included as well.
 func (t *accountAggCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageAggregator, t.GetName(), payload.CurrentHeight))
 
+	ledger := payload.RawState.GetStaking().GetLedger()
+
 	var created []model.AccountAgg
 	var updated []model.AccountAgg
-	for publicKey, rawAccount := range payload.RawState.GetStaking().GetLedger() {
+
+	publicKeys := touchedAccounts(payload)
+	if payload.CurrentHeight == t.cfg.FirstBlockHeight {
+		publicKeys = ledgerAccounts(ledger)
+	}
+
+	for _, publicKey := range publicKeys {
+		rawAccount, ok := ledger[publicKey]
+		if !ok {
+			continue
+		}
+
+		accountAgg := &model.AccountAgg{
+			Aggregate: &model.Aggregate{
+				RecentAtHeight: payload.Syncable.Height,
+				RecentAt:       payload.Syncable.Time,
+			},
+
+			PublicKey:                        publicKey,
+			RecentGeneralBalance:             types.NewQuantityFromBytes(rawAccount.GetGeneral().GetBalance()),
+			RecentGeneralNonce:               rawAccount.GetGeneral().GetNonce(),
+			RecentEscrowActiveBalance:        types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetBalance()),
+			RecentEscrowActiveTotalShares:    types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetTotalShares()),
+			RecentEscrowDebondingBalance:     types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetBalance()),
+			RecentEscrowDebondingTotalShares: types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetTotalShares()),
+		}
+
 		existing, err := t.db.FindByPublicKey(publicKey)
 		if err != nil {
 			if err == store.ErrNotFound {
-				accountAgg := &model.AccountAgg{
-					Aggregate: &model.Aggregate{
-						StartedAtHeight: payload.Syncable.Height,
-						StartedAt:       payload.Syncable.Time,
-						RecentAtHeight:  payload.Syncable.Height,
-						RecentAt:        payload.Syncable.Time,
-					},
-
-					PublicKey:                        publicKey,
-					RecentGeneralBalance:             types.NewQuantityFromBytes(rawAccount.GetGeneral().GetBalance()),
-					RecentGeneralNonce:               rawAccount.GetGeneral().GetNonce(),
-					RecentEscrowActiveBalance:        types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetBalance()),
-					RecentEscrowActiveTotalShares:    types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetTotalShares()),
-					RecentEscrowDebondingBalance:     types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetBalance()),
-					RecentEscrowDebondingTotalShares: types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetTotalShares()),
-				}
+				accountAgg.Aggregate.StartedAtHeight = payload.Syncable.Height
+				accountAgg.Aggregate.StartedAt = payload.Syncable.Time
 
 				if !accountAgg.Valid() {
 					return ErrAccountAggNotValid
 				}
 
-				if err := t.db.Create(accountAgg); err != nil {
-					return err
-				}
 				created = append(created, *accountAgg)
-			} else {
-				return err
+				continue
 			}
-		} else {
-			accountAgg := &model.AccountAgg{
-				Aggregate: &model.Aggregate{
-					RecentAtHeight: payload.Syncable.Height,
-					RecentAt:       payload.Syncable.Time,
-				},
+			return err
+		}
 
-				PublicKey:                        publicKey,
-				RecentGeneralBalance:             types.NewQuantityFromBytes(rawAccount.GetGeneral().GetBalance()),
-				RecentGeneralNonce:               rawAccount.GetGeneral().GetNonce(),
-				RecentEscrowActiveBalance:        types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetBalance()),
-				RecentEscrowActiveTotalShares:    types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetTotalShares()),
-				RecentEscrowDebondingBalance:     types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetBalance()),
-				RecentEscrowDebondingTotalShares: types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetTotalShares()),
-			}
+		// Account was already aggregated at later height, ie. when reindexing older heights
+		if existing.RecentAtHeight > payload.Syncable.Height {
+			continue
+		}
 
-			existing.Update(accountAgg)
+		existing.Update(accountAgg)
 
-			if !existing.Valid() {
-				return ErrAccountAggNotValid
-			}
-
-			if err := t.db.Save(existing); err != nil {
-				return err
-			}
-			updated = append(updated, *accountAgg)
+		if !existing.Valid() {
+			return ErrAccountAggNotValid
 		}
+
+		updated = append(updated, *existing)
 	}
 	payload.NewAggregatedAccounts = created
 	payload.UpdatedAggregatedAccounts = updated
 	return nil
 }
 
+// touchedAccounts returns unique accounts which are senders, recipients, owners or escrows of events at current height
+func touchedAccounts(payload *payload) []string {
+	var keys []string
+	seen := map[string]bool{}
+	add := func(key string) {
+		if key == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	for _, rawEvent := range payload.RawTransferEvents {
+		add(rawEvent.GetFrom())
+		add(rawEvent.GetTo())
+	}
+	for _, rawEvent := range payload.RawEscrowEvents.GetAdd() {
+		add(rawEvent.GetOwner())
+		add(rawEvent.GetEscrow())
+	}
+	for _, rawEvent := range payload.RawEscrowEvents.GetTake() {
+		add(rawEvent.GetOwner())
+	}
+	for _, rawEvent := range payload.RawEscrowEvents.GetReclaim() {
+		add(rawEvent.GetOwner())
+		add(rawEvent.GetEscrow())
+	}
+	return keys
+}
+
+// ledgerAccounts returns all accounts from the ledger sorted by public key
+func ledgerAccounts(ledger map[string]*accountpb.Account) []string {
+	keys := make([]string, 0, len(ledger))
+	for key := range ledger {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func NewValidatorAggCreatorTask(db ValidatorAggCreatorTaskStore) *validatorAggCreatorTask {
 	return &validatorAggCreatorTask{
 		db: db,
@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/config"
 	mock "github.com/figment-networks/oasishub-indexer/mock/indexer"
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
@@ -25,6 +27,9 @@ func TestAccountAggCreatorTask_Run(t *testing.T) {
 		description string
 		new         accountLedger
 		existing    accountLedger
+		stale       accountLedger
+		untouched   accountLedger
+		firstHeight bool
 		expectErr   error
 	}{
 		{
@@ -57,28 +62,47 @@ func TestAccountAggCreatorTask_Run(t *testing.T) {
 			expectErr: nil,
 		},
 		{
-			description: "return error if there's an unexpected db error on findByPublicKey",
+			description: "skips accounts not touched by events",
 			new: accountLedger{
 				"pkey1": testAccount(),
 			},
-			existing:  accountLedger{},
-			expectErr: errTestDbFind,
+			existing: accountLedger{},
+			untouched: accountLedger{
+				"pkey2": testAccount(),
+			},
+			expectErr: nil,
+		},
+		{
+			description: "skips accounts aggregated at later height",
+			new:         accountLedger{},
+			existing: accountLedger{
+				"pkey1": testAccount(),
+			},
+			stale: accountLedger{
+				"pkey2": testAccount(),
+			},
+			expectErr: nil,
 		},
 		{
-			description: "return error if there's a db error on create",
+			description: "creates all ledger accounts at first height",
 			new: accountLedger{
 				"pkey1": testAccount(),
 			},
-			existing:  accountLedger{},
-			expectErr: errTestDbCreate,
+			existing: accountLedger{},
+			untouched: accountLedger{
+				"pkey2": testAccount(),
+				"pkey3": testAccount(),
+			},
+			firstHeight: true,
+			expectErr:   nil,
 		},
 		{
-			description: "return error if there's a db error on save",
-			new:         accountLedger{},
-			existing: accountLedger{
+			description: "return error if there's an unexpected db error on findByPublicKey",
+			new: accountLedger{
 				"pkey1": testAccount(),
 			},
-			expectErr: errTestDbSave,
+			existing:  accountLedger{},
+			expectErr: errTestDbFind,
 		},
 	}
 
@@ -93,38 +117,44 @@ func TestAccountAggCreatorTask_Run(t *testing.T) {
 
 			dbMock := mock.NewMockAccountAggCreatorTaskStore(ctrl)
 
-			ledger := combineLedgers(tt.new, tt.existing)
-			payload := testAccountAggPayload(ledger)
+			touched := combineLedgers(combineLedgers(tt.new, tt.existing), tt.stale)
+			payload := testAccountAggPayload(combineLedgers(touched, tt.untouched))
+			for key := range touched {
+				payload.RawTransferEvents = append(payload.RawTransferEvents, &eventpb.TransferEvent{From: key})
+			}
+
+			cfg := &config.Config{FirstBlockHeight: 1}
+			expectedNew := tt.new
+			if tt.firstHeight {
+				cfg.FirstBlockHeight = payload.CurrentHeight
+				expectedNew = combineLedgers(tt.new, tt.untouched)
+			}
 
-			for key, acnt := range tt.new {
+			expectNew := map[string]*model.AccountAgg{}
+			for key, acnt := range expectedNew {
 				if tt.expectErr == errTestDbFind {
 					dbMock.EXPECT().FindByPublicKey(key).Return(nil, errTestDbFind).Times(1)
 					break
 				}
 				dbMock.EXPECT().FindByPublicKey(key).Return(nil, store.ErrNotFound).Times(1)
 				newAccount := newAccountAgg(key, payload.Syncable.Height, payload.Syncable.Time)
-				updatedAccount := updateAccountAgg(newAccount, acnt, payload)
-				if tt.expectErr == errTestDbCreate {
-					dbMock.EXPECT().Create(updatedAccount).Return(errTestDbCreate).Times(1)
-					break
-				}
-				dbMock.EXPECT().Create(updatedAccount).Return(nil).Times(1)
+				expectNew[key] = updateAccountAgg(newAccount, acnt, payload)
 			}
 
+			expectUpdated := map[string]*model.AccountAgg{}
 			for key, acnt := range tt.existing {
 				existingAccount := newAccountAgg(key, 0, *types.NewTimeFromTime(time.Now()))
 				dbMock.EXPECT().FindByPublicKey(key).Return(existingAccount, nil).Times(1)
-				updatedAcnt := updateAccountAgg(existingAccount, acnt, payload)
-
-				if tt.expectErr == errTestDbSave {
-					dbMock.EXPECT().Save(updatedAcnt).Return(errTestDbSave).Times(1)
-					break
-				}
+				expectUpdated[key] = updateAccountAgg(existingAccount, acnt, payload)
+			}
 
-				dbMock.EXPECT().Save(updatedAcnt).Return(nil).Times(1)
+			for key := range tt.stale {
+				staleAccount := newAccountAgg(key, 0, *types.NewTimeFromTime(time.Now()))
+				staleAccount.RecentAtHeight = payload.Syncable.Height + 1
+				dbMock.EXPECT().FindByPublicKey(key).Return(staleAccount, nil).Times(1)
 			}
 
-			task := NewAccountAggCreatorTask(dbMock)
+			task := NewAccountAggCreatorTask(cfg, dbMock)
 			if err := task.Run(ctx, payload); err != tt.expectErr {
 				t.Errorf("unexpected error, got: %v; want: %v", err, tt.expectErr)
 				return
@@ -135,15 +165,27 @@ func TestAccountAggCreatorTask_Run(t *testing.T) {
 				return
 			}
 
-			if len(payload.NewAggregatedAccounts) != len(tt.new) {
-				t.Errorf("expected payload.NewAggregatedAccounts to contain new accounts, got: %v; want: %v", len(payload.NewAggregatedAccounts), len(tt.new))
+			if len(payload.NewAggregatedAccounts) != len(expectedNew) {
+				t.Errorf("expected payload.NewAggregatedAccounts to contain new accounts, got: %v; want: %v", len(payload.NewAggregatedAccounts), len(expectedNew))
 				return
 			}
 
+			for _, val := range payload.NewAggregatedAccounts {
+				if expectVal, ok := expectNew[val.PublicKey]; !ok || !reflect.DeepEqual(val, *expectVal) {
+					t.Errorf("unexpected entry in payload.NewAggregatedAccounts, got: %v; want: %v", val, expectVal)
+				}
+			}
+
 			if len(payload.UpdatedAggregatedAccounts) != len(tt.existing) {
 				t.Errorf("expected payload.UpdatedAggregatedAccounts to contain accounts, got: %v; want: %v", len(payload.UpdatedAggregatedAccounts), len(tt.existing))
 				return
 			}
+
+			for _, val := range payload.UpdatedAggregatedAccounts {
+				if expectVal, ok := expectUpdated[val.PublicKey]; !ok || !reflect.DeepEqual(val, *expectVal) {
+					t.Errorf("unexpected entry in payload.UpdatedAggregatedAccounts, got: %v; want: %v", val, expectVal)
+				}
+			}
 		})
 	}
 }
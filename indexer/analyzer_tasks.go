@@ -23,9 +23,16 @@ var (
 	ErrActiveEscrowBalanceOutsideOfRange = errors.New("active escrow balance is outside of specified buckets")
 	ErrCommissionOutsideOfRange          = errors.New("commission is outside of specified buckets")
 
-	MaxValidatorSequences int64 = 1000
-	MissedForMaxThreshold int64 = 50
-	MissedInRowThreshold  int64 = 50
+	activeEscrowBalanceChangeKinds = []model.SystemEventKind{
+		model.SystemEventActiveEscrowBalanceChange1,
+		model.SystemEventActiveEscrowBalanceChange2,
+		model.SystemEventActiveEscrowBalanceChange3,
+	}
+	commissionChangeKinds = []model.SystemEventKind{
+		model.SystemEventCommissionChange1,
+		model.SystemEventCommissionChange2,
+		model.SystemEventCommissionChange3,
+	}
 )
 
 // NewSystemEventCreatorTask creates system events
@@ -83,6 +90,12 @@ func (t *systemEventCreatorTask) Run(ctx context.Context, p pipeline.Payload) er
 	}
 	payload.SystemEvents = append(payload.SystemEvents, missedBlocksSystemEvents...)
 
+	slashedSystemEvents, err := t.getSlashedSystemEvents(payload)
+	if err != nil {
+		return err
+	}
+	payload.SystemEvents = append(payload.SystemEvents, slashedSystemEvents...)
+
 	return nil
 }
 
@@ -108,7 +121,9 @@ func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidator
 			return systemEvents, nil
 		}
 
-		lastValidatorSequencesForAddress, err := t.SystemEventCreatorStore.FindLastByAddress(validatorSequence.Address, MaxValidatorSequences)
+		thresholds := t.cfg.MissedBlocksThresholdsFor(validatorSequence.Address)
+
+		lastValidatorSequencesForAddress, err := t.SystemEventCreatorStore.FindLastByAddress(validatorSequence.Address, thresholds.MaxValidatorSequences)
 		if err != nil {
 			if err == store.ErrNotFound {
 				return systemEvents, nil
@@ -120,12 +135,12 @@ func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidator
 			validatorSequencesToCheck = append([]model.ValidatorSeq{validatorSequence}, lastValidatorSequencesForAddress...)
 			totalMissedCount := t.getTotalMissed(validatorSequencesToCheck)
 
-			logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", MaxValidatorSequences, validatorSequence.Address, totalMissedCount))
+			logger.Debug(fmt.Sprintf("total missed blocks for last %d blocks for address %s: %d", thresholds.MaxValidatorSequences, validatorSequence.Address, totalMissedCount))
 
-			if totalMissedCount == MissedForMaxThreshold {
+			if totalMissedCount == thresholds.MissedForMaxThreshold {
 				newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventMissedNofM, systemEventRawData{
-					"threshold":               MissedForMaxThreshold,
-					"max_validator_sequences": MaxValidatorSequences,
+					"threshold":               thresholds.MissedForMaxThreshold,
+					"max_validator_sequences": thresholds.MaxValidatorSequences,
 				})
 				if err != nil {
 					return nil, err
@@ -134,13 +149,13 @@ func (t *systemEventCreatorTask) getMissedBlocksSystemEvents(currHeightValidator
 				systemEvents = append(systemEvents, newSystemEvent)
 			}
 
-			missedInRowCount := t.getMissedInRow(validatorSequencesToCheck, MissedInRowThreshold)
+			missedInRowCount := t.getMissedInRow(validatorSequencesToCheck, thresholds.MissedInRowThreshold)
 
 			logger.Debug(fmt.Sprintf("total missed blocks in a row for address %s: %d", validatorSequence.Address, missedInRowCount))
 
-			if missedInRowCount == MissedInRowThreshold {
+			if missedInRowCount == thresholds.MissedInRowThreshold {
 				newSystemEvent, err := t.newSystemEvent(validatorSequence, model.SystemEventMissedNConsecutive, systemEventRawData{
-					"threshold": MissedInRowThreshold,
+					"threshold": thresholds.MissedInRowThreshold,
 				})
 				if err != nil {
 					return nil, err
@@ -167,7 +182,7 @@ func (t systemEventCreatorTask) getTotalMissed(validatorSequences []model.Valida
 
 // getMissedInRow get number of validator sequences missed in the row
 func (t systemEventCreatorTask) getMissedInRow(validatorSequences []model.ValidatorSeq, limit int64) int64 {
-	if int64(len(validatorSequences)) > MissedInRowThreshold {
+	if int64(len(validatorSequences)) > limit {
 		validatorSequences = validatorSequences[:limit]
 	}
 
@@ -244,6 +259,51 @@ func (t *systemEventCreatorTask) getActiveSetPresenceChangeSystemEvents(currHeig
 	return systemEvents, nil
 }
 
+func (t *systemEventCreatorTask) getSlashedSystemEvents(payload *payload) ([]*model.SystemEvent, error) {
+	var systemEvents []*model.SystemEvent
+
+	slashed := getSlashed(payload.RawEscrowEvents.GetTake())
+	if len(slashed) == 0 {
+		return systemEvents, nil
+	}
+
+	// Delegators affected by slashing are the ones with slash balance events created in parser stage
+	affectedDelegators := make(map[string]map[string]struct{})
+	for _, balanceEvent := range payload.BalanceEvents {
+		if balanceEvent.Kind != model.SlashActive && balanceEvent.Kind != model.SlashDebonding {
+			continue
+		}
+		if _, ok := affectedDelegators[balanceEvent.EscrowAddress]; !ok {
+			affectedDelegators[balanceEvent.EscrowAddress] = make(map[string]struct{})
+		}
+		affectedDelegators[balanceEvent.EscrowAddress][balanceEvent.Address] = struct{}{}
+	}
+
+	for address, amount := range slashed {
+		logger.Debug(fmt.Sprintf("address %s has been slashed [amount=%s]", address, amount.String()))
+
+		seq := model.ValidatorSeq{
+			Sequence: &model.Sequence{
+				Height: payload.Syncable.Height,
+				Time:   payload.Syncable.Time,
+			},
+			Address: address,
+		}
+
+		newSystemEvent, err := t.newSystemEvent(seq, model.SystemEventValidatorSlashed, systemEventRawData{
+			"amount":              amount.String(),
+			"affected_delegators": len(affectedDelegators[address]),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		systemEvents = append(systemEvents, newSystemEvent)
+	}
+
+	return systemEvents, nil
+}
+
 func (t *systemEventCreatorTask) getValueChangeSystemEvents(currHeightValidatorSequences []model.ValidatorSeq, prevHeightValidatorSequences []model.ValidatorSeq) ([]*model.SystemEvent, error) {
 	var systemEvents []*model.SystemEvent
 	for _, validatorSequence := range currHeightValidatorSequences {
@@ -281,14 +341,8 @@ func (t *systemEventCreatorTask) getActiveEscrowBalanceChange(currValidatorSeq m
 	roundedChangeRate := t.getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventActiveEscrowBalanceChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventActiveEscrowBalanceChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventActiveEscrowBalanceChange3
-	} else {
+	kind, ok := t.getChangeKind(roundedAbsChangeRate, t.cfg.EscrowBalanceChangeBuckets, activeEscrowBalanceChangeKinds)
+	if !ok {
 		return nil, ErrActiveEscrowBalanceOutsideOfRange
 	}
 
@@ -305,14 +359,8 @@ func (t *systemEventCreatorTask) getCommissionChange(currValidatorSeq model.Vali
 	roundedChangeRate := t.getRoundedChangeRate(currValue, prevValue)
 	roundedAbsChangeRate := math.Abs(roundedChangeRate)
 
-	var kind model.SystemEventKind
-	if roundedAbsChangeRate >= 0.1 && roundedAbsChangeRate < 1 {
-		kind = model.SystemEventCommissionChange1
-	} else if roundedAbsChangeRate >= 1 && roundedAbsChangeRate < 10 {
-		kind = model.SystemEventCommissionChange2
-	} else if roundedAbsChangeRate >= 10 {
-		kind = model.SystemEventCommissionChange3
-	} else {
+	kind, ok := t.getChangeKind(roundedAbsChangeRate, t.cfg.CommissionChangeBuckets, commissionChangeKinds)
+	if !ok {
 		return nil, ErrCommissionOutsideOfRange
 	}
 
@@ -323,6 +371,20 @@ func (t *systemEventCreatorTask) getCommissionChange(currValidatorSeq model.Vali
 	})
 }
 
+// getChangeKind gets system event kind for the highest bucket boundary reached by change rate
+func (t *systemEventCreatorTask) getChangeKind(absChangeRate float64, buckets []float64, kinds []model.SystemEventKind) (model.SystemEventKind, bool) {
+	var kind model.SystemEventKind
+	ok := false
+	for i, boundary := range buckets {
+		if i >= len(kinds) || absChangeRate < boundary {
+			break
+		}
+		kind = kinds[i]
+		ok = true
+	}
+	return kind, ok
+}
+
 func (t *systemEventCreatorTask) getRoundedChangeRate(currValue int64, prevValue int64) float64 {
 	var changeRate float64
 
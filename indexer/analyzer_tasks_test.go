@@ -2,10 +2,13 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/oasishub-indexer/config"
 	mock_indexer "github.com/figment-networks/oasishub-indexer/mock/indexer"
 	"github.com/figment-networks/oasishub-indexer/model"
@@ -24,7 +27,12 @@ var (
 	ErrCouldNotFindByAddress    = errors.New("could not find test")
 
 	testCfg = &config.Config{
-		FirstBlockHeight: 1,
+		FirstBlockHeight:           1,
+		MaxValidatorSequences:      10,
+		MissedInRowThreshold:       5,
+		MissedForMaxThreshold:      5,
+		EscrowBalanceChangeBuckets: []float64{0.1, 1, 10},
+		CommissionChangeBuckets:    []float64{0.1, 1, 10},
 	}
 )
 
@@ -35,10 +43,6 @@ func TestSystemEventCreatorTask_Run(t *testing.T) {
 
 		validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
 
-		MaxValidatorSequences = 10
-		MissedInRowThreshold = 5
-		MissedForMaxThreshold = 5
-
 		prevHeightValidatorSequences := []model.ValidatorSeq{
 			newValidatorSeq(testValidatorAddress, 1000, 0, true),
 		}
@@ -221,6 +225,59 @@ func TestSystemEventCreatorTask_getValueChangeSystemEvents(t *testing.T) {
 	}
 }
 
+func TestSystemEventCreatorTask_getValueChangeSystemEventsWithCustomBuckets(t *testing.T) {
+	cfg := *testCfg
+	cfg.EscrowBalanceChangeBuckets = []float64{5, 20, 50}
+	cfg.CommissionChangeBuckets = []float64{1, 2, 3}
+
+	tests := []struct {
+		description                   string
+		activeEscrowBalanceChangeRate float64
+		commissionChangeRate          float64
+		expectedKinds                 []model.SystemEventKind
+	}{
+		{"returns no system events when changes are below lowest buckets", 4, 0.5, nil},
+		{"returns activeEscrowBalanceChange1 system event when active escrow balance change reaches first bucket", 5, 0, []model.SystemEventKind{model.SystemEventActiveEscrowBalanceChange1}},
+		{"returns activeEscrowBalanceChange2 system event when active escrow balance change reaches second bucket", 20, 0, []model.SystemEventKind{model.SystemEventActiveEscrowBalanceChange2}},
+		{"returns commissionChange3 system event when commission change reaches third bucket", 0, 3, []model.SystemEventKind{model.SystemEventCommissionChange3}},
+		{"returns both system events when both changes reach buckets", 50, 2, []model.SystemEventKind{model.SystemEventActiveEscrowBalanceChange3, model.SystemEventCommissionChange2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+
+			var before int64 = 1000
+			activeEscrowBalanceAfter := float64(before) + (float64(before) * tt.activeEscrowBalanceChangeRate / 100)
+			commissionAfter := float64(before) + (float64(before) * tt.commissionChangeRate / 100)
+
+			prevHeightValidatorSequences := []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, before, before, true),
+			}
+			currHeightValidatorSequences := []model.ValidatorSeq{
+				newValidatorSeq(testValidatorAddress, int64(activeEscrowBalanceAfter), int64(commissionAfter), true),
+			}
+
+			task := NewSystemEventCreatorTask(&cfg, validatorSeqStoreMock)
+			createdSystemEvents, _ := task.getValueChangeSystemEvents(currHeightValidatorSequences, prevHeightValidatorSequences)
+
+			if len(createdSystemEvents) != len(tt.expectedKinds) {
+				t.Errorf("unexpected system event count, want %v; got %v", len(tt.expectedKinds), len(createdSystemEvents))
+				return
+			}
+
+			for i, kind := range tt.expectedKinds {
+				if createdSystemEvents[i].Kind != kind {
+					t.Errorf("unexpected system event kind, want %v; got %v", kind, createdSystemEvents[i].Kind)
+				}
+			}
+		})
+	}
+}
+
 func TestSystemEventCreatorTask_getActiveSetPresenceChangeSystemEvents(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -586,9 +643,10 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 
 			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
 
-			MaxValidatorSequences = tt.maxValidatorSequences
-			MissedInRowThreshold = tt.missedInRowThreshold
-			MissedForMaxThreshold = tt.missedForMaxThreshold
+			cfg := *testCfg
+			cfg.MaxValidatorSequences = tt.maxValidatorSequences
+			cfg.MissedInRowThreshold = tt.missedInRowThreshold
+			cfg.MissedForMaxThreshold = tt.missedForMaxThreshold
 
 			var mockCalls []*gomock.Call
 			for i, validatorSeqs := range tt.lastForValidatorList {
@@ -607,7 +665,7 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 			}
 			gomock.InOrder(mockCalls...)
 
-			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+			task := NewSystemEventCreatorTask(&cfg, validatorSeqStoreMock)
 			createdSystemEvents, err := task.getMissedBlocksSystemEvents(tt.currHeightList)
 			if err == nil && tt.expectedErr != nil {
 				t.Errorf("should return error")
@@ -632,6 +690,143 @@ func TestSystemEventCreatorTask_getMissedBlocksSystemEvents(t *testing.T) {
 	}
 }
 
+func TestSystemEventCreatorTask_getMissedBlocksSystemEventsWithValidatorOverrides(t *testing.T) {
+	cfg := *testCfg
+	cfg.MaxValidatorSequences = 1000
+	cfg.MissedInRowThreshold = 50
+	cfg.MissedForMaxThreshold = 50
+	cfg.ValidatorThresholds = map[string]config.MissedBlocksThresholds{
+		testValidatorAddress: {MaxValidatorSequences: 5, MissedInRowThreshold: 3},
+	}
+
+	lastValidatorSeqs := func(address string) []model.ValidatorSeq {
+		return []model.ValidatorSeq{
+			newValidatorSeq(address, 1000, 0, false),
+			newValidatorSeq(address, 1000, 0, false),
+			newValidatorSeq(address, 1000, 0, true),
+		}
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+	gomock.InOrder(
+		validatorSeqStoreMock.EXPECT().FindLastByAddress(testValidatorAddress, int64(5)).Return(lastValidatorSeqs(testValidatorAddress), nil),
+		validatorSeqStoreMock.EXPECT().FindLastByAddress("address1", int64(1000)).Return(lastValidatorSeqs("address1"), nil),
+	)
+
+	task := NewSystemEventCreatorTask(&cfg, validatorSeqStoreMock)
+	createdSystemEvents, err := task.getMissedBlocksSystemEvents([]model.ValidatorSeq{
+		newValidatorSeq(testValidatorAddress, 1000, 0, false),
+		newValidatorSeq("address1", 1000, 0, false),
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if len(createdSystemEvents) != 1 {
+		t.Errorf("unexpected system event count, want %v; got %v", 1, len(createdSystemEvents))
+		return
+	}
+
+	if createdSystemEvents[0].Kind != model.SystemEventMissedNConsecutive || createdSystemEvents[0].Actor != testValidatorAddress {
+		t.Errorf("unexpected system event, want %v for %v; got %v for %v", model.SystemEventMissedNConsecutive, testValidatorAddress, createdSystemEvents[0].Kind, createdSystemEvents[0].Actor)
+	}
+}
+
+func TestSystemEventCreatorTask_getSlashedSystemEvents(t *testing.T) {
+	tests := []struct {
+		description           string
+		takeEvents            []*eventpb.TakeEscrowEvent
+		balanceEvents         []model.BalanceEvent
+		expectedCount         int
+		expectedAmount        string
+		expectedAffectedCount float64
+	}{
+		{
+			description:   "returns no system events when there are no take escrow events",
+			expectedCount: 0,
+		},
+		{
+			description: "returns validator slashed system event",
+			takeEvents: []*eventpb.TakeEscrowEvent{
+				{Owner: testValidatorAddress, Amount: big.NewInt(300).Bytes()},
+			},
+			balanceEvents: []model.BalanceEvent{
+				{Address: "delegator1", EscrowAddress: testValidatorAddress, Kind: model.SlashActive},
+				{Address: "delegator1", EscrowAddress: testValidatorAddress, Kind: model.SlashDebonding},
+				{Address: "delegator2", EscrowAddress: testValidatorAddress, Kind: model.SlashDebonding},
+				{Address: "delegator3", EscrowAddress: testValidatorAddress, Kind: model.Reward},
+				{Address: "delegator4", EscrowAddress: "other_address", Kind: model.SlashActive},
+			},
+			expectedCount:         1,
+			expectedAmount:        "300",
+			expectedAffectedCount: 2,
+		},
+		{
+			description: "returns validator slashed system event when there are no delegators",
+			takeEvents: []*eventpb.TakeEscrowEvent{
+				{Owner: testValidatorAddress, Amount: big.NewInt(10).Bytes()},
+			},
+			expectedCount:         1,
+			expectedAmount:        "10",
+			expectedAffectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			validatorSeqStoreMock := mock_indexer.NewMockSystemEventCreatorStore(ctrl)
+
+			payload := testPayload()
+			payload.RawEscrowEvents = &eventpb.EscrowEvents{Take: tt.takeEvents}
+			payload.BalanceEvents = tt.balanceEvents
+
+			task := NewSystemEventCreatorTask(testCfg, validatorSeqStoreMock)
+			createdSystemEvents, err := task.getSlashedSystemEvents(payload)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if len(createdSystemEvents) != tt.expectedCount {
+				t.Errorf("unexpected system event count, want %v; got %v", tt.expectedCount, len(createdSystemEvents))
+				return
+			}
+
+			for _, systemEvent := range createdSystemEvents {
+				if systemEvent.Kind != model.SystemEventValidatorSlashed || systemEvent.Actor != testValidatorAddress {
+					t.Errorf("unexpected system event, want %v for %v; got %v for %v", model.SystemEventValidatorSlashed, testValidatorAddress, systemEvent.Kind, systemEvent.Actor)
+				}
+
+				if systemEvent.Height != testHeight {
+					t.Errorf("unexpected height, want %v; got %v", testHeight, systemEvent.Height)
+				}
+
+				var data map[string]interface{}
+				if err := json.Unmarshal(systemEvent.Data.RawMessage, &data); err != nil {
+					t.Errorf("unexpected error: %v", err)
+					return
+				}
+
+				if data["amount"] != tt.expectedAmount {
+					t.Errorf("unexpected amount, want %v; got %v", tt.expectedAmount, data["amount"])
+				}
+
+				if data["affected_delegators"] != tt.expectedAffectedCount {
+					t.Errorf("unexpected affected delegators, want %v; got %v", tt.expectedAffectedCount, data["affected_delegators"])
+				}
+			}
+		})
+	}
+}
+
 func testPayload() *payload {
 	return &payload{
 		Syncable: &model.Syncable{
@@ -16,6 +16,7 @@ const (
 	IndexTargetSystemEvents
 	IndexValidatorRewards
 	IndexBalanceEvents
+	IndexTransferAndEscrowEvents
 )
 
 var (
@@ -35,7 +35,12 @@ func (t *BlockFetcherTask) GetName() string {
 func (t *BlockFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
-	block, err := t.client.GetByHeight(payload.CurrentHeight)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawBlock = data.block.GetBlock()
+		return nil
+	}
+
+	block, err := t.client.GetByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -68,8 +73,13 @@ func (t *EventsFetcherTask) GetName() string {
 
 func (t *EventsFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 	payload := p.(*payload)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawEscrowEvents = data.escrowEvents.GetEvents()
+		payload.RawTransferEvents = data.transferEvents.GetEvents()
+		return nil
+	}
 
-	escrow, err := t.client.GetEscrowEventsByHeight(payload.CurrentHeight)
+	escrow, err := t.client.GetEscrowEventsByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -84,12 +94,7 @@ func (t *EventsFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 		logger.Field("height", payload.CurrentHeight),
 	)
 
-	// only fetch transfer events if there are add escrow events -  needed for rewards calculations in parser
-	if len(payload.RawEscrowEvents.GetAdd()) == 0 {
-		return nil
-	}
-
-	transfer, err := t.client.GetTransferEventsByHeight(payload.CurrentHeight)
+	transfer, err := t.client.GetTransferEventsByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -115,7 +120,12 @@ func (t *StateFetcherTask) GetName() string {
 func (t *StateFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
-	state, err := t.client.GetByHeight(payload.CurrentHeight)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawState = data.state.GetState()
+		return nil
+	}
+
+	state, err := t.client.GetByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -149,7 +159,12 @@ func (t *StakingStateFetcherTask) GetName() string {
 func (t *StakingStateFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
-	state, err := t.client.GetStakingByHeight(payload.CurrentHeight)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawStakingState = data.stakingState.GetStaking()
+		return nil
+	}
+
+	state, err := t.client.GetStakingByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -183,7 +198,12 @@ func (t *ValidatorFetcherTask) GetName() string {
 func (t *ValidatorFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
-	validators, err := t.client.GetByHeight(payload.CurrentHeight)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawValidators = data.validators.GetValidators()
+		return nil
+	}
+
+	validators, err := t.client.GetByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
@@ -217,7 +237,12 @@ func (t *TransactionFetcherTask) GetName() string {
 func (t *TransactionFetcherTask) Run(ctx context.Context, p pipeline.Payload) error {
 
 	payload := p.(*payload)
-	transactions, err := t.client.GetByHeight(payload.CurrentHeight)
+	if data := getPrefetched(ctx, payload.CurrentHeight); data != nil {
+		payload.RawTransactions = data.transactions.GetTransactions()
+		return nil
+	}
+
+	transactions, err := t.client.GetByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
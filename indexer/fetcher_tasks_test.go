@@ -37,7 +37,7 @@ func TestBlockFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 20}
 
-			mockClient.EXPECT().GetByHeight(pl.CurrentHeight).Return(&blockpb.GetByHeightResponse{Block: tt.expectedBlock}, tt.result).Times(1)
+			mockClient.EXPECT().GetByHeight(gomock.Any(), pl.CurrentHeight).Return(&blockpb.GetByHeightResponse{Block: tt.expectedBlock}, tt.result).Times(1)
 
 			if result := task.Run(ctx, pl); result != tt.result {
 				t.Errorf("want %v; got %v", tt.result, result)
@@ -79,7 +79,7 @@ func TestStateFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 30}
 
-			mockClient.EXPECT().GetByHeight(pl.CurrentHeight).Return(&statepb.GetByHeightResponse{State: tt.expectedState}, tt.result).Times(1)
+			mockClient.EXPECT().GetByHeight(gomock.Any(), pl.CurrentHeight).Return(&statepb.GetByHeightResponse{State: tt.expectedState}, tt.result).Times(1)
 
 			if result := task.Run(ctx, pl); result != tt.result {
 				t.Errorf("want %v; got %v", tt.result, result)
@@ -121,7 +121,7 @@ func TestStakingStateFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 30}
 
-			mockClient.EXPECT().GetStakingByHeight(pl.CurrentHeight).Return(&statepb.GetStakingByHeightResponse{Staking: tt.expectedStaking}, tt.result).Times(1)
+			mockClient.EXPECT().GetStakingByHeight(gomock.Any(), pl.CurrentHeight).Return(&statepb.GetStakingByHeightResponse{Staking: tt.expectedStaking}, tt.result).Times(1)
 
 			if result := task.Run(ctx, pl); result != tt.result {
 				t.Errorf("want %v; got %v", tt.result, result)
@@ -164,7 +164,7 @@ func TestValidatorFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 30}
 
-			mockClient.EXPECT().GetByHeight(pl.CurrentHeight).Return(&validatorpb.GetByHeightResponse{Validators: tt.expectedValidators}, tt.result).Times(1)
+			mockClient.EXPECT().GetByHeight(gomock.Any(), pl.CurrentHeight).Return(&validatorpb.GetByHeightResponse{Validators: tt.expectedValidators}, tt.result).Times(1)
 
 			if result := task.Run(ctx, pl); result != tt.result {
 				t.Errorf("want %v; got %v", tt.result, result)
@@ -216,7 +216,7 @@ func TestTransactionFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 30}
 
-			mockClient.EXPECT().GetByHeight(pl.CurrentHeight).Return(&transactionpb.GetByHeightResponse{Transactions: tt.expectedTransactions}, tt.result).Times(1)
+			mockClient.EXPECT().GetByHeight(gomock.Any(), pl.CurrentHeight).Return(&transactionpb.GetByHeightResponse{Transactions: tt.expectedTransactions}, tt.result).Times(1)
 
 			if result := task.Run(ctx, pl); result != tt.result {
 				t.Errorf("want %v; got %v", tt.result, result)
@@ -266,6 +266,22 @@ func TestEventFetcher_Run(t *testing.T) {
 				To:     "escrowAddr",
 				Amount: randBytes(5),
 			}}, nil},
+		{"fetches transfers when there are no add escrow events", &eventpb.EscrowEvents{
+			Take: []*eventpb.TakeEscrowEvent{{
+				Owner:  "ownerAddr",
+				Amount: randBytes(5),
+			}}}, true, []*eventpb.TransferEvent{
+			{
+				From:   "fromAddr",
+				To:     "toAddr",
+				Amount: randBytes(5),
+			}}, nil},
+		{"fetches transfers when there are no escrow events", nil, true, []*eventpb.TransferEvent{
+			{
+				From:   "fromAddr",
+				To:     "toAddr",
+				Amount: randBytes(5),
+			}}, nil},
 	}
 
 	for _, tt := range tests {
@@ -280,12 +296,12 @@ func TestEventFetcher_Run(t *testing.T) {
 
 			pl := &payload{CurrentHeight: 20}
 
-			mockClient.EXPECT().GetEscrowEventsByHeight(pl.CurrentHeight).Return(
+			mockClient.EXPECT().GetEscrowEventsByHeight(gomock.Any(), pl.CurrentHeight).Return(
 				&eventpb.GetEscrowEventsByHeightResponse{Events: tt.expectedEscrow}, tt.result,
 			).Times(1)
 
 			if tt.callTransfer {
-				mockClient.EXPECT().GetTransferEventsByHeight(pl.CurrentHeight).Return(
+				mockClient.EXPECT().GetTransferEventsByHeight(gomock.Any(), pl.CurrentHeight).Return(
 					&eventpb.GetTransferEventsByHeightResponse{Events: tt.expectedTransfer}, tt.result,
 				).Times(1)
 			}
@@ -306,7 +322,7 @@ func TestEventFetcher_Run(t *testing.T) {
 			}
 
 			if !reflect.DeepEqual(pl.RawTransferEvents, tt.expectedTransfer) {
-				t.Errorf("want: %+v, got: %+v", tt.expectedTransfer, pl.RawEscrowEvents)
+				t.Errorf("want: %+v, got: %+v", tt.expectedTransfer, pl.RawTransferEvents)
 				return
 			}
 		})
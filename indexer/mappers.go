@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
@@ -163,3 +164,79 @@ func DebondingDelegationToSequence(syncable *model.Syncable, rawState *statepb.S
 	}
 	return delegations, nil
 }
+
+func TransferEventToSequence(syncable *model.Syncable, rawTransferEvents []*eventpb.TransferEvent) ([]model.TransferEventSeq, error) {
+	var transferEvents []model.TransferEventSeq
+	for _, rawTransferEvent := range rawTransferEvents {
+		e := model.TransferEventSeq{
+			Sequence: &model.Sequence{
+				Height: syncable.Height,
+				Time:   syncable.Time,
+			},
+
+			FromAddress: rawTransferEvent.GetFrom(),
+			ToAddress:   rawTransferEvent.GetTo(),
+			Amount:      types.NewQuantityFromBytes(rawTransferEvent.GetAmount()),
+		}
+
+		if !e.Valid() {
+			return nil, errors.New("transfer event sequence not valid")
+		}
+
+		transferEvents = append(transferEvents, e)
+	}
+	return transferEvents, nil
+}
+
+func EscrowEventToSequence(syncable *model.Syncable, rawEscrowEvents *eventpb.EscrowEvents) ([]model.EscrowEventSeq, error) {
+	sequence := func() *model.Sequence {
+		return &model.Sequence{
+			Height: syncable.Height,
+			Time:   syncable.Time,
+		}
+	}
+
+	var escrowEvents []model.EscrowEventSeq
+	for _, rawEvent := range rawEscrowEvents.GetAdd() {
+		escrowEvents = append(escrowEvents, model.EscrowEventSeq{
+			Sequence: sequence(),
+
+			Kind:   model.EscrowEventKindAdd,
+			Owner:  rawEvent.GetOwner(),
+			Escrow: rawEvent.GetEscrow(),
+			Amount: types.NewQuantityFromBytes(rawEvent.GetAmount()),
+			Shares: types.NewQuantityFromBytes(rawEvent.GetNewShares()),
+		})
+	}
+
+	for _, rawEvent := range rawEscrowEvents.GetTake() {
+		escrowEvents = append(escrowEvents, model.EscrowEventSeq{
+			Sequence: sequence(),
+
+			Kind: model.EscrowEventKindTake,
+			// Owner of take escrow event is the escrow account that has been slashed
+			Owner:  rawEvent.GetOwner(),
+			Escrow: rawEvent.GetOwner(),
+			Amount: types.NewQuantityFromBytes(rawEvent.GetAmount()),
+		})
+	}
+
+	for _, rawEvent := range rawEscrowEvents.GetReclaim() {
+		escrowEvents = append(escrowEvents, model.EscrowEventSeq{
+			Sequence: sequence(),
+
+			Kind:   model.EscrowEventKindReclaim,
+			Owner:  rawEvent.GetOwner(),
+			Escrow: rawEvent.GetEscrow(),
+			Amount: types.NewQuantityFromBytes(rawEvent.GetAmount()),
+			Shares: types.NewQuantityFromBytes(rawEvent.GetShares()),
+		})
+	}
+
+	for _, e := range escrowEvents {
+		if !e.Valid() {
+			return nil, errors.New("escrow event sequence not valid")
+		}
+	}
+	return escrowEvents, nil
+}
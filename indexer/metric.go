@@ -9,4 +9,11 @@ var (
 		Name:      "db_size",
 		Desc:      "The size of the database after indexing of height",
 	}).WithLabels()
+
+	indexerFollowLag = metrics.MustNewGaugeWithTags(metrics.Options{
+		Namespace: "indexers",
+		Subsystem: "oasishub_task",
+		Name:      "follow_lag",
+		Desc:      "The number of heights between chain head and height being indexed in follow mode",
+	}).WithLabels()
 )
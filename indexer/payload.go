@@ -69,10 +69,13 @@ type payload struct {
 	NewValidatorSequences     []model.ValidatorSeq
 	UpdatedValidatorSequences []model.ValidatorSeq
 
-	StakingSequence              *model.StakingSeq
-	TransactionSequences         []model.TransactionSeq
-	DelegationSequences          []model.DelegationSeq
-	DebondingDelegationSequences []model.DebondingDelegationSeq
+	NewStakingSequence              *model.StakingSeq
+	UpdatedStakingSequence          *model.StakingSeq
+	TransactionSequences            []model.TransactionSeq
+	NewDelegationSequences          []model.DelegationSeq
+	NewDebondingDelegationSequences []model.DebondingDelegationSeq
+	NewTransferEventSequences       []model.TransferEventSeq
+	NewEscrowEventSequences         []model.EscrowEventSeq
 
 	// Analyzer
 	SystemEvents []*model.SystemEvent
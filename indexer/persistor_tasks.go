@@ -14,8 +14,15 @@ const (
 	TaskNameSyncerPersistor       = "SyncerPersistor"
 	TaskNameBlockSeqPersistor     = "BlockSeqPersistor"
 	TaskNameValidatorSeqPersistor = "ValidatorSeqPersistor"
+	TaskNameStakingSeqPersistor   = "StakingSeqPersistor"
+	TaskNameAccountAggPersistor   = "AccountAggPersistor"
 	TaskNameValidatorAggPersistor = "ValidatorAggPersistor"
 	TaskNameSystemEventPersistor  = "SystemEventPersistor"
+
+	TaskNameTransferEventSeqPersistor       = "TransferEventSeqPersistor"
+	TaskNameEscrowEventSeqPersistor         = "EscrowEventSeqPersistor"
+	TaskNameDelegationSeqPersistor          = "DelegationSeqPersistor"
+	TaskNameDebondingDelegationSeqPersistor = "DebondingDelegationSeqPersistor"
 )
 
 func NewSyncerPersistorTask(db SyncerPersistorTaskStore) pipeline.Task {
@@ -81,6 +88,42 @@ func (t *blockSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) err
 	return nil
 }
 
+func NewStakingSeqPersistorTask(db StakingSeqPersistorTaskStore) pipeline.Task {
+	return &stakingSeqPersistorTask{
+		db: db,
+	}
+}
+
+type stakingSeqPersistorTask struct {
+	db StakingSeqPersistorTaskStore
+}
+
+type StakingSeqPersistorTaskStore interface {
+	Create(record interface{}) error
+	Save(record interface{}) error
+}
+
+func (t *stakingSeqPersistorTask) GetName() string {
+	return TaskNameStakingSeqPersistor
+}
+
+func (t *stakingSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	if payload.NewStakingSequence != nil {
+		return t.db.Create(payload.NewStakingSequence)
+	}
+
+	if payload.UpdatedStakingSequence != nil {
+		return t.db.Save(payload.UpdatedStakingSequence)
+	}
+
+	return nil
+}
+
 func NewValidatorSeqPersistorTask(db ValidatorSeqPersistorTaskStore) pipeline.Task {
 	return &validatorSeqPersistorTask{
 		db: db,
@@ -121,6 +164,46 @@ func (t *validatorSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 	return nil
 }
 
+func NewAccountAggPersistorTask(db AccountAggPersistorTaskStore) pipeline.Task {
+	return &accountAggPersistorTask{
+		db: db,
+	}
+}
+
+type AccountAggPersistorTaskStore interface {
+	Create(record interface{}) error
+	Save(record interface{}) error
+}
+
+type accountAggPersistorTask struct {
+	db AccountAggPersistorTaskStore
+}
+
+func (t *accountAggPersistorTask) GetName() string {
+	return TaskNameAccountAggPersistor
+}
+
+func (t *accountAggPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, aggregate := range payload.NewAggregatedAccounts {
+		if err := t.db.Create(&aggregate); err != nil {
+			return err
+		}
+	}
+
+	for _, aggregate := range payload.UpdatedAggregatedAccounts {
+		if err := t.db.Save(&aggregate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func NewValidatorAggPersistorTask(db ValidatorAggPersistorTaskStore) pipeline.Task {
 	return &validatorAggPersistorTask{
 		db: db,
@@ -226,3 +309,135 @@ func (t *balanceEventPersistorTask) Run(ctx context.Context, p pipeline.Payload)
 
 	return nil
 }
+
+func NewTransferEventSeqPersistorTask(db TransferEventSeqPersistorTaskStore) pipeline.Task {
+	return &transferEventSeqPersistorTask{
+		db: db,
+	}
+}
+
+type TransferEventSeqPersistorTaskStore interface {
+	Create(record interface{}) error
+}
+
+type transferEventSeqPersistorTask struct {
+	db TransferEventSeqPersistorTaskStore
+}
+
+func (t *transferEventSeqPersistorTask) GetName() string {
+	return TaskNameTransferEventSeqPersistor
+}
+
+func (t *transferEventSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, sequence := range payload.NewTransferEventSequences {
+		if err := t.db.Create(&sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewEscrowEventSeqPersistorTask(db EscrowEventSeqPersistorTaskStore) pipeline.Task {
+	return &escrowEventSeqPersistorTask{
+		db: db,
+	}
+}
+
+type EscrowEventSeqPersistorTaskStore interface {
+	Create(record interface{}) error
+}
+
+type escrowEventSeqPersistorTask struct {
+	db EscrowEventSeqPersistorTaskStore
+}
+
+func (t *escrowEventSeqPersistorTask) GetName() string {
+	return TaskNameEscrowEventSeqPersistor
+}
+
+func (t *escrowEventSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, sequence := range payload.NewEscrowEventSequences {
+		if err := t.db.Create(&sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewDelegationSeqPersistorTask(db DelegationSeqPersistorTaskStore) pipeline.Task {
+	return &delegationSeqPersistorTask{
+		db: db,
+	}
+}
+
+type DelegationSeqPersistorTaskStore interface {
+	Create(record interface{}) error
+}
+
+type delegationSeqPersistorTask struct {
+	db DelegationSeqPersistorTaskStore
+}
+
+func (t *delegationSeqPersistorTask) GetName() string {
+	return TaskNameDelegationSeqPersistor
+}
+
+func (t *delegationSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, sequence := range payload.NewDelegationSequences {
+		if err := t.db.Create(&sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewDebondingDelegationSeqPersistorTask(db DebondingDelegationSeqPersistorTaskStore) pipeline.Task {
+	return &debondingDelegationSeqPersistorTask{
+		db: db,
+	}
+}
+
+type DebondingDelegationSeqPersistorTaskStore interface {
+	Create(record interface{}) error
+}
+
+type debondingDelegationSeqPersistorTask struct {
+	db DebondingDelegationSeqPersistorTaskStore
+}
+
+func (t *debondingDelegationSeqPersistorTask) GetName() string {
+	return TaskNameDebondingDelegationSeqPersistor
+}
+
+func (t *debondingDelegationSeqPersistorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StagePersistor, t.GetName(), payload.CurrentHeight))
+
+	for _, sequence := range payload.NewDebondingDelegationSequences {
+		if err := t.db.Create(&sequence); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -164,6 +164,69 @@ func TestBlockSeqPersistor_Run(t *testing.T) {
 	}
 }
 
+func TestStakingSeqPersistor_Run(t *testing.T) {
+	seq := &model.StakingSeq{
+		Sequence: &model.Sequence{
+			Height: 20,
+			Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+		},
+		TotalSupply:         types.NewQuantityFromInt64(1000),
+		CommonPool:          types.NewQuantityFromInt64(100),
+		DebondingInterval:   10,
+		MinDelegationAmount: types.NewQuantityFromInt64(1),
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with staking sequence", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("[new] %v", tt.description), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockStakingSeqPersistorTaskStore(ctrl)
+
+			task := NewStakingSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:      20,
+				NewStakingSequence: seq,
+			}
+
+			dbMock.EXPECT().Create(seq).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+
+		t.Run(fmt.Sprintf("[updated] %v", tt.description), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockStakingSeqPersistorTaskStore(ctrl)
+
+			task := NewStakingSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:          20,
+				UpdatedStakingSequence: seq,
+			}
+
+			dbMock.EXPECT().Save(seq).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestValidatorSeqPersistor_Run(t *testing.T) {
 	newValidatorSeq := func() model.ValidatorSeq {
 		return model.ValidatorSeq{
@@ -241,6 +304,84 @@ func TestValidatorSeqPersistor_Run(t *testing.T) {
 	}
 }
 
+func TestAccountAggPersistor_Run(t *testing.T) {
+	newAccountAgg := func() model.AccountAgg {
+		return model.AccountAgg{
+			Aggregate: &model.Aggregate{
+				StartedAtHeight: 20,
+				StartedAt:       *types.NewTimeFromTime(time.Date(1988, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			PublicKey: randString(5),
+		}
+	}
+
+	seq := []model.AccountAgg{newAccountAgg(), newAccountAgg()}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with all account aggregates", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("[new] %v", tt.description), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockAccountAggPersistorTaskStore(ctrl)
+
+			task := NewAccountAggPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:         20,
+				NewAggregatedAccounts: seq,
+			}
+
+			for _, s := range seq {
+				createSeq := s
+				dbMock.EXPECT().Create(&createSeq).Return(tt.expectErr).Times(1)
+				if tt.expectErr != nil {
+					// don't expect any more calls
+					break
+				}
+			}
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+
+		t.Run(fmt.Sprintf("[updated] %v", tt.description), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockAccountAggPersistorTaskStore(ctrl)
+
+			task := NewAccountAggPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:             20,
+				UpdatedAggregatedAccounts: seq,
+			}
+
+			for _, s := range seq {
+				saveSeq := s
+				dbMock.EXPECT().Save(&saveSeq).Return(tt.expectErr).Times(1)
+				if tt.expectErr != nil {
+					// don't expect any more calls
+					break
+				}
+			}
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
 func TestValidatorAggPersistor_Run(t *testing.T) {
 	newValidatorAgg := func() model.ValidatorAgg {
 		return model.ValidatorAgg{
@@ -317,3 +458,190 @@ func TestValidatorAggPersistor_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferEventSeqPersistor_Run(t *testing.T) {
+	seqs := []model.TransferEventSeq{
+		{
+			Sequence: &model.Sequence{
+				Height: 20,
+				Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			FromAddress: "from",
+			ToAddress:   "to",
+			Amount:      types.NewQuantityFromInt64(100),
+		},
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with transfer event sequences", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockTransferEventSeqPersistorTaskStore(ctrl)
+
+			task := NewTransferEventSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:             20,
+				NewTransferEventSequences: seqs,
+			}
+
+			dbMock.EXPECT().Create(&seqs[0]).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestEscrowEventSeqPersistor_Run(t *testing.T) {
+	seqs := []model.EscrowEventSeq{
+		{
+			Sequence: &model.Sequence{
+				Height: 20,
+				Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			Kind:   model.EscrowEventKindAdd,
+			Owner:  "owner",
+			Escrow: "escrow",
+			Amount: types.NewQuantityFromInt64(100),
+			Shares: types.NewQuantityFromInt64(10),
+		},
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with escrow event sequences", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockEscrowEventSeqPersistorTaskStore(ctrl)
+
+			task := NewEscrowEventSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:           20,
+				NewEscrowEventSequences: seqs,
+			}
+
+			dbMock.EXPECT().Create(&seqs[0]).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestDelegationSeqPersistor_Run(t *testing.T) {
+	seqs := []model.DelegationSeq{
+		{
+			Sequence: &model.Sequence{
+				Height: 20,
+				Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			ValidatorUID: "validator",
+			DelegatorUID: "delegator",
+			Shares:       types.NewQuantityFromInt64(100),
+		},
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with delegation sequences", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockDelegationSeqPersistorTaskStore(ctrl)
+
+			task := NewDelegationSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:          20,
+				NewDelegationSequences: seqs,
+			}
+
+			dbMock.EXPECT().Create(&seqs[0]).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestDebondingDelegationSeqPersistor_Run(t *testing.T) {
+	seqs := []model.DebondingDelegationSeq{
+		{
+			Sequence: &model.Sequence{
+				Height: 20,
+				Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			ValidatorUID: "validator",
+			DelegatorUID: "delegator",
+			Shares:       types.NewQuantityFromInt64(100),
+			DebondEnd:    30,
+		},
+	}
+
+	tests := []struct {
+		description string
+		expectErr   error
+	}{
+		{"calls db with debonding delegation sequences", nil},
+		{"returns error if database errors", errTestDbCreate},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+
+			dbMock := mock.NewMockDebondingDelegationSeqPersistorTaskStore(ctrl)
+
+			task := NewDebondingDelegationSeqPersistorTask(dbMock)
+
+			pl := &payload{
+				CurrentHeight:                   20,
+				NewDebondingDelegationSequences: seqs,
+			}
+
+			dbMock.EXPECT().Create(&seqs[0]).Return(tt.expectErr).Times(1)
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("want %v; got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -2,13 +2,16 @@ package indexer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/figment-networks/indexing-engine/pipeline"
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
 	"github.com/pkg/errors"
 )
@@ -23,6 +26,7 @@ var (
 	ErrIsPristine          = errors.New("cannot run because database is empty")
 	ErrIndexCannotBeRun    = errors.New("cannot run index process")
 	ErrBackfillCannotBeRun = errors.New("cannot run backfill process")
+	ErrInvalidChunkSize    = errors.New("reindex chunk size must be greater than 0")
 )
 
 type indexingPipeline struct {
@@ -35,8 +39,8 @@ type indexingPipeline struct {
 	configParser ConfigParser
 }
 
-func NewPipeline(cfg *config.Config, db *store.Store, client *client.Client) (*indexingPipeline, error) {
-	constants, err := client.Chain.GetConstants()
+func NewPipeline(ctx context.Context, cfg *config.Config, db *store.Store, client *client.Client) (*indexingPipeline, error) {
+	constants, err := client.Chain.GetConstants(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -86,12 +90,14 @@ func NewPipeline(cfg *config.Config, db *store.Store, client *client.Client) (*i
 		pipeline.RetryingTask(NewValidatorSeqCreatorTask(db.ValidatorSeq), isTransient, 3),
 		pipeline.RetryingTask(NewDelegationsSeqCreatorTask(db.DelegationSeq), isTransient, 3),
 		pipeline.RetryingTask(NewDebondingDelegationsSeqCreatorTask(db.DebondingDelegationSeq), isTransient, 3),
+		pipeline.RetryingTask(NewTransferEventSeqCreatorTask(db.TransferEventSeq), isTransient, 3),
+		pipeline.RetryingTask(NewEscrowEventSeqCreatorTask(db.EscrowEventSeq), isTransient, 3),
 	)
 
 	// Set aggregator stage
 	defaultPipeline.SetAsyncTasks(
 		pipeline.StageAggregator,
-		pipeline.RetryingTask(NewAccountAggCreatorTask(db.AccountAgg), isTransient, 3),
+		pipeline.RetryingTask(NewAccountAggCreatorTask(cfg, db.AccountAgg), isTransient, 3),
 		pipeline.RetryingTask(NewValidatorAggCreatorTask(db.ValidatorAgg), isTransient, 3),
 	)
 
@@ -104,9 +110,15 @@ func NewPipeline(cfg *config.Config, db *store.Store, client *client.Client) (*i
 		pipeline.RetryingTask(NewSyncerPersistorTask(db.Syncables), isTransient, 3),
 		pipeline.RetryingTask(NewBlockSeqPersistorTask(db.BlockSeq), isTransient, 3),
 		pipeline.RetryingTask(NewValidatorSeqPersistorTask(db.ValidatorSeq), isTransient, 3),
+		pipeline.RetryingTask(NewStakingSeqPersistorTask(db.StakingSeq), isTransient, 3),
+		pipeline.RetryingTask(NewAccountAggPersistorTask(db.AccountAgg), isTransient, 3),
 		pipeline.RetryingTask(NewValidatorAggPersistorTask(db.ValidatorAgg), isTransient, 3),
 		pipeline.RetryingTask(NewSystemEventPersistorTask(db.SystemEvents), isTransient, 3),
 		pipeline.RetryingTask(NewBalanceEventPersistorTask(db.BalanceEvents), isTransient, 3),
+		pipeline.RetryingTask(NewTransferEventSeqPersistorTask(db.TransferEventSeq), isTransient, 3),
+		pipeline.RetryingTask(NewEscrowEventSeqPersistorTask(db.EscrowEventSeq), isTransient, 3),
+		pipeline.RetryingTask(NewDelegationSeqPersistorTask(db.DelegationSeq), isTransient, 3),
+		pipeline.RetryingTask(NewDebondingDelegationSeqPersistorTask(db.DebondingDelegationSeq), isTransient, 3),
 	)
 
 	configParser, err := NewConfigParser(cfg.IndexerConfigFile)
@@ -144,7 +156,7 @@ func (o *indexingPipeline) Index(ctx context.Context, indexCfg IndexConfig) erro
 
 	currentIndexVersion := o.configParser.GetCurrentVersionId()
 
-	source, err := NewIndexSource(o.cfg, o.db.Syncables, o.client.Chain, indexCfg.StartHeight, indexCfg.BatchSize)
+	source, err := NewIndexSource(ctx, o.cfg, o.db.Syncables, o.client.Chain, indexCfg.StartHeight, indexCfg.BatchSize)
 	if err != nil {
 		return err
 	}
@@ -177,7 +189,8 @@ func (o *indexingPipeline) Index(ctx context.Context, indexCfg IndexConfig) erro
 	logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d] [options=%+v]", source.startHeight, source.endHeight, pipelineOptions))
 
 	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
-	err = o.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+	ctxWithPrefetch, prefetchingSource := o.withPrefetch(ctxWithReport, source, pipelineOptions)
+	err = o.pipeline.Start(ctxWithPrefetch, prefetchingSource, sink, pipelineOptions)
 
 	logger.Info(fmt.Sprintf("pipeline completed [Err: %+v]", err))
 
@@ -195,6 +208,95 @@ func (o *indexingPipeline) canRunIndex() error {
 	return nil
 }
 
+// Follow keeps indexing new heights as they appear on chain until context is done.
+// Pipeline errors stop current run, which is then restarted from last processed height after a backoff.
+func (o *indexingPipeline) Follow(ctx context.Context, followCfg FollowConfig) error {
+	if err := o.canRunIndex(); err != nil {
+		return err
+	}
+
+	retry := newBackoff(followCfg.PollInterval, followCfg.MaxBackoff)
+	for {
+		successCount, err := o.follow(ctx, followCfg)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if successCount > 0 {
+			retry.reset()
+		}
+		wait := retry.next()
+		logger.Error(fmt.Errorf("follow pipeline stopped, restarting in %s: %w", wait, err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// follow runs pipeline until it fails or context is done and returns number of processed heights
+func (o *indexingPipeline) follow(ctx context.Context, followCfg FollowConfig) (int64, error) {
+	currentIndexVersion := o.configParser.GetCurrentVersionId()
+
+	source, err := NewFollowSource(ctx, o.cfg, o.db.Syncables, o.client.Chain, followCfg)
+	if err != nil {
+		return 0, err
+	}
+
+	sink := NewSink(o.db, currentIndexVersion)
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindIndex,
+		indexVersion: currentIndexVersion,
+		startHeight:  source.startHeight,
+		endHeight:    source.headHeight,
+		store:        o.db.Reports,
+	}
+
+	versionIds := o.configParser.GetAllVersionedVersionIds()
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser: o.configParser,
+
+		desiredVersionIds: versionIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := reportCreator.create(); err != nil {
+		return 0, err
+	}
+
+	logger.Info(fmt.Sprintf("starting follow pipeline [start=%d] [head=%d] [options=%+v]", source.startHeight, source.headHeight, pipelineOptions))
+
+	ctxWithReport := context.WithValue(ctx, CtxReport, reportCreator.report)
+	ctxWithPrefetch, prefetchingSource := o.withPrefetch(ctxWithReport, source, pipelineOptions)
+	err = o.pipeline.Start(ctxWithPrefetch, prefetchingSource, sink, pipelineOptions)
+
+	logger.Info(fmt.Sprintf("follow pipeline completed [end=%d] [Err: %+v]", source.currentHeight, err))
+
+	// End height is not known upfront, set it to last height pipeline got to
+	reportCreator.report.EndHeight = source.currentHeight
+	if reportErr := reportCreator.complete(source.Len(), sink.successCount, err); reportErr != nil {
+		logger.Error(reportErr)
+	}
+
+	return sink.successCount, err
+}
+
+// withPrefetch wraps source with prefetching of raw data for next heights when prefetch window is set in config.
+// Only data used by fetcher tasks whitelisted in pipeline options is prefetched.
+func (o *indexingPipeline) withPrefetch(ctx context.Context, source boundedSource, options *pipeline.Options) (context.Context, pipeline.Source) {
+	if o.cfg.PrefetchWindow <= 0 {
+		return ctx, source
+	}
+	fetcher := newPrefetcher(o.client, o.cfg.PrefetchWindow, options.TaskWhitelist)
+	return context.WithValue(ctx, CtxPrefetcher, fetcher), NewPrefetchSource(ctx, source, fetcher)
+}
+
 type BackfillConfig struct {
 	Parallel bool
 	Force    bool
@@ -220,7 +322,7 @@ func (o *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 		kind = model.ReportKindParallelReindex
 	}
 	if backfillCfg.Force {
-		if err := o.db.Reports.DeleteByKinds([]model.ReportKind{model.ReportKindParallelReindex, model.ReportKindSequentialReindex}); err != nil {
+		if err := o.db.Reports.DeleteByKinds([]model.ReportKind{model.ReportKindParallelReindex, model.ReportKindSequentialReindex, model.ReportKindDistributedReindex}); err != nil {
 			return err
 		}
 	}
@@ -247,7 +349,7 @@ func (o *indexingPipeline) Backfill(ctx context.Context, backfillCfg BackfillCon
 		return err
 	}
 
-	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex); err != nil {
+	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex, model.ReportKindDistributedReindex); err != nil {
 		return err
 	}
 
@@ -327,7 +429,7 @@ func (o *indexingPipeline) Reindex(ctx context.Context, cfg ReindexConfig) error
 		return err
 	}
 
-	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex); err != nil {
+	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex, model.ReportKindDistributedReindex); err != nil {
 		return err
 	}
 
@@ -347,6 +449,141 @@ func (o *indexingPipeline) Reindex(ctx context.Context, cfg ReindexConfig) error
 	return nil
 }
 
+type EnqueueReindexConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	TargetIds   []int64
+	ChunkSize   int64
+}
+
+// EnqueueReindex splits reindex range into chunks stored as jobs which are processed by workers.
+// Progress of all chunks is tracked by single distributed reindex report.
+func (o *indexingPipeline) EnqueueReindex(ctx context.Context, cfg EnqueueReindexConfig) (*model.Report, error) {
+	if cfg.ChunkSize <= 0 {
+		return nil, ErrInvalidChunkSize
+	}
+
+	if err := o.canRunBackfill(true); err != nil {
+		return nil, err
+	}
+
+	source, err := NewReindexSource(o.cfg, o.db.Syncables, cfg.StartHeight, cfg.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate targets before any job is created
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:     o.configParser,
+		desiredTargetIds: cfg.TargetIds,
+	}
+	if _, err := pipelineOptionsCreator.parse(); err != nil {
+		return nil, err
+	}
+
+	targetIds, err := json.Marshal(cfg.TargetIds)
+	if err != nil {
+		return nil, err
+	}
+
+	reportCreator := &reportCreator{
+		kind:         model.ReportKindDistributedReindex,
+		indexVersion: o.configParser.GetCurrentVersionId(),
+		startHeight:  source.startHeight,
+		endHeight:    source.endHeight,
+		store:        o.db.Reports,
+	}
+
+	if err := reportCreator.createIfNotExists(model.ReportKindSequentialReindex, model.ReportKindParallelReindex, model.ReportKindDistributedReindex); err != nil {
+		return nil, err
+	}
+	if reportCreator.report.StartHeight != source.startHeight || reportCreator.report.EndHeight != source.endHeight {
+		return nil, errors.New(fmt.Sprintf("there is already distributed reindex in process [start=%d] [end=%d] (use -force flag to override it)", reportCreator.report.StartHeight, reportCreator.report.EndHeight))
+	}
+
+	counts, err := o.db.ReindexJobs.CountByStatus(reportCreator.report.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(counts) > 0 {
+		logger.Info(fmt.Sprintf("distributed reindex already enqueued [report=%d] [jobs=%v]", reportCreator.report.ID, counts))
+		return reportCreator.report, nil
+	}
+
+	chunks := reindexChunks(source.startHeight, source.endHeight, cfg.ChunkSize)
+	for _, chunk := range chunks {
+		job := &model.ReindexJob{
+			ReportID:    reportCreator.report.ID,
+			StartHeight: chunk.startHeight,
+			EndHeight:   chunk.endHeight,
+			TargetIds:   types.Jsonb{RawMessage: targetIds},
+			Status:      model.ReindexJobStatusPending,
+		}
+		if err := o.db.ReindexJobs.Create(job); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.Info(fmt.Sprintf("enqueued distributed reindex [report=%d] [start=%d] [end=%d] [jobs=%d]", reportCreator.report.ID, source.startHeight, source.endHeight, len(chunks)))
+
+	return reportCreator.report, nil
+}
+
+// ReindexChunk reindexes range of reindex job as part of given distributed reindex report.
+// Report is neither created nor completed here, number of successfully indexed heights is returned instead.
+func (o *indexingPipeline) ReindexChunk(ctx context.Context, cfg ReindexConfig, report *model.Report) (int64, error) {
+	source, err := NewReindexSource(o.cfg, o.db.Syncables, cfg.StartHeight, cfg.EndHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	sink := NewSink(o.db, o.configParser.GetCurrentVersionId())
+
+	pipelineOptionsCreator := &pipelineOptionsCreator{
+		configParser:     o.configParser,
+		desiredTargetIds: cfg.TargetIds,
+	}
+	pipelineOptions, err := pipelineOptionsCreator.parse()
+	if err != nil {
+		return 0, err
+	}
+
+	if err := o.db.Syncables.ResetProcessedAtForRange(source.startHeight, source.endHeight); err != nil {
+		return 0, err
+	}
+
+	logger.Info(fmt.Sprintf("starting pipeline [start=%d] [end=%d] [report=%d] [options=%+v]", source.startHeight, source.endHeight, report.ID, pipelineOptions))
+
+	ctxWithReport := context.WithValue(ctx, CtxReport, report)
+	err = o.pipeline.Start(ctxWithReport, source, sink, pipelineOptions)
+	if err != nil {
+		logger.Info(fmt.Sprintf("pipeline completed with error [Err: %+v]", err))
+		return sink.successCount, err
+	}
+
+	logger.Info("pipeline completed successfully")
+
+	return sink.successCount, nil
+}
+
+type heightChunk struct {
+	startHeight int64
+	endHeight   int64
+}
+
+// reindexChunks splits range into chunks of given size, last chunk can be shorter
+func reindexChunks(startHeight int64, endHeight int64, size int64) []heightChunk {
+	var chunks []heightChunk
+	for h := startHeight; h <= endHeight; h += size {
+		end := h + size - 1
+		if end > endHeight {
+			end = endHeight
+		}
+		chunks = append(chunks, heightChunk{startHeight: h, endHeight: end})
+	}
+	return chunks
+}
+
 type RunConfig struct {
 	Height            int64
 	DesiredVersionIDs []int64
@@ -2,8 +2,10 @@ package indexer
 
 import (
 	"fmt"
+	"path/filepath"
 	"github.com/figment-networks/indexing-engine/pipeline"
 	mock_indexer "github.com/figment-networks/oasishub-indexer/mock/indexer"
+	"github.com/figment-networks/oasishub-indexer/utils/projectpath"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"testing"
@@ -142,3 +144,61 @@ func TestPipelineOptionsCreator_parse(t *testing.T) {
 		}
 	})
 }
+
+func TestPipelineOptionsCreator_parseProjectConfig(t *testing.T) {
+	configParser, err := NewConfigParser(filepath.Join(projectpath.Root, "indexer_config.json"))
+	if err != nil {
+		t.Fatalf("could not parse project indexer config: %v", err)
+	}
+
+	hasTask := func(tasks []pipeline.TaskName, name pipeline.TaskName) bool {
+		for _, task := range tasks {
+			if task == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Slashed system events are created from escrow events and slash balance events
+	checkSystemEventDependencies := func(t *testing.T, creator pipelineOptionsCreator) {
+		options, err := creator.parse()
+		if err != nil {
+			t.Errorf("parse() should not return error, got: %v", err)
+			return
+		}
+		if !hasTask(options.TaskWhitelist, TaskNameSystemEventCreator) {
+			return
+		}
+		for _, dependency := range []pipeline.TaskName{TaskNameEventFetcher, TaskNameBalanceParser} {
+			if !hasTask(options.TaskWhitelist, dependency) {
+				t.Errorf("%s runs without %s", TaskNameSystemEventCreator, dependency)
+			}
+		}
+	}
+
+	for _, target := range configParser.targets.AvailableTargets {
+		target := target
+		t.Run(fmt.Sprintf("target %s", target.Name), func(t *testing.T) {
+			checkSystemEventDependencies(t, pipelineOptionsCreator{configParser: configParser, desiredTargetIds: []int64{target.ID}})
+		})
+	}
+
+	for _, versionId := range configParser.GetAllVersionedVersionIds() {
+		versionId := versionId
+		t.Run(fmt.Sprintf("version %d", versionId), func(t *testing.T) {
+			checkSystemEventDependencies(t, pipelineOptionsCreator{configParser: configParser, desiredVersionIds: []int64{versionId}})
+		})
+	}
+
+	t.Run("system events target creates system events", func(t *testing.T) {
+		options, err := (&pipelineOptionsCreator{configParser: configParser, desiredTargetIds: []int64{IndexTargetSystemEvents}}).parse()
+		if err != nil {
+			t.Errorf("parse() should not return error, got: %v", err)
+			return
+		}
+		if !hasTask(options.TaskWhitelist, TaskNameSystemEventCreator) {
+			t.Errorf("expected %s in tasks white list", TaskNameSystemEventCreator)
+		}
+	})
+}
@@ -0,0 +1,335 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock "github.com/figment-networks/oasishub-indexer/mock/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/projectpath"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+)
+
+const (
+	// Fixture heights are far above heights of real chains, so that fixture does not collide with other data in test database
+	replayFixtureStartHeight int64 = 900000001
+	replayFixtureEndHeight   int64 = 900000003
+)
+
+// replayFixtureValidator describes validator present at every height of fixture bundle
+type replayFixtureValidator struct {
+	address             string
+	tendermintAddress   string
+	entityID            string
+	votingPower         int64
+	activeEscrowBalance int64
+}
+
+var (
+	replayFixtureValidators = []replayFixtureValidator{
+		{address: "replay-validator-1", tendermintAddress: "replay-tm-1", entityID: "replay-entity-1", votingPower: 100, activeEscrowBalance: 5000},
+		{address: "replay-validator-2", tendermintAddress: "replay-tm-2", entityID: "replay-entity-2", votingPower: 50, activeEscrowBalance: 2500},
+	}
+	replayFixtureTotalSupply int64 = 1000000
+	replayFixtureTime              = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// TestPipeline_IndexReplay runs whole pipeline against replayed proxy responses and checks persisted sequences.
+// It needs migrated database, so it is skipped unless TEST_DATABASE_DSN is set.
+// Responses come from fixture bundle, bundle recorded with indexer:record can be used instead by setting TEST_REPLAY_BUNDLE.
+func TestPipeline_IndexReplay(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is required")
+	}
+
+	bundlePath := os.Getenv("TEST_REPLAY_BUNDLE")
+
+	var bundle *client.Bundle
+	if bundlePath != "" {
+		var err error
+		if bundle, err = client.LoadBundle(bundlePath); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		bundle = testReplayFixtureBundle(t)
+	}
+
+	db, err := store.New(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	cfg := config.New()
+	if err := config.FromEnv(cfg); err != nil {
+		t.Fatal(err)
+	}
+	cfg.IndexerConfigFile = filepath.Join(projectpath.Root, "indexer_config.json")
+	cfg.FirstBlockHeight = bundle.StartHeight
+	cfg.PrefetchWindow = 0
+
+	indexingPipeline, err := NewPipeline(context.Background(), cfg, db, client.NewReplayClient(bundle))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = indexingPipeline.Index(context.Background(), IndexConfig{StartHeight: bundle.StartHeight})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for height := bundle.StartHeight; height <= bundle.EndHeight; height++ {
+		syncable, err := db.Syncables.FindByHeight(height)
+		if err != nil {
+			t.Errorf("could not find syncable [height=%d]: %v", height, err)
+			continue
+		}
+		if syncable.ProcessedAt == nil {
+			t.Errorf("expected syncable to be processed [height=%d]", height)
+		}
+
+		if bundlePath != "" {
+			assertReplaySequencesPersisted(t, db, height)
+		} else {
+			assertReplayFixtureSequences(t, db, height)
+		}
+	}
+}
+
+// assertReplaySequencesPersisted checks that sequences of height were persisted when replaying recorded bundle
+func assertReplaySequencesPersisted(t *testing.T, db *store.Store, height int64) {
+	if _, err := db.BlockSeq.FindByHeight(height); err != nil {
+		t.Errorf("could not find block sequence [height=%d]: %v", height, err)
+	}
+	if _, err := db.StakingSeq.FindByHeight(height); err != nil {
+		t.Errorf("could not find staking sequence [height=%d]: %v", height, err)
+	}
+	validatorSeqs, err := db.ValidatorSeq.FindByHeight(height)
+	if err != nil || len(validatorSeqs) == 0 {
+		t.Errorf("could not find validator sequences [height=%d]: %v", height, err)
+	}
+}
+
+// assertReplayFixtureSequences checks that sequences of height match data of fixture bundle
+func assertReplayFixtureSequences(t *testing.T, db *store.Store, height int64) {
+	blockSeq, err := db.BlockSeq.FindByHeight(height)
+	if err != nil {
+		t.Errorf("could not find block sequence [height=%d]: %v", height, err)
+	} else {
+		if blockSeq.TransactionsCount != int64(len(testReplayFixtureTransactions(height))) {
+			t.Errorf("unexpected transactions count [height=%d], want %v; got %v", height, len(testReplayFixtureTransactions(height)), blockSeq.TransactionsCount)
+		}
+		if !blockSeq.Time.Equal(*types.NewTimeFromTime(testReplayFixtureTime(height))) {
+			t.Errorf("unexpected block time [height=%d], want %v; got %v", height, testReplayFixtureTime(height), blockSeq.Time)
+		}
+	}
+
+	stakingSeq, err := db.StakingSeq.FindByHeight(height)
+	if err != nil {
+		t.Errorf("could not find staking sequence [height=%d]: %v", height, err)
+	} else if !stakingSeq.TotalSupply.Equals(types.NewQuantityFromInt64(replayFixtureTotalSupply)) {
+		t.Errorf("unexpected total supply [height=%d], want %v; got %v", height, replayFixtureTotalSupply, stakingSeq.TotalSupply)
+	}
+
+	validatorSeqs, err := db.ValidatorSeq.FindByHeight(height)
+	if err != nil {
+		t.Errorf("could not find validator sequences [height=%d]: %v", height, err)
+	}
+	if len(validatorSeqs) != len(replayFixtureValidators) {
+		t.Errorf("unexpected validator sequences count [height=%d], want %v; got %v", height, len(replayFixtureValidators), len(validatorSeqs))
+	}
+	for _, seq := range validatorSeqs {
+		var expected *replayFixtureValidator
+		for i := range replayFixtureValidators {
+			if replayFixtureValidators[i].address == seq.Address {
+				expected = &replayFixtureValidators[i]
+			}
+		}
+		if expected == nil {
+			t.Errorf("unexpected validator sequence [height=%d] [address=%s]", height, seq.Address)
+			continue
+		}
+		if seq.EntityUID != expected.entityID || seq.VotingPower != expected.votingPower {
+			t.Errorf("unexpected validator sequence [height=%d] [address=%s]: %+v", height, seq.Address, seq)
+		}
+		if !seq.ActiveEscrowBalance.Equals(types.NewQuantityFromInt64(expected.activeEscrowBalance)) {
+			t.Errorf("unexpected active escrow balance [height=%d] [address=%s], want %v; got %v", height, seq.Address, expected.activeEscrowBalance, seq.ActiveEscrowBalance)
+		}
+		if seq.Proposed != (expected.tendermintAddress == testReplayFixtureProposer(height)) {
+			t.Errorf("unexpected proposed [height=%d] [address=%s]: %v", height, seq.Address, seq.Proposed)
+		}
+		if seq.PrecommitValidated == nil || !*seq.PrecommitValidated {
+			t.Errorf("expected precommit to be validated [height=%d] [address=%s]", height, seq.Address)
+		}
+	}
+
+	transactionSeqs, err := db.TransactionSeq.FindByHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		t.Errorf("could not find transaction sequences [height=%d]: %v", height, err)
+	}
+	expectedTransactions := testReplayFixtureTransactions(height)
+	if len(transactionSeqs) != len(expectedTransactions) {
+		t.Errorf("unexpected transaction sequences count [height=%d], want %v; got %v", height, len(expectedTransactions), len(transactionSeqs))
+	} else {
+		for i, seq := range transactionSeqs {
+			if seq.Hash != expectedTransactions[i].GetHash() || seq.PublicKey != expectedTransactions[i].GetPublicKey() {
+				t.Errorf("unexpected transaction sequence [height=%d]: %+v", height, seq)
+			}
+		}
+	}
+}
+
+// testReplayFixtureBundle records fixture responses of every request made by pipeline into bundle
+func testReplayFixtureBundle(t *testing.T) *client.Bundle {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chainClient := mock.NewMockChainClient(ctrl)
+	blockClient := mock.NewMockBlockClient(ctrl)
+	eventClient := mock.NewMockEventClient(ctrl)
+	stateClient := mock.NewMockStateClient(ctrl)
+	validatorClient := mock.NewMockValidatorClient(ctrl)
+	transactionClient := mock.NewMockTransactionClient(ctrl)
+
+	bundle := client.NewBundle(replayFixtureStartHeight, replayFixtureEndHeight)
+	recorder := client.NewRecordingClient(&client.Client{
+		Chain:       chainClient,
+		Block:       blockClient,
+		Event:       eventClient,
+		State:       stateClient,
+		Validator:   validatorClient,
+		Transaction: transactionClient,
+	}, bundle)
+
+	ctx := context.Background()
+
+	chainClient.EXPECT().GetConstants(gomock.Any()).Return(&chainpb.GetConstantsResponse{CommonPoolAddress: "replay-common-pool"}, nil)
+	if _, err := recorder.Chain.GetConstants(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for height := replayFixtureStartHeight; height <= replayFixtureEndHeight; height++ {
+		timestamp, err := ptypes.TimestampProto(testReplayFixtureTime(height))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var votes []*blockpb.Vote
+		var validators []*validatorpb.Validator
+		staking := &statepb.Staking{
+			TotalSupply: big.NewInt(replayFixtureTotalSupply).Bytes(),
+			CommonPool:  big.NewInt(500).Bytes(),
+			Ledger:      map[string]*accountpb.Account{},
+			Parameters: &statepb.StakingParameters{
+				DebondingInterval:   10,
+				MinDelegationAmount: big.NewInt(100).Bytes(),
+			},
+		}
+		for i, v := range replayFixtureValidators {
+			votes = append(votes, testpbVote(int64(i), 2))
+			validators = append(validators, testpbValidator(
+				setValidatorAddress(v.address),
+				setTendermintAddress(v.tendermintAddress),
+				setValidatorEntityID(v.entityID),
+				setValidatorVotingPower(v.votingPower),
+			))
+			staking.Ledger[v.address] = &accountpb.Account{
+				General: &accountpb.GeneralAccount{Balance: big.NewInt(1000).Bytes()},
+				Escrow: &accountpb.EscrowAccount{
+					Active:    &accountpb.SharePool{Balance: big.NewInt(v.activeEscrowBalance).Bytes(), TotalShares: big.NewInt(v.activeEscrowBalance).Bytes()},
+					Debonding: &accountpb.SharePool{},
+				},
+			}
+		}
+
+		block := &blockpb.Block{
+			Header: &blockpb.Header{
+				ChainId:         "replay-chain",
+				Height:          height,
+				Time:            timestamp,
+				ProposerAddress: testReplayFixtureProposer(height),
+			},
+			LastCommit: &blockpb.Commit{Height: height - 1, Votes: votes},
+		}
+
+		chainClient.EXPECT().GetMetaByHeight(gomock.Any(), height).Return(&chainpb.GetMetaByHeightResponse{Height: height, Time: timestamp, AppVersion: 1, BlockVersion: 1}, nil)
+		blockClient.EXPECT().GetByHeight(gomock.Any(), height).Return(&blockpb.GetByHeightResponse{Block: block}, nil)
+		eventClient.EXPECT().GetEscrowEventsByHeight(gomock.Any(), height).Return(&eventpb.GetEscrowEventsByHeightResponse{Events: &eventpb.EscrowEvents{}}, nil)
+		eventClient.EXPECT().GetTransferEventsByHeight(gomock.Any(), height).Return(&eventpb.GetTransferEventsByHeightResponse{}, nil)
+		stateClient.EXPECT().GetByHeight(gomock.Any(), height).Return(&statepb.GetByHeightResponse{State: &statepb.State{ChainID: "replay-chain", Height: height, Staking: staking}}, nil)
+		stateClient.EXPECT().GetStakingByHeight(gomock.Any(), height).Return(&statepb.GetStakingByHeightResponse{Staking: staking}, nil)
+		validatorClient.EXPECT().GetByHeight(gomock.Any(), height).Return(&validatorpb.GetByHeightResponse{Validators: validators}, nil)
+		transactionClient.EXPECT().GetByHeight(gomock.Any(), height).Return(&transactionpb.GetByHeightResponse{Transactions: testReplayFixtureTransactions(height)}, nil)
+
+		if _, err := recorder.Chain.GetMetaByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.Block.GetByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.Event.GetEscrowEventsByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.Event.GetTransferEventsByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.State.GetByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.State.GetStakingByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.Validator.GetByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := recorder.Transaction.GetByHeight(ctx, height); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return bundle
+}
+
+func testReplayFixtureTime(height int64) time.Time {
+	return replayFixtureTime.Add(time.Duration(height-replayFixtureStartHeight) * 6 * time.Second)
+}
+
+// testReplayFixtureProposer returns tendermint address of validator proposing height, validators take turns
+func testReplayFixtureProposer(height int64) string {
+	return replayFixtureValidators[height%int64(len(replayFixtureValidators))].tendermintAddress
+}
+
+// testReplayFixtureTransactions returns transactions of height, only the middle height of fixture has transactions
+func testReplayFixtureTransactions(height int64) []*transactionpb.Transaction {
+	if height != replayFixtureStartHeight+1 {
+		return nil
+	}
+	return []*transactionpb.Transaction{
+		{
+			Hash:      "replay-tx-1",
+			PublicKey: "replay-sender-1",
+			Signature: "replay-signature-1",
+			Nonce:     1,
+			Fee:       big.NewInt(10).Bytes(),
+			GasLimit:  1000,
+			GasPrice:  big.NewInt(1).Bytes(),
+			Method:    "staking.Transfer",
+		},
+	}
+}
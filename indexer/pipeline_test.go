@@ -0,0 +1,30 @@
+package indexer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReindexChunks(t *testing.T) {
+	tests := []struct {
+		description string
+		startHeight int64
+		endHeight   int64
+		size        int64
+		expect      []heightChunk
+	}{
+		{"splits range into equal chunks", 1, 6, 3, []heightChunk{{1, 3}, {4, 6}}},
+		{"shortens last chunk", 1, 7, 3, []heightChunk{{1, 3}, {4, 6}, {7, 7}}},
+		{"returns single chunk for short range", 10, 12, 100, []heightChunk{{10, 12}}},
+		{"returns no chunks for empty range", 10, 9, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			chunks := reindexChunks(tt.startHeight, tt.endHeight, tt.size)
+			if !reflect.DeepEqual(chunks, tt.expect) {
+				t.Errorf("unexpected chunks, want %v; got %v", tt.expect, chunks)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+const (
+	CtxPrefetcher = "context_prefetcher"
+)
+
+// prefetchedHeight holds raw data of a single height fetched ahead of pipeline
+type prefetchedHeight struct {
+	done chan struct{}
+	err  error
+
+	block          *blockpb.GetByHeightResponse
+	state          *statepb.GetByHeightResponse
+	stakingState   *statepb.GetStakingByHeightResponse
+	validators     *validatorpb.GetByHeightResponse
+	transactions   *transactionpb.GetByHeightResponse
+	escrowEvents   *eventpb.GetEscrowEventsByHeightResponse
+	transferEvents *eventpb.GetTransferEventsByHeightResponse
+}
+
+// newPrefetcher creates prefetcher fetching data used by given fetcher tasks. Empty task whitelist means all tasks run.
+func newPrefetcher(client *client.Client, window int64, taskWhitelist []pipeline.TaskName) *prefetcher {
+	var tasks map[pipeline.TaskName]bool
+	if len(taskWhitelist) > 0 {
+		tasks = make(map[pipeline.TaskName]bool, len(taskWhitelist))
+		for _, task := range taskWhitelist {
+			tasks[task] = true
+		}
+	}
+
+	return &prefetcher{
+		client:  client,
+		window:  window,
+		tasks:   tasks,
+		heights: map[int64]*prefetchedHeight{},
+	}
+}
+
+// prefetcher fetches raw data of heights concurrently.
+// At most window heights are kept in memory, older heights are evicted when pipeline moves forward.
+// Only data read by fetcher tasks which run in pipeline is fetched.
+type prefetcher struct {
+	client *client.Client
+	window int64
+	tasks  map[pipeline.TaskName]bool
+
+	mu      sync.Mutex
+	heights map[int64]*prefetchedHeight
+}
+
+// prefetch evicts heights below current one and starts fetching heights in window, but not above last height
+func (f *prefetcher) prefetch(ctx context.Context, current int64, last int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for height := range f.heights {
+		if height < current {
+			delete(f.heights, height)
+		}
+	}
+
+	end := current + f.window - 1
+	if end > last {
+		end = last
+	}
+
+	for height := current; height <= end; height++ {
+		if _, ok := f.heights[height]; ok {
+			continue
+		}
+		data := &prefetchedHeight{done: make(chan struct{})}
+		f.heights[height] = data
+		go f.fetch(ctx, height, data)
+	}
+}
+
+// get waits for height to be fetched. It returns nil if height is not prefetched or fetching failed.
+func (f *prefetcher) get(ctx context.Context, height int64) *prefetchedHeight {
+	f.mu.Lock()
+	data, ok := f.heights[height]
+	f.mu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-data.done:
+	}
+
+	if data.err != nil {
+		return nil
+	}
+	return data
+}
+
+func (f *prefetcher) fetch(ctx context.Context, height int64, data *prefetchedHeight) {
+	defer close(data.done)
+
+	data.err = f.fetchAll(ctx, height, data)
+	if data.err != nil {
+		logger.Debug(fmt.Sprintf("prefetching failed, falling back to fetcher tasks [height=%d] [err=%+v]", height, data.err))
+	}
+}
+
+// needs returns true if fetcher task runs in pipeline
+func (f *prefetcher) needs(task pipeline.TaskName) bool {
+	return f.tasks == nil || f.tasks[task]
+}
+
+func (f *prefetcher) fetchAll(ctx context.Context, height int64, data *prefetchedHeight) error {
+	var err error
+	if f.needs(TaskNameBlockFetcher) {
+		if data.block, err = f.client.Block.GetByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	if f.needs(TaskNameStateFetcher) {
+		if data.state, err = f.client.State.GetByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	if f.needs(TaskNameStakingStateFetcher) {
+		if data.stakingState, err = f.client.State.GetStakingByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	if f.needs(TaskNameValidatorFetcher) {
+		if data.validators, err = f.client.Validator.GetByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	if f.needs(TaskNameTransactionFetcher) {
+		if data.transactions, err = f.client.Transaction.GetByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	if f.needs(TaskNameEventFetcher) {
+		if data.escrowEvents, err = f.client.Event.GetEscrowEventsByHeight(ctx, height); err != nil {
+			return err
+		}
+		if data.transferEvents, err = f.client.Event.GetTransferEventsByHeight(ctx, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPrefetched returns prefetched data of height if pipeline runs with prefetch source
+func getPrefetched(ctx context.Context, height int64) *prefetchedHeight {
+	fetcher, ok := ctx.Value(CtxPrefetcher).(*prefetcher)
+	if !ok {
+		return nil
+	}
+	return fetcher.get(ctx, height)
+}
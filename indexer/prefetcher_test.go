@@ -0,0 +1,186 @@
+package indexer
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	mock "github.com/figment-networks/oasishub-indexer/mock/client"
+	"github.com/golang/mock/gomock"
+)
+
+func TestPrefetcher_Prefetch(t *testing.T) {
+	tests := []struct {
+		description   string
+		window        int64
+		prefetched    []int64
+		current       int64
+		last          int64
+		expectHeights []int64
+	}{
+		{"fetches heights in window", 3, nil, 10, 20, []int64{10, 11, 12}},
+		{"does not fetch above last height", 3, nil, 10, 11, []int64{10, 11}},
+		{"evicts heights below current", 3, []int64{8, 9, 10}, 10, 20, []int64{10, 11, 12}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fetcher := newPrefetcher(testPrefetchClient(ctrl, testpbBlock(), nil), tt.window, nil)
+			for _, height := range tt.prefetched {
+				data := &prefetchedHeight{done: make(chan struct{})}
+				close(data.done)
+				fetcher.heights[height] = data
+			}
+
+			fetcher.prefetch(context.Background(), tt.current, tt.last)
+
+			var heights []int64
+			for height := range fetcher.heights {
+				// wait for fetching to finish
+				fetcher.get(context.Background(), height)
+				heights = append(heights, height)
+			}
+			sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+			if !reflect.DeepEqual(heights, tt.expectHeights) {
+				t.Errorf("unexpected heights, want %v; got %v", tt.expectHeights, heights)
+			}
+		})
+	}
+}
+
+func TestPrefetcher_Get(t *testing.T) {
+	t.Run("returns nil if height is not prefetched", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fetcher := newPrefetcher(testPrefetchClient(ctrl, testpbBlock(), nil), 1, nil)
+
+		if data := fetcher.get(context.Background(), 10); data != nil {
+			t.Errorf("expected nil, got %+v", data)
+		}
+	})
+
+	t.Run("returns nil if fetching failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fetcher := newPrefetcher(testPrefetchClient(ctrl, testpbBlock(), errTestClient), 1, nil)
+		fetcher.prefetch(context.Background(), 10, 10)
+
+		if data := fetcher.get(context.Background(), 10); data != nil {
+			t.Errorf("expected nil, got %+v", data)
+		}
+	})
+
+	t.Run("returns fetched data", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		block := testpbBlock()
+		fetcher := newPrefetcher(testPrefetchClient(ctrl, block, nil), 1, nil)
+		fetcher.prefetch(context.Background(), 10, 10)
+
+		data := fetcher.get(context.Background(), 10)
+		if data == nil {
+			t.Fatal("expected data, got nil")
+		}
+		if !reflect.DeepEqual(data.block.GetBlock(), block) {
+			t.Errorf("unexpected block, want %+v; got %+v", block, data.block.GetBlock())
+		}
+	})
+}
+
+func TestPrefetcher_FetchWhitelistedTasks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blockClient := mock.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().GetByHeight(gomock.Any(), int64(10)).Return(&blockpb.GetByHeightResponse{Block: testpbBlock()}, nil).Times(1)
+
+	eventClient := mock.NewMockEventClient(ctrl)
+	eventClient.EXPECT().GetEscrowEventsByHeight(gomock.Any(), int64(10)).Return(&eventpb.GetEscrowEventsByHeightResponse{}, nil).Times(1)
+	eventClient.EXPECT().GetTransferEventsByHeight(gomock.Any(), int64(10)).Return(&eventpb.GetTransferEventsByHeightResponse{}, nil).Times(1)
+
+	// state, validator and transaction clients are not set, calling them would panic
+	fetcher := newPrefetcher(&client.Client{Block: blockClient, Event: eventClient}, 1, []pipeline.TaskName{
+		TaskNameBlockFetcher,
+		TaskNameEventFetcher,
+		TaskNameSystemEventCreator,
+	})
+	fetcher.prefetch(context.Background(), 10, 10)
+
+	data := fetcher.get(context.Background(), 10)
+	if data == nil {
+		t.Fatal("expected data, got nil")
+	}
+	if data.state != nil || data.stakingState != nil || data.validators != nil || data.transactions != nil {
+		t.Errorf("unexpected data fetched for tasks which do not run: %+v", data)
+	}
+}
+
+func TestBlockFetcher_RunWithPrefetcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	block := testpbBlock()
+	fetcher := newPrefetcher(testPrefetchClient(ctrl, block, nil), 1, []pipeline.TaskName{TaskNameBlockFetcher})
+	fetcher.prefetch(context.Background(), 20, 20)
+
+	mockClient := mock.NewMockBlockClient(ctrl)
+	mockClient.EXPECT().GetByHeight(gomock.Any(), gomock.Any()).Times(0)
+
+	task := NewBlockFetcherTask(mockClient)
+	pl := &payload{CurrentHeight: 20}
+	ctx := context.WithValue(context.Background(), CtxPrefetcher, fetcher)
+
+	if err := task.Run(ctx, pl); err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+
+	if !reflect.DeepEqual(pl.RawBlock, block) {
+		t.Errorf("want: %+v, got: %+v", block, pl.RawBlock)
+	}
+}
+
+// testPrefetchClient returns client responding to all calls made by prefetcher
+func testPrefetchClient(ctrl *gomock.Controller, block *blockpb.Block, err error) *client.Client {
+	blockClient := mock.NewMockBlockClient(ctrl)
+	blockClient.EXPECT().GetByHeight(gomock.Any(), gomock.Any()).Return(&blockpb.GetByHeightResponse{Block: block}, err).AnyTimes()
+
+	stateClient := mock.NewMockStateClient(ctrl)
+	stateClient.EXPECT().GetByHeight(gomock.Any(), gomock.Any()).Return(&statepb.GetByHeightResponse{}, nil).AnyTimes()
+	stateClient.EXPECT().GetStakingByHeight(gomock.Any(), gomock.Any()).Return(&statepb.GetStakingByHeightResponse{}, nil).AnyTimes()
+
+	validatorClient := mock.NewMockValidatorClient(ctrl)
+	validatorClient.EXPECT().GetByHeight(gomock.Any(), gomock.Any()).Return(&validatorpb.GetByHeightResponse{}, nil).AnyTimes()
+
+	transactionClient := mock.NewMockTransactionClient(ctrl)
+	transactionClient.EXPECT().GetByHeight(gomock.Any(), gomock.Any()).Return(&transactionpb.GetByHeightResponse{}, nil).AnyTimes()
+
+	eventClient := mock.NewMockEventClient(ctrl)
+	eventClient.EXPECT().GetEscrowEventsByHeight(gomock.Any(), gomock.Any()).Return(&eventpb.GetEscrowEventsByHeightResponse{}, nil).AnyTimes()
+	eventClient.EXPECT().GetTransferEventsByHeight(gomock.Any(), gomock.Any()).Return(&eventpb.GetTransferEventsByHeightResponse{}, nil).AnyTimes()
+
+	return &client.Client{
+		Block:       blockClient,
+		State:       stateClient,
+		Validator:   validatorClient,
+		Transaction: transactionClient,
+		Event:       eventClient,
+	}
+}
@@ -17,6 +17,8 @@ const (
 	TaskNameStakingSeqCreator             = "StakingSeqCreator"
 	TaskNameDelegationSeqCreator          = "DelegationSeqCreator"
 	TaskNameDebondingDelegationSeqCreator = "DebondingDelegationSeqCreator"
+	TaskNameTransferEventSeqCreator       = "TransferEventSeqCreator"
+	TaskNameEscrowEventSeqCreator         = "EscrowEventSeqCreator"
 )
 
 var (
@@ -26,6 +28,8 @@ var (
 	_ pipeline.Task = (*stakingSeqCreatorTask)(nil)
 	_ pipeline.Task = (*delegationSeqCreatorTask)(nil)
 	_ pipeline.Task = (*debondingDelegationSeqCreatorTask)(nil)
+	_ pipeline.Task = (*transferEventSeqCreatorTask)(nil)
+	_ pipeline.Task = (*escrowEventSeqCreatorTask)(nil)
 )
 
 func NewBlockSeqCreatorTask(db BlockSeqCreatorTaskStore) *blockSeqCreatorTask {
@@ -205,7 +209,6 @@ type stakingSeqCreatorTask struct {
 }
 
 type StakingSeqCreatorTaskStore interface {
-	Create(record interface{}) error
 	FindByHeight(height int64) (*model.StakingSeq, error)
 }
 
@@ -219,22 +222,23 @@ func (t *stakingSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) err
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
 
-	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
+	rawStakingSeq, err := StakingToSequence(payload.Syncable, payload.RawState.GetStaking())
+	if err != nil {
+		return err
+	}
+
+	stakingSeq, err := t.db.FindByHeight(payload.CurrentHeight)
 	if err != nil {
 		if err == store.ErrNotFound {
-			toSequence, err := StakingToSequence(payload.Syncable, payload.RawState.GetStaking())
-			if err != nil {
-				return err
-			}
-			if err := t.db.Create(toSequence); err != nil {
-				return err
-			}
-			payload.StakingSequence = toSequence
+			payload.NewStakingSequence = rawStakingSeq
 			return nil
 		}
 		return err
 	}
-	payload.StakingSequence = sequenced
+
+	stakingSeq.Update(*rawStakingSeq)
+	payload.UpdatedStakingSequence = stakingSeq
+
 	return nil
 }
 
@@ -243,7 +247,6 @@ type delegationSeqCreatorTask struct {
 }
 
 type DelegationSeqCreatorTaskStore interface {
-	Create(record interface{}) error
 	FindByHeight(h int64) ([]model.DelegationSeq, error)
 }
 
@@ -263,12 +266,6 @@ func (t *delegationSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload)
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
 
-	var res []model.DelegationSeq
-	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
-	if err != nil {
-		return err
-	}
-
 	toSequence, err := DelegationToSequence(payload.Syncable, payload.RawState)
 	if err != nil {
 		return err
@@ -276,14 +273,12 @@ func (t *delegationSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload)
 
 	// Nothing to sequence
 	if len(toSequence) == 0 {
-		payload.DelegationSequences = res
 		return nil
 	}
 
-	// Everything sequenced and saved to persistence
-	if len(sequenced) == len(toSequence) {
-		payload.DelegationSequences = sequenced
-		return nil
+	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
 	}
 
 	isSequenced := func(vs model.DelegationSeq) bool {
@@ -295,15 +290,13 @@ func (t *delegationSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload)
 		return false
 	}
 
+	var newSequences []model.DelegationSeq
 	for _, vs := range toSequence {
 		if !isSequenced(vs) {
-			if err := t.db.Create(&vs); err != nil {
-				return err
-			}
+			newSequences = append(newSequences, vs)
 		}
-		res = append(res, vs)
 	}
-	payload.DelegationSequences = res
+	payload.NewDelegationSequences = newSequences
 	return nil
 }
 
@@ -318,7 +311,6 @@ type debondingDelegationSeqCreatorTask struct {
 }
 
 type DebondingDelegationSeqCreatorTaskStore interface {
-	Create(record interface{}) error
 	FindByHeight(h int64) ([]model.DebondingDelegationSeq, error)
 }
 
@@ -332,30 +324,80 @@ func (t *debondingDelegationSeqCreatorTask) Run(ctx context.Context, p pipeline.
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
 
-	var res []model.DebondingDelegationSeq
+	toSequence, err := DebondingDelegationToSequence(payload.Syncable, payload.RawState)
+	if err != nil {
+		return err
+	}
+
+	// Nothing to sequence
+	if len(toSequence) == 0 {
+		return nil
+	}
+
 	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
 
-	toSequence, err := DebondingDelegationToSequence(payload.Syncable, payload.RawState)
+	isSequenced := func(vs model.DebondingDelegationSeq) bool {
+		for _, sv := range sequenced {
+			if sv.Equal(vs) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var newSequences []model.DebondingDelegationSeq
+	for _, vs := range toSequence {
+		if !isSequenced(vs) {
+			newSequences = append(newSequences, vs)
+		}
+	}
+	payload.NewDebondingDelegationSequences = newSequences
+	return nil
+}
+
+func NewTransferEventSeqCreatorTask(db TransferEventSeqCreatorTaskStore) *transferEventSeqCreatorTask {
+	return &transferEventSeqCreatorTask{
+		db: db,
+	}
+}
+
+type transferEventSeqCreatorTask struct {
+	db TransferEventSeqCreatorTaskStore
+}
+
+type TransferEventSeqCreatorTaskStore interface {
+	FindByHeight(h int64) ([]model.TransferEventSeq, error)
+}
+
+func (t *transferEventSeqCreatorTask) GetName() string {
+	return TaskNameTransferEventSeqCreator
+}
+
+func (t *transferEventSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	toSequence, err := TransferEventToSequence(payload.Syncable, payload.RawTransferEvents)
 	if err != nil {
 		return err
 	}
 
 	// Nothing to sequence
 	if len(toSequence) == 0 {
-		payload.DebondingDelegationSequences = res
 		return nil
 	}
 
-	// Everything sequenced and saved to persistence
-	if len(sequenced) == len(toSequence) {
-		payload.DebondingDelegationSequences = sequenced
-		return nil
+	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
 	}
 
-	isSequenced := func(vs model.DebondingDelegationSeq) bool {
+	isSequenced := func(vs model.TransferEventSeq) bool {
 		for _, sv := range sequenced {
 			if sv.Equal(vs) {
 				return true
@@ -364,14 +406,70 @@ func (t *debondingDelegationSeqCreatorTask) Run(ctx context.Context, p pipeline.
 		return false
 	}
 
+	var newSequences []model.TransferEventSeq
 	for _, vs := range toSequence {
 		if !isSequenced(vs) {
-			if err := t.db.Create(&vs); err != nil {
-				return err
+			newSequences = append(newSequences, vs)
+		}
+	}
+	payload.NewTransferEventSequences = newSequences
+	return nil
+}
+
+func NewEscrowEventSeqCreatorTask(db EscrowEventSeqCreatorTaskStore) *escrowEventSeqCreatorTask {
+	return &escrowEventSeqCreatorTask{
+		db: db,
+	}
+}
+
+type escrowEventSeqCreatorTask struct {
+	db EscrowEventSeqCreatorTaskStore
+}
+
+type EscrowEventSeqCreatorTaskStore interface {
+	FindByHeight(h int64) ([]model.EscrowEventSeq, error)
+}
+
+func (t *escrowEventSeqCreatorTask) GetName() string {
+	return TaskNameEscrowEventSeqCreator
+}
+
+func (t *escrowEventSeqCreatorTask) Run(ctx context.Context, p pipeline.Payload) error {
+
+	payload := p.(*payload)
+
+	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSequencer, t.GetName(), payload.CurrentHeight))
+
+	toSequence, err := EscrowEventToSequence(payload.Syncable, payload.RawEscrowEvents)
+	if err != nil {
+		return err
+	}
+
+	// Nothing to sequence
+	if len(toSequence) == 0 {
+		return nil
+	}
+
+	sequenced, err := t.db.FindByHeight(payload.CurrentHeight)
+	if err != nil {
+		return err
+	}
+
+	isSequenced := func(vs model.EscrowEventSeq) bool {
+		for _, sv := range sequenced {
+			if sv.Equal(vs) {
+				return true
 			}
 		}
-		res = append(res, vs)
+		return false
+	}
+
+	var newSequences []model.EscrowEventSeq
+	for _, vs := range toSequence {
+		if !isSequenced(vs) {
+			newSequences = append(newSequences, vs)
+		}
 	}
-	payload.DebondingDelegationSequences = res
+	payload.NewEscrowEventSequences = newSequences
 	return nil
 }
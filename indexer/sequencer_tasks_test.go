@@ -4,11 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"math/rand"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/event/eventpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
@@ -360,46 +360,40 @@ func TestStakingSeqCreator_Run(t *testing.T) {
 		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
 	}
 
-	existing := &model.StakingSeq{
-		Sequence: &model.Sequence{
-			Height: 20,
-			Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
-		},
-		TotalSupply:         types.NewQuantityFromBytes(randBytes(6)),
-		CommonPool:          types.NewQuantityFromBytes(randBytes(6)),
-		DebondingInterval:   rand.Uint64(),
-		MinDelegationAmount: types.NewQuantityFromBytes(randBytes(6)),
+	existing := func() *model.StakingSeq {
+		return &model.StakingSeq{
+			Sequence: &model.Sequence{
+				Height: 20,
+				Time:   *types.NewTimeFromTime(time.Date(1987, 12, 11, 14, 0, 0, 0, time.UTC)),
+			},
+			TotalSupply:         types.NewQuantityFromBytes([]byte{5}),
+			CommonPool:          types.NewQuantityFromBytes([]byte{6}),
+			DebondingInterval:   7,
+			MinDelegationAmount: types.NewQuantityFromBytes([]byte{8}),
+		}
 	}
 
 	tests := []struct {
-		description      string
-		raw              *statepb.Staking
-		dbErr            error
-		expectErr        error
-		expectStakingSeq *model.StakingSeq
+		description   string
+		raw           *statepb.Staking
+		dbReturn      *model.StakingSeq
+		dbErr         error
+		expectErr     error
+		expectNew     *model.StakingSeq
+		expectUpdated *model.StakingSeq
 	}{
 		{
-			description:      "Adds exisitng staking seq to payload",
-			raw:              testpbStaking(),
-			dbErr:            nil,
-			expectErr:        nil,
-			expectStakingSeq: existing,
-		},
-		{
-			description: "Adds new staking seq to payload",
+			description: "Updates existing staking seq",
 			raw: testpbStaking(
 				setStakingTotalSupply([]byte{1}),
 				setStakingCommonPool([]byte{2}),
 				setStakingDebondingInterval(3),
 				setStakingMinDelegationAmount([]byte{4}),
 			),
-			dbErr:     store.ErrNotFound,
+			dbReturn:  existing(),
 			expectErr: nil,
-			expectStakingSeq: &model.StakingSeq{
-				Sequence: &model.Sequence{
-					Height: sync.Height,
-					Time:   sync.Time,
-				},
+			expectUpdated: &model.StakingSeq{
+				Sequence:            existing().Sequence,
 				TotalSupply:         types.NewQuantityFromBytes([]byte{1}),
 				CommonPool:          types.NewQuantityFromBytes([]byte{2}),
 				DebondingInterval:   3,
@@ -407,23 +401,16 @@ func TestStakingSeqCreator_Run(t *testing.T) {
 			},
 		},
 		{
-			description:      "Returns error on unexpected FindByHeight database error",
-			raw:              testpbStaking(),
-			dbErr:            errTestDbFind,
-			expectErr:        errTestDbFind,
-			expectStakingSeq: nil,
-		},
-		{
-			description: "Returns error on unexpected Create database error",
+			description: "Adds new staking seq to payload",
 			raw: testpbStaking(
 				setStakingTotalSupply([]byte{1}),
 				setStakingCommonPool([]byte{2}),
 				setStakingDebondingInterval(3),
 				setStakingMinDelegationAmount([]byte{4}),
 			),
-			dbErr:     errTestDbCreate,
-			expectErr: errTestDbCreate,
-			expectStakingSeq: &model.StakingSeq{
+			dbErr:     store.ErrNotFound,
+			expectErr: nil,
+			expectNew: &model.StakingSeq{
 				Sequence: &model.Sequence{
 					Height: sync.Height,
 					Time:   sync.Time,
@@ -434,6 +421,12 @@ func TestStakingSeqCreator_Run(t *testing.T) {
 				MinDelegationAmount: types.NewQuantityFromBytes([]byte{4}),
 			},
 		},
+		{
+			description: "Returns error on unexpected FindByHeight database error",
+			raw:         testpbStaking(),
+			dbErr:       errTestDbFind,
+			expectErr:   errTestDbFind,
+		},
 	}
 
 	for _, tt := range tests {
@@ -442,20 +435,7 @@ func TestStakingSeqCreator_Run(t *testing.T) {
 			ctx := context.Background()
 
 			mockDb := mock.NewMockStakingSeqCreatorTaskStore(ctrl)
-
-			if tt.dbErr == errTestDbFind {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(nil, errTestDbFind).Times(1)
-			} else if tt.dbErr == errTestDbCreate {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(nil, store.ErrNotFound).Times(1)
-				mockDb.EXPECT().Create(tt.expectStakingSeq).Return(errTestDbCreate).Times(1)
-			} else if tt.dbErr == store.ErrNotFound {
-				// expet new seq to be created and added to payload
-				mockDb.EXPECT().FindByHeight(currHeight).Return(nil, store.ErrNotFound).Times(1)
-				mockDb.EXPECT().Create(tt.expectStakingSeq).Return(nil).Times(1)
-			} else {
-				// expect existing seq to be added to payload
-				mockDb.EXPECT().FindByHeight(currHeight).Return(existing, nil).Times(1)
-			}
+			mockDb.EXPECT().FindByHeight(currHeight).Return(tt.dbReturn, tt.dbErr).Times(1)
 
 			task := NewStakingSeqCreatorTask(mockDb)
 			pl := &payload{
@@ -476,8 +456,13 @@ func TestStakingSeqCreator_Run(t *testing.T) {
 				return
 			}
 
-			if !reflect.DeepEqual(pl.StakingSequence, tt.expectStakingSeq) {
-				t.Errorf("unexpected NewBlockSequence, want: %+v, got: %+v", tt.expectStakingSeq, pl.StakingSequence)
+			if !reflect.DeepEqual(pl.NewStakingSequence, tt.expectNew) {
+				t.Errorf("unexpected NewStakingSequence, want: %+v, got: %+v", tt.expectNew, pl.NewStakingSequence)
+				return
+			}
+
+			if !reflect.DeepEqual(pl.UpdatedStakingSequence, tt.expectUpdated) {
+				t.Errorf("unexpected UpdatedStakingSequence, want: %+v, got: %+v", tt.expectUpdated, pl.UpdatedStakingSequence)
 				return
 			}
 		})
@@ -656,45 +641,28 @@ func TestDelegationSeqCreator_Run(t *testing.T) {
 		expectSeq   []model.DelegationSeq
 	}{
 		{
-			description: "Adds exisitng delegation seq to payload",
-			rawStaking: testpbStaking(
-				setStakingDelegationEntry("t0", "del1", uintToBytes(100, t)),
-			),
-			dbReturn:  []model.DelegationSeq{toModel("t0", "del1", uintToBytes(100, t))},
-			dbErr:     nil,
-			expectErr: nil,
-			expectSeq: []model.DelegationSeq{toModel("t0", "del1", uintToBytes(100, t))},
+			description: "does not call db when there are no delegations",
+			rawStaking:  testpbStaking(),
+			expectSeq:   nil,
 		},
 		{
-			description: "Adds new delegation seq to payload",
+			description: "returns err on unexpected FindByHeight error",
 			rawStaking: testpbStaking(
 				setStakingDelegationEntry("t0", "del1", uintToBytes(100, t)),
 			),
-			dbReturn:  []model.DelegationSeq{},
-			dbErr:     nil,
-			expectErr: nil,
-			expectSeq: []model.DelegationSeq{toModel("t0", "del1", uintToBytes(100, t))},
+			dbErr:     errTestDbFind,
+			expectErr: errTestDbFind,
 		},
 		{
-			description: "Returns err on unexpected FindByHeight error",
+			description: "adds new delegation sequences to payload",
 			rawStaking: testpbStaking(
 				setStakingDelegationEntry("t0", "del1", uintToBytes(100, t)),
 			),
 			dbReturn:  []model.DelegationSeq{},
-			dbErr:     errTestDbFind,
-			expectErr: errTestDbFind,
-			expectSeq: []model.DelegationSeq{},
-		},
-		{
-			description: "Adds empty list to payload when there's no delegations sequence",
-			rawStaking:  testpbStaking(),
-			dbReturn:    []model.DelegationSeq{},
-			dbErr:       nil,
-			expectErr:   nil,
-			expectSeq:   []model.DelegationSeq{},
+			expectSeq: []model.DelegationSeq{toModel("t0", "del1", uintToBytes(100, t))},
 		},
 		{
-			description: "Adds new and existing delegations sequence to payload",
+			description: "skips delegations which are already sequenced",
 			rawStaking: testpbStaking(
 				setStakingDelegationEntry("t0", "del1", uintToBytes(100, t)),
 				setStakingDelegationEntry("t0", "newdel", uintToBytes(200, t)),
@@ -705,42 +673,23 @@ func TestDelegationSeqCreator_Run(t *testing.T) {
 				toModel("t0", "del1", uintToBytes(100, t)),
 				toModel("t1", "del1", uintToBytes(400, t)),
 			},
-			dbErr:     nil,
-			expectErr: nil,
 			expectSeq: []model.DelegationSeq{
-				toModel("t0", "del1", uintToBytes(100, t)),
 				toModel("t0", "newdel", uintToBytes(200, t)),
 				toModel("t3", "newdel2", uintToBytes(300, t)),
-				toModel("t1", "del1", uintToBytes(400, t)),
 			},
 		},
 	}
 
 	for _, tt := range tests {
+		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
 			ctrl := gomock.NewController(t)
 			ctx := context.Background()
 			mockDb := mock.NewMockDelegationSeqCreatorTaskStore(ctrl)
 
-			if tt.dbErr != nil {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(nil, tt.dbErr).Times(1)
-			} else {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(tt.dbReturn, nil).Times(1)
-
-				// expect create to be called on each delegation seq not in dbReturn
-				for _, seq := range tt.expectSeq {
-					var found bool
-					for _, extSeq := range tt.dbReturn {
-						if reflect.DeepEqual(seq, extSeq) {
-							found = true
-							break
-						}
-					}
-					if !found {
-						s := seq
-						mockDb.EXPECT().Create(&s).Return(nil).Times(1)
-					}
-				}
+			if len(tt.rawStaking.GetDelegations()) > 0 {
+				mockDb.EXPECT().FindByHeight(currHeight).Return(tt.dbReturn, tt.dbErr).Times(1)
 			}
 
 			task := NewDelegationsSeqCreatorTask(mockDb)
@@ -762,24 +711,22 @@ func TestDelegationSeqCreator_Run(t *testing.T) {
 				return
 			}
 
-			if len(pl.DelegationSequences) != len(tt.expectSeq) {
-				t.Errorf("expected payload.DelegationSequences to contain all sequences, got: %v; want: %v", len(pl.DelegationSequences), len(tt.expectSeq))
+			if len(pl.NewDelegationSequences) != len(tt.expectSeq) {
+				t.Errorf("unexpected payload.NewDelegationSequences length, want: %v; got: %v", len(tt.expectSeq), len(pl.NewDelegationSequences))
 				return
 			}
 
+			// delegations map is iterated in random order
 			for _, expectVal := range tt.expectSeq {
 				var found bool
-				for _, val := range pl.DelegationSequences {
-					if val.DelegatorUID == expectVal.DelegatorUID && val.ValidatorUID == expectVal.ValidatorUID {
-						if !reflect.DeepEqual(val, expectVal) {
-							t.Errorf("unexpected entry in payload.DelegationSequences, got: %v; want: %v", val, expectVal)
-						}
+				for _, val := range pl.NewDelegationSequences {
+					if reflect.DeepEqual(val, expectVal) {
 						found = true
 						break
 					}
 				}
 				if !found {
-					t.Errorf("missing entry in payload.DelegationSequences, want: %v", expectVal)
+					t.Errorf("missing entry in payload.NewDelegationSequences, want: %v", expectVal)
 				}
 			}
 		})
@@ -817,45 +764,28 @@ func TestDebondingDelegationSeqCreator_Run(t *testing.T) {
 		expectSeq   []model.DebondingDelegationSeq
 	}{
 		{
-			description: "Adds exisitng delegation seq to payload",
-			rawStaking: testpbStaking(
-				setDebondingDelegationEntry("t0", "del1", uintToBytes(100, t), 12),
-			),
-			dbReturn:  []model.DebondingDelegationSeq{toModel("t0", "del1", uintToBytes(100, t), 12)},
-			dbErr:     nil,
-			expectErr: nil,
-			expectSeq: []model.DebondingDelegationSeq{toModel("t0", "del1", uintToBytes(100, t), 12)},
-		},
-		{
-			description: "Adds new delegation seq to payload",
-			rawStaking: testpbStaking(
-				setDebondingDelegationEntry("t0", "del1", uintToBytes(100, t), 14),
-			),
-			dbReturn:  []model.DebondingDelegationSeq{},
-			dbErr:     nil,
-			expectErr: nil,
-			expectSeq: []model.DebondingDelegationSeq{toModel("t0", "del1", uintToBytes(100, t), 14)},
+			description: "does not call db when there are no debonding delegations",
+			rawStaking:  testpbStaking(),
+			expectSeq:   nil,
 		},
 		{
-			description: "Returns err on unexpected FindByHeight error",
+			description: "returns err on unexpected FindByHeight error",
 			rawStaking: testpbStaking(
 				setDebondingDelegationEntry("t0", "del1", uintToBytes(100, t), 5),
 			),
-			dbReturn:  []model.DebondingDelegationSeq{},
 			dbErr:     errTestDbFind,
 			expectErr: errTestDbFind,
-			expectSeq: []model.DebondingDelegationSeq{},
 		},
 		{
-			description: "Adds empty list to payload when there's no delegations sequence",
-			rawStaking:  testpbStaking(),
-			dbReturn:    []model.DebondingDelegationSeq{},
-			dbErr:       nil,
-			expectErr:   nil,
-			expectSeq:   []model.DebondingDelegationSeq{},
+			description: "adds new debonding delegation sequences to payload",
+			rawStaking: testpbStaking(
+				setDebondingDelegationEntry("t0", "del1", uintToBytes(100, t), 14),
+			),
+			dbReturn:  []model.DebondingDelegationSeq{},
+			expectSeq: []model.DebondingDelegationSeq{toModel("t0", "del1", uintToBytes(100, t), 14)},
 		},
 		{
-			description: "Adds new and existing delegations sequence to payload",
+			description: "skips debonding delegations which are already sequenced",
 			rawStaking: testpbStaking(
 				setDebondingDelegationEntry("t0", "del1", uintToBytes(100, t), 1),
 				setDebondingDelegationEntry("t0", "newdel", uintToBytes(200, t), 2),
@@ -866,44 +796,23 @@ func TestDebondingDelegationSeqCreator_Run(t *testing.T) {
 				toModel("t0", "del1", uintToBytes(100, t), 1),
 				toModel("t1", "del1", uintToBytes(400, t), 4),
 			},
-			dbErr:     nil,
-			expectErr: nil,
 			expectSeq: []model.DebondingDelegationSeq{
-				toModel("t0", "del1", uintToBytes(100, t), 1),
 				toModel("t0", "newdel", uintToBytes(200, t), 2),
 				toModel("t3", "newdel2", uintToBytes(300, t), 3),
-				toModel("t1", "del1", uintToBytes(400, t), 4),
 			},
 		},
 	}
 
 	for _, tt := range tests {
-		tt := tt // need to set this since running tests in parallel
+		tt := tt
 		t.Run(tt.description, func(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 			ctx := context.Background()
 			mockDb := mock.NewMockDebondingDelegationSeqCreatorTaskStore(ctrl)
 
-			if tt.dbErr != nil {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(nil, tt.dbErr).Times(1)
-			} else {
-				mockDb.EXPECT().FindByHeight(currHeight).Return(tt.dbReturn, nil).Times(1)
-
-				// expect create to be called on each delegation seq not in dbReturn
-				for _, seq := range tt.expectSeq {
-					var found bool
-					for _, extSeq := range tt.dbReturn {
-						if reflect.DeepEqual(seq, extSeq) {
-							found = true
-							break
-						}
-					}
-					if !found {
-						s := seq
-						mockDb.EXPECT().Create(&s).Return(nil).Times(1)
-					}
-				}
+			if len(tt.rawStaking.GetDebondingDelegations()) > 0 {
+				mockDb.EXPECT().FindByHeight(currHeight).Return(tt.dbReturn, tt.dbErr).Times(1)
 			}
 
 			task := NewDebondingDelegationsSeqCreatorTask(mockDb)
@@ -925,25 +834,221 @@ func TestDebondingDelegationSeqCreator_Run(t *testing.T) {
 				return
 			}
 
-			if len(pl.DebondingDelegationSequences) != len(tt.expectSeq) {
-				t.Errorf("expected payload.DebondingDelegationSequences to contain all sequences, got: %v; want: %v", len(pl.DelegationSequences), len(tt.expectSeq))
+			if len(pl.NewDebondingDelegationSequences) != len(tt.expectSeq) {
+				t.Errorf("unexpected payload.NewDebondingDelegationSequences length, want: %v; got: %v", len(tt.expectSeq), len(pl.NewDebondingDelegationSequences))
 				return
 			}
 
+			// debonding delegations map is iterated in random order
 			for _, expectVal := range tt.expectSeq {
 				var found bool
-				for _, val := range pl.DebondingDelegationSequences {
-					if val.DelegatorUID == expectVal.DelegatorUID && val.ValidatorUID == expectVal.ValidatorUID {
-						if !reflect.DeepEqual(val, expectVal) {
-							t.Errorf("unexpected entry in payload.DebondingDelegationSequences, got: %v; want: %v", val, expectVal)
-						}
+				for _, val := range pl.NewDebondingDelegationSequences {
+					if reflect.DeepEqual(val, expectVal) {
 						found = true
 						break
 					}
 				}
 				if !found {
-					t.Errorf("missing entry in payload.DebondingDelegationSequences, want: %v", expectVal)
+					t.Errorf("missing entry in payload.NewDebondingDelegationSequences, want: %v", expectVal)
+				}
+			}
+		})
+	}
+}
+
+func TestTransferEventSeqCreator_Run(t *testing.T) {
+	var currHeight int64 = 20
+
+	sync := &model.Syncable{
+		Height: currHeight,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+
+	raw1 := &eventpb.TransferEvent{From: "from1", To: "to1", Amount: randBytes(5)}
+	raw2 := &eventpb.TransferEvent{From: "from2", To: "to2", Amount: randBytes(5)}
+
+	rawToModel := func(raw *eventpb.TransferEvent) model.TransferEventSeq {
+		return model.TransferEventSeq{
+			Sequence: &model.Sequence{
+				Height: sync.Height,
+				Time:   sync.Time,
+			},
+			FromAddress: raw.GetFrom(),
+			ToAddress:   raw.GetTo(),
+			Amount:      types.NewQuantityFromBytes(raw.GetAmount()),
+		}
+	}
+
+	tests := []struct {
+		description string
+		raw         []*eventpb.TransferEvent
+		existing    []*eventpb.TransferEvent
+
+		dbErr     error
+		expectErr error
+		expectSeq []model.TransferEventSeq
+	}{
+		{
+			description: "does not call db when there are no transfer events",
+			raw:         nil,
+			expectSeq:   nil,
+		},
+		{
+			description: "returns err on unexpected FindByHeight error",
+			raw:         []*eventpb.TransferEvent{raw1},
+			dbErr:       errTestDbFind,
+			expectErr:   errTestDbFind,
+		},
+		{
+			description: "adds new transfer event sequences to payload",
+			raw:         []*eventpb.TransferEvent{raw1, raw2},
+			expectSeq:   []model.TransferEventSeq{rawToModel(raw1), rawToModel(raw2)},
+		},
+		{
+			description: "skips transfer events which are already sequenced",
+			raw:         []*eventpb.TransferEvent{raw1, raw2},
+			existing:    []*eventpb.TransferEvent{raw1},
+			expectSeq:   []model.TransferEventSeq{rawToModel(raw2)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockDb := mock.NewMockTransferEventSeqCreatorTaskStore(ctrl)
+
+			if len(tt.raw) > 0 {
+				dbReturn := make([]model.TransferEventSeq, len(tt.existing))
+				for i, raw := range tt.existing {
+					dbReturn[i] = rawToModel(raw)
 				}
+				mockDb.EXPECT().FindByHeight(currHeight).Return(dbReturn, tt.dbErr).Times(1)
+			}
+
+			task := NewTransferEventSeqCreatorTask(mockDb)
+			pl := &payload{
+				CurrentHeight:     currHeight,
+				Syncable:          sync,
+				RawTransferEvents: tt.raw,
+			}
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+
+			// skip payload check if there's an error
+			if tt.expectErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(pl.NewTransferEventSequences, tt.expectSeq) {
+				t.Errorf("unexpected payload.NewTransferEventSequences, want: %+v; got: %+v", tt.expectSeq, pl.NewTransferEventSequences)
+			}
+		})
+	}
+}
+
+func TestEscrowEventSeqCreator_Run(t *testing.T) {
+	var currHeight int64 = 20
+
+	sync := &model.Syncable{
+		Height: currHeight,
+		Time:   *types.NewTimeFromTime(time.Date(2020, 11, 10, 23, 0, 0, 0, time.UTC)),
+	}
+	seq := func() *model.Sequence {
+		return &model.Sequence{
+			Height: sync.Height,
+			Time:   sync.Time,
+		}
+	}
+
+	rawAdd := &eventpb.AddEscrowEvent{Owner: "owner1", Escrow: "escrow1", Amount: randBytes(5), NewShares: randBytes(5)}
+	rawTake := &eventpb.TakeEscrowEvent{Owner: "escrow1", Amount: randBytes(5)}
+
+	addSeq := model.EscrowEventSeq{
+		Sequence: seq(),
+		Kind:     model.EscrowEventKindAdd,
+		Owner:    rawAdd.GetOwner(),
+		Escrow:   rawAdd.GetEscrow(),
+		Amount:   types.NewQuantityFromBytes(rawAdd.GetAmount()),
+		Shares:   types.NewQuantityFromBytes(rawAdd.GetNewShares()),
+	}
+	takeSeq := model.EscrowEventSeq{
+		Sequence: seq(),
+		Kind:     model.EscrowEventKindTake,
+		Owner:    rawTake.GetOwner(),
+		Escrow:   rawTake.GetOwner(),
+		Amount:   types.NewQuantityFromBytes(rawTake.GetAmount()),
+	}
+
+	tests := []struct {
+		description string
+		raw         *eventpb.EscrowEvents
+		existing    []model.EscrowEventSeq
+
+		dbErr     error
+		expectErr error
+		expectSeq []model.EscrowEventSeq
+	}{
+		{
+			description: "does not call db when there are no escrow events",
+			raw:         &eventpb.EscrowEvents{},
+			expectSeq:   nil,
+		},
+		{
+			description: "returns err on unexpected FindByHeight error",
+			raw:         &eventpb.EscrowEvents{Add: []*eventpb.AddEscrowEvent{rawAdd}},
+			dbErr:       errTestDbFind,
+			expectErr:   errTestDbFind,
+		},
+		{
+			description: "adds new escrow event sequences to payload",
+			raw:         &eventpb.EscrowEvents{Add: []*eventpb.AddEscrowEvent{rawAdd}, Take: []*eventpb.TakeEscrowEvent{rawTake}},
+			expectSeq:   []model.EscrowEventSeq{addSeq, takeSeq},
+		},
+		{
+			description: "skips escrow events which are already sequenced",
+			raw:         &eventpb.EscrowEvents{Add: []*eventpb.AddEscrowEvent{rawAdd}, Take: []*eventpb.TakeEscrowEvent{rawTake}},
+			existing:    []model.EscrowEventSeq{addSeq},
+			expectSeq:   []model.EscrowEventSeq{takeSeq},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockDb := mock.NewMockEscrowEventSeqCreatorTaskStore(ctrl)
+
+			if len(tt.raw.GetAdd())+len(tt.raw.GetTake()) > 0 {
+				mockDb.EXPECT().FindByHeight(currHeight).Return(tt.existing, tt.dbErr).Times(1)
+			}
+
+			task := NewEscrowEventSeqCreatorTask(mockDb)
+			pl := &payload{
+				CurrentHeight:   currHeight,
+				Syncable:        sync,
+				RawEscrowEvents: tt.raw,
+			}
+
+			if err := task.Run(ctx, pl); err != tt.expectErr {
+				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
+				return
+			}
+
+			// skip payload check if there's an error
+			if tt.expectErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(pl.NewEscrowEventSequences, tt.expectSeq) {
+				t.Errorf("unexpected payload.NewEscrowEventSequences, want: %+v; got: %+v", tt.expectSeq, pl.NewEscrowEventSequences)
 			}
 		})
 	}
@@ -41,7 +41,7 @@ func (t *heightMetaRetrieverTask) Run(ctx context.Context, p pipeline.Payload) e
 
 	logger.Info(fmt.Sprintf("running indexer task [stage=%s] [task=%s] [height=%d]", pipeline.StageSetup, t.GetName(), payload.CurrentHeight))
 
-	meta, err := t.client.GetMetaByHeight(payload.CurrentHeight)
+	meta, err := t.client.GetMetaByHeight(ctx, payload.CurrentHeight)
 	if err != nil {
 		return err
 	}
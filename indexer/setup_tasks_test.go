@@ -23,7 +23,7 @@ func TestSetup_Run(t *testing.T) {
 		task := NewHeightMetaRetrieverTask(mockClient)
 		pl := &payload{CurrentHeight: 6}
 
-		mockClient.EXPECT().GetMetaByHeight(pl.CurrentHeight).Return(nil, errTestClient).Times(1)
+		mockClient.EXPECT().GetMetaByHeight(gomock.Any(), pl.CurrentHeight).Return(nil, errTestClient).Times(1)
 
 		if result := task.Run(ctx, pl); result != errTestClient {
 			t.Errorf("want: %v, got: %v", errTestClient, result)
@@ -46,7 +46,7 @@ func TestSetup_Run(t *testing.T) {
 
 		pl := &payload{CurrentHeight: 6}
 
-		mockClient.EXPECT().GetMetaByHeight(pl.CurrentHeight).Return(
+		mockClient.EXPECT().GetMetaByHeight(gomock.Any(), pl.CurrentHeight).Return(
 			&chainpb.GetMetaByHeightResponse{
 				Height:       tt.height,
 				Time:         tt.timestamp,
@@ -0,0 +1,158 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+var (
+	_ pipeline.Source = (*followSource)(nil)
+)
+
+// FollowConfig holds intervals used when following chain head
+type FollowConfig struct {
+	PollInterval time.Duration
+	MaxBackoff   time.Duration
+}
+
+// NewFollowSource creates source which never runs out of heights, instead it waits for chain head to move.
+// It returns ErrNothingToProcess when context is done before first height is available.
+func NewFollowSource(ctx context.Context, cfg *config.Config, db SourceIndexStore, client client.ChainClient, followCfg FollowConfig) (*followSource, error) {
+	src := &followSource{
+		cfg:    cfg,
+		db:     db,
+		client: client,
+
+		pollInterval: followCfg.PollInterval,
+		backoff:      newBackoff(followCfg.PollInterval, followCfg.MaxBackoff),
+	}
+	if err := src.init(ctx); err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+type followSource struct {
+	cfg    *config.Config
+	db     SourceIndexStore
+	client client.ChainClient
+
+	pollInterval time.Duration
+	backoff      *backoff
+
+	startHeight   int64
+	currentHeight int64
+	headHeight    int64
+	err           error
+}
+
+func (s *followSource) Next(ctx context.Context, _ pipeline.Payload) bool {
+	if s.err != nil || !s.waitForHeight(ctx, s.currentHeight+1) {
+		return false
+	}
+	s.currentHeight = s.currentHeight + 1
+	s.setLag()
+	return true
+}
+
+func (s *followSource) Skip(stageName pipeline.StageName) bool {
+	return false
+}
+
+func (s *followSource) Current() int64 {
+	return s.currentHeight
+}
+
+func (s *followSource) Err() error {
+	return s.err
+}
+
+func (s *followSource) Len() int64 {
+	return s.currentHeight - s.startHeight + 1
+}
+
+func (s *followSource) LastHeight() int64 {
+	return s.headHeight
+}
+
+func (s *followSource) init(ctx context.Context) error {
+	startH, err := getStartHeight(s.cfg, s.db)
+	if err != nil {
+		return err
+	}
+	s.startHeight = startH
+	s.currentHeight = startH
+
+	if !s.waitForHeight(ctx, startH) {
+		return ErrNothingToProcess
+	}
+	s.setLag()
+	return nil
+}
+
+// waitForHeight polls chain head until it reaches given height, backing off on client errors.
+// It returns false when context is done before that happens.
+func (s *followSource) waitForHeight(ctx context.Context, height int64) bool {
+	if s.headHeight >= height {
+		return true
+	}
+
+	for {
+		wait := s.pollInterval
+
+		head, err := s.client.GetHead(ctx)
+		if err != nil {
+			wait = s.backoff.next()
+			logger.Error(fmt.Errorf("could not get chain head, retrying in %s: %w", wait, err))
+		} else {
+			s.backoff.reset()
+			s.headHeight = head.GetHeight()
+			if s.headHeight >= height {
+				return true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (s *followSource) setLag() {
+	indexerFollowLag.Set(float64(s.headHeight - s.currentHeight))
+}
+
+// backoff doubles wait duration after each consecutive failure, up to max
+type backoff struct {
+	min     time.Duration
+	max     time.Duration
+	current time.Duration
+}
+
+func newBackoff(min time.Duration, max time.Duration) *backoff {
+	return &backoff{min: min, max: max}
+}
+
+func (b *backoff) next() time.Duration {
+	if b.current == 0 {
+		b.current = b.min
+	} else {
+		b.current = b.current * 2
+	}
+	if b.current > b.max {
+		b.current = b.max
+	}
+	return b.current
+}
+
+func (b *backoff) reset() {
+	b.current = 0
+}
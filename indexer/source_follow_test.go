@@ -0,0 +1,175 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock_client "github.com/figment-networks/oasishub-indexer/mock/client"
+	mock "github.com/figment-networks/oasishub-indexer/mock/indexer"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSource_NewFollowSource(t *testing.T) {
+	const configStartH int64 = 3
+
+	followCfg := FollowConfig{PollInterval: time.Millisecond, MaxBackoff: 4 * time.Millisecond}
+
+	t.Run("should start from next block if last block is already processed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockChainClient(ctrl)
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(10), nil)
+
+		dbMock := mock.NewMockSourceIndexStore(ctrl)
+		dbMock.EXPECT().FindMostRecent().Return(testSyncable(5, true), nil)
+
+		source, err := NewFollowSource(context.Background(), &config.Config{}, dbMock, clientMock, followCfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if source.Current() != 6 {
+			t.Errorf("unexpected source.current, want %v; got %v", 6, source.Current())
+		}
+	})
+
+	t.Run("should start from config startheight if last block doesnt exist in store", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockChainClient(ctrl)
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(10), nil)
+
+		dbMock := mock.NewMockSourceIndexStore(ctrl)
+		dbMock.EXPECT().FindMostRecent().Return(nil, store.ErrNotFound)
+
+		cfg := &config.Config{FirstBlockHeight: configStartH}
+		source, err := NewFollowSource(context.Background(), cfg, dbMock, clientMock, followCfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if source.Current() != configStartH {
+			t.Errorf("unexpected source.current, want %v; got %v", configStartH, source.Current())
+		}
+	})
+
+	t.Run("handle unexpected db error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockChainClient(ctrl)
+
+		dbMock := mock.NewMockSourceIndexStore(ctrl)
+		dbMock.EXPECT().FindMostRecent().Return(nil, errTestDbFind)
+
+		if _, err := NewFollowSource(context.Background(), &config.Config{}, dbMock, clientMock, followCfg); err != errTestDbFind {
+			t.Errorf("unexpected error, want %v; got %v", errTestDbFind, err)
+		}
+	})
+
+	t.Run("should wait for head and retry client errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockChainClient(ctrl)
+		gomock.InOrder(
+			clientMock.EXPECT().GetHead(gomock.Any()).Return(nil, errTestClient),
+			clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(5), nil),
+			clientMock.EXPECT().GetHead(gomock.Any()).Return(nil, errTestClient),
+			clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(6), nil),
+		)
+
+		dbMock := mock.NewMockSourceIndexStore(ctrl)
+		dbMock.EXPECT().FindMostRecent().Return(testSyncable(5, true), nil)
+
+		source, err := NewFollowSource(context.Background(), &config.Config{}, dbMock, clientMock, followCfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if source.Current() != 6 {
+			t.Errorf("unexpected source.current, want %v; got %v", 6, source.Current())
+		}
+	})
+
+	t.Run("return error when context is done before first height", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientMock := mock_client.NewMockChainClient(ctrl)
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(5), nil).MinTimes(1)
+
+		dbMock := mock.NewMockSourceIndexStore(ctrl)
+		dbMock.EXPECT().FindMostRecent().Return(testSyncable(5, true), nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		if _, err := NewFollowSource(ctx, &config.Config{}, dbMock, clientMock, followCfg); err != ErrNothingToProcess {
+			t.Errorf("unexpected error, want %v; got %v", ErrNothingToProcess, err)
+		}
+	})
+}
+
+func TestSource_FollowSourceNext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientMock := mock_client.NewMockChainClient(ctrl)
+	gomock.InOrder(
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(7), nil),
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(7), nil),
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(8), nil),
+		clientMock.EXPECT().GetHead(gomock.Any()).Return(testpbChainResp(8), nil).AnyTimes(),
+	)
+
+	dbMock := mock.NewMockSourceIndexStore(ctrl)
+	dbMock.EXPECT().FindMostRecent().Return(testSyncable(5, true), nil)
+
+	followCfg := FollowConfig{PollInterval: time.Millisecond, MaxBackoff: time.Millisecond}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	source, err := NewFollowSource(ctx, &config.Config{}, dbMock, clientMock, followCfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pl := &payload{}
+	for _, expectCurrent := range []int64{7, 8} {
+		if ok := source.Next(ctx, pl); !ok {
+			t.Fatalf("expected source.Next to return true for height %v", expectCurrent)
+		}
+		if source.Current() != expectCurrent {
+			t.Errorf("unexpected source.current, want %v; got %v", expectCurrent, source.Current())
+		}
+	}
+
+	if source.Len() != 3 {
+		t.Errorf("unexpected source.len, want %v; got %v", 3, source.Len())
+	}
+
+	cancel()
+	if ok := source.Next(ctx, pl); ok {
+		t.Errorf("expected source.Next to return false when context is done")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	b := newBackoff(time.Second, 5*time.Second)
+
+	for _, expect := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := b.next(); got != expect {
+			t.Errorf("unexpected backoff, want %v; got %v", expect, got)
+		}
+	}
+
+	b.reset()
+	if got := b.next(); got != time.Second {
+		t.Errorf("unexpected backoff after reset, want %v; got %v", time.Second, got)
+	}
+}
@@ -21,7 +21,7 @@ type SourceIndexStore interface {
 	FindMostRecent() (*model.Syncable, error)
 }
 
-func NewIndexSource(cfg *config.Config, db SourceIndexStore, client client.ChainClient, startHeight int64, batchSize int64) (*indexSource, error) {
+func NewIndexSource(ctx context.Context, cfg *config.Config, db SourceIndexStore, client client.ChainClient, startHeight int64, batchSize int64) (*indexSource, error) {
 	src := &indexSource{
 		cfg:    cfg,
 		db:     db,
@@ -32,7 +32,7 @@ func NewIndexSource(cfg *config.Config, db SourceIndexStore, client client.Chain
 		startHeight:   startHeight,
 		currentHeight: startHeight,
 	}
-	if err := src.init(); err != nil {
+	if err := src.init(ctx); err != nil {
 		return nil, err
 	}
 	return src, nil
@@ -51,8 +51,8 @@ type indexSource struct {
 	err           error
 }
 
-func (s *indexSource) Next(context.Context, pipeline.Payload) bool {
-	if s.err == nil && s.currentHeight < s.endHeight {
+func (s *indexSource) Next(ctx context.Context, _ pipeline.Payload) bool {
+	if s.err == nil && ctx.Err() == nil && s.currentHeight < s.endHeight {
 		s.currentHeight = s.currentHeight + 1
 		return true
 	}
@@ -75,11 +75,15 @@ func (s *indexSource) Len() int64 {
 	return s.endHeight - s.startHeight + 1
 }
 
-func (s *indexSource) init() error {
+func (s *indexSource) LastHeight() int64 {
+	return s.endHeight
+}
+
+func (s *indexSource) init(ctx context.Context) error {
 	if err := s.setStartHeight(); err != nil {
 		return err
 	}
-	if err := s.setEndHeight(); err != nil {
+	if err := s.setEndHeight(ctx); err != nil {
 		return err
 	}
 	if err := s.validate(); err != nil {
@@ -90,21 +94,9 @@ func (s *indexSource) init() error {
 
 func (s *indexSource) setStartHeight() error {
 	if s.startHeight == 0 {
-		var startH int64
-		syncable, err := s.db.FindMostRecent()
+		startH, err := getStartHeight(s.cfg, s.db)
 		if err != nil {
-			if err != store.ErrNotFound {
-				return err
-			}
-			// No syncables found, get first block number from config
-			startH = s.cfg.FirstBlockHeight
-		} else {
-			// Reindex if last syncable failed
-			if syncable.ProcessedAt == nil {
-				startH = syncable.Height
-			} else {
-				startH = syncable.Height + 1
-			}
+			return err
 		}
 		s.currentHeight = startH
 		s.startHeight = startH
@@ -112,8 +104,8 @@ func (s *indexSource) setStartHeight() error {
 	return nil
 }
 
-func (s *indexSource) setEndHeight() error {
-	syncableFromNode, err := s.client.GetHead()
+func (s *indexSource) setEndHeight(ctx context.Context) error {
+	syncableFromNode, err := s.client.GetHead(ctx)
 	if err != nil {
 		return err
 	}
@@ -135,3 +127,20 @@ func (s *indexSource) validate() error {
 	}
 	return nil
 }
+
+// getStartHeight returns height following most recent syncable, or first block height from config
+func getStartHeight(cfg *config.Config, db SourceIndexStore) (int64, error) {
+	syncable, err := db.FindMostRecent()
+	if err != nil {
+		if err != store.ErrNotFound {
+			return 0, err
+		}
+		// No syncables found, get first block number from config
+		return cfg.FirstBlockHeight, nil
+	}
+	// Reindex if last syncable failed
+	if syncable.ProcessedAt == nil {
+		return syncable.Height, nil
+	}
+	return syncable.Height + 1, nil
+}
@@ -118,13 +118,13 @@ func TestSource_NewIndexSource(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			clientMock := mock_client.NewMockChainClient(ctrl)
-			clientMock.EXPECT().GetHead().Return(tt.clientResp, tt.clientErr)
+			clientMock.EXPECT().GetHead(gomock.Any()).Return(tt.clientResp, tt.clientErr)
 
 			dbMock := mock.NewMockSourceIndexStore(ctrl)
 			dbMock.EXPECT().FindMostRecent().Return(tt.dbResp, tt.dbErr)
 
 			cfg := &config.Config{FirstBlockHeight: configStartH}
-			source, err := NewIndexSource(cfg, dbMock, clientMock, tt.startHeight, batchSize)
+			source, err := NewIndexSource(context.Background(), cfg, dbMock, clientMock, tt.startHeight, batchSize)
 
 			if err != tt.expectErr {
 				t.Errorf("unexpected error, want %v; got %v", tt.expectErr, err)
@@ -0,0 +1,44 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/figment-networks/indexing-engine/pipeline"
+)
+
+var (
+	_ pipeline.Source = (*prefetchSource)(nil)
+	_ boundedSource   = (*indexSource)(nil)
+	_ boundedSource   = (*followSource)(nil)
+)
+
+// boundedSource is a source which knows the last height available on chain
+type boundedSource interface {
+	pipeline.Source
+
+	LastHeight() int64
+}
+
+// NewPrefetchSource wraps source so that raw data of heights in window ahead of current height is fetched in the background
+func NewPrefetchSource(ctx context.Context, source boundedSource, fetcher *prefetcher) *prefetchSource {
+	fetcher.prefetch(ctx, source.Current(), source.LastHeight())
+
+	return &prefetchSource{
+		boundedSource: source,
+		fetcher:       fetcher,
+	}
+}
+
+type prefetchSource struct {
+	boundedSource
+
+	fetcher *prefetcher
+}
+
+func (s *prefetchSource) Next(ctx context.Context, p pipeline.Payload) bool {
+	if !s.boundedSource.Next(ctx, p) {
+		return false
+	}
+	s.fetcher.prefetch(ctx, s.Current(), s.LastHeight())
+	return true
+}
@@ -39,8 +39,8 @@ type reindexSource struct {
 	err           error
 }
 
-func (s *reindexSource) Next(context.Context, pipeline.Payload) bool {
-	if s.err == nil && s.currentHeight < s.endHeight {
+func (s *reindexSource) Next(ctx context.Context, _ pipeline.Payload) bool {
+	if s.err == nil && ctx.Err() == nil && s.currentHeight < s.endHeight {
 		s.currentHeight = s.currentHeight + 1
 		return true
 	}
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS transfer_events
+(
+    id         BIGSERIAL                NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    height     DECIMAL(65, 0)           NOT NULL,
+    time       TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    from_address TEXT                   NOT NULL,
+    to_address   TEXT                   NOT NULL,
+    amount       DECIMAL(65, 0)         NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_transfer_events_height on transfer_events (height);
+CREATE index idx_transfer_events_time on transfer_events (time);
+CREATE index idx_transfer_events_from_address on transfer_events (from_address);
+CREATE index idx_transfer_events_to_address on transfer_events (to_address);
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS escrow_events
+(
+    id         BIGSERIAL                NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    height     DECIMAL(65, 0)           NOT NULL,
+    time       TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    kind       TEXT                     NOT NULL,
+    owner      TEXT                     NOT NULL,
+    escrow     TEXT                     NOT NULL,
+    amount     DECIMAL(65, 0)           NOT NULL,
+    shares     DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_escrow_events_height on escrow_events (height);
+CREATE index idx_escrow_events_time on escrow_events (time);
+CREATE index idx_escrow_events_owner on escrow_events (owner);
+CREATE index idx_escrow_events_escrow on escrow_events (escrow);
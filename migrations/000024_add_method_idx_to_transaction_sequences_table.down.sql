@@ -0,0 +1,2 @@
+DROP index IF EXISTS idx_transaction_sequences_public_key_height;
+DROP index IF EXISTS idx_transaction_sequences_method;
@@ -0,0 +1,2 @@
+CREATE index idx_transaction_sequences_public_key_height on transaction_sequences (public_key, height);
+CREATE index idx_transaction_sequences_method on transaction_sequences (method);
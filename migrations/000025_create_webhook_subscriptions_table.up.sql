@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id                   BIGSERIAL                NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    url                  TEXT                     NOT NULL,
+    actor                TEXT,
+    kind                 TEXT,
+    secret               TEXT                     NOT NULL,
+    last_system_event_id BIGINT                   NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_webhook_subscriptions_actor on webhook_subscriptions (actor);
+CREATE index idx_webhook_subscriptions_kind on webhook_subscriptions (kind);
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              BIGSERIAL                NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    subscription_id BIGINT                   NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    system_event_id BIGINT                   NOT NULL REFERENCES system_events (id) ON DELETE CASCADE,
+    status          TEXT                     NOT NULL,
+    attempts        INTEGER                  NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error      TEXT,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_webhook_deliveries_subscription_id on webhook_deliveries (subscription_id);
+CREATE index idx_webhook_deliveries_status_next_attempt_at on webhook_deliveries (status, next_attempt_at);
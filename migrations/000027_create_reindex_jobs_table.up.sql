@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS reindex_jobs
+(
+    id           BIGSERIAL                NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    report_id    BIGINT                   NOT NULL REFERENCES reports (id) ON DELETE CASCADE,
+    start_height DECIMAL(65, 0)           NOT NULL,
+    end_height   DECIMAL(65, 0)           NOT NULL,
+    target_ids   JSONB,
+    status       TEXT                     NOT NULL,
+    worker_id    TEXT,
+    attempts     INTEGER                  NOT NULL DEFAULT 0,
+    heartbeat_at TIMESTAMP WITH TIME ZONE,
+    last_error   TEXT,
+    completed_at TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_reindex_jobs_report_id on reindex_jobs (report_id);
+CREATE index idx_reindex_jobs_status_heartbeat_at on reindex_jobs (status, heartbeat_at);
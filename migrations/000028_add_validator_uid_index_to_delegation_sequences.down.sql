@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_delegation_sequences_height_validator_uid;
+DROP INDEX IF EXISTS idx_debonding_delegation_sequences_height_validator_uid;
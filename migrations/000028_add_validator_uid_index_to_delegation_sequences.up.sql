@@ -0,0 +1,2 @@
+CREATE index idx_delegation_sequences_height_validator_uid on delegation_sequences (height, validator_uid);
+CREATE index idx_debonding_delegation_sequences_height_validator_uid on debonding_delegation_sequences (height, validator_uid);
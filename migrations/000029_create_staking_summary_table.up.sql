@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS staking_summary
+(
+    id                        BIGSERIAL                NOT NULL,
+    created_at                TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at                TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    time_interval             VARCHAR                  NOT NULL,
+    time_bucket               TIMESTAMP WITH TIME ZONE NOT NULL,
+    index_version             INT                      NOT NULL,
+
+    total_supply_avg          DECIMAL(65, 0)           NOT NULL,
+    total_supply_max          DECIMAL(65, 0)           NOT NULL,
+    total_supply_min          DECIMAL(65, 0)           NOT NULL,
+    common_pool_avg           DECIMAL(65, 0)           NOT NULL,
+    common_pool_max           DECIMAL(65, 0)           NOT NULL,
+    common_pool_min           DECIMAL(65, 0)           NOT NULL,
+    debonding_interval_max    BIGINT                   NOT NULL,
+    min_delegation_amount_max DECIMAL(65, 0)           NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_staking_summary_time on staking_summary (time_interval, time_bucket);
+CREATE index idx_staking_summary_index_version on staking_summary (index_version);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_account_aggregates_general_balance;
+DROP INDEX IF EXISTS idx_account_aggregates_escrow_balance;
+DROP INDEX IF EXISTS idx_account_aggregates_total_balance;
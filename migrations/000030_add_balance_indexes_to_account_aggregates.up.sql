@@ -0,0 +1,3 @@
+CREATE index idx_account_aggregates_general_balance on account_aggregates (recent_general_balance);
+CREATE index idx_account_aggregates_escrow_balance on account_aggregates ((recent_escrow_active_balance + recent_escrow_debonding_balance));
+CREATE index idx_account_aggregates_total_balance on account_aggregates ((recent_general_balance + recent_escrow_active_balance + recent_escrow_debonding_balance));
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS network_summary
+(
+    id                   BIGSERIAL                NOT NULL,
+    created_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at           TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    time_interval        VARCHAR                  NOT NULL,
+    time_bucket          TIMESTAMP WITH TIME ZONE NOT NULL,
+    index_version        INT                      NOT NULL,
+
+    height               DECIMAL(65, 0)           NOT NULL,
+    total_staked         DECIMAL(65, 0)           NOT NULL,
+    staking_ratio        DECIMAL                  NOT NULL,
+    active_validators    BIGINT                   NOT NULL,
+    nakamoto_coefficient BIGINT                   NOT NULL,
+    gini_coefficient     DECIMAL                  NOT NULL,
+    commission_avg       DECIMAL                  NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- Indexes
+CREATE index idx_network_summary_time on network_summary (time_interval, time_bucket);
+CREATE index idx_network_summary_index_version on network_summary (index_version);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_validator_sequences_address_height;
@@ -0,0 +1 @@
+CREATE index idx_validator_sequences_address_height on validator_sequences (address, height);
@@ -5,6 +5,7 @@
 package mock_client
 
 import (
+	context "context"
 	accountpb "github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
 	blockpb "github.com/figment-networks/oasis-rpc-proxy/grpc/block/blockpb"
 	chainpb "github.com/figment-networks/oasis-rpc-proxy/grpc/chain/chainpb"
@@ -40,18 +41,18 @@ func (m *MockAccountClient) EXPECT() *MockAccountClientMockRecorder {
 }
 
 // GetByAddress mocks base method
-func (m *MockAccountClient) GetByAddress(arg0 string, arg1 int64) (*accountpb.GetByAddressResponse, error) {
+func (m *MockAccountClient) GetByAddress(arg0 context.Context, arg1 string, arg2 int64) (*accountpb.GetByAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAddress", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetByAddress", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*accountpb.GetByAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAddress indicates an expected call of GetByAddress
-func (mr *MockAccountClientMockRecorder) GetByAddress(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAccountClientMockRecorder) GetByAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAddress", reflect.TypeOf((*MockAccountClient)(nil).GetByAddress), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAddress", reflect.TypeOf((*MockAccountClient)(nil).GetByAddress), arg0, arg1, arg2)
 }
 
 // MockBlockClient is a mock of BlockClient interface
@@ -78,18 +79,18 @@ func (m *MockBlockClient) EXPECT() *MockBlockClientMockRecorder {
 }
 
 // GetByHeight mocks base method
-func (m *MockBlockClient) GetByHeight(arg0 int64) (*blockpb.GetByHeightResponse, error) {
+func (m *MockBlockClient) GetByHeight(arg0 context.Context, arg1 int64) (*blockpb.GetByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*blockpb.GetByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByHeight indicates an expected call of GetByHeight
-func (mr *MockBlockClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockBlockClientMockRecorder) GetByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockBlockClient)(nil).GetByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockBlockClient)(nil).GetByHeight), arg0, arg1)
 }
 
 // MockChainClient is a mock of ChainClient interface
@@ -116,63 +117,63 @@ func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
 }
 
 // GetConstants mocks base method
-func (m *MockChainClient) GetConstants() (*chainpb.GetConstantsResponse, error) {
+func (m *MockChainClient) GetConstants(arg0 context.Context) (*chainpb.GetConstantsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConstants")
+	ret := m.ctrl.Call(m, "GetConstants", arg0)
 	ret0, _ := ret[0].(*chainpb.GetConstantsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetConstants indicates an expected call of GetConstants
-func (mr *MockChainClientMockRecorder) GetConstants() *gomock.Call {
+func (mr *MockChainClientMockRecorder) GetConstants(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConstants", reflect.TypeOf((*MockChainClient)(nil).GetConstants))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConstants", reflect.TypeOf((*MockChainClient)(nil).GetConstants), arg0)
 }
 
 // GetHead mocks base method
-func (m *MockChainClient) GetHead() (*chainpb.GetHeadResponse, error) {
+func (m *MockChainClient) GetHead(arg0 context.Context) (*chainpb.GetHeadResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHead")
+	ret := m.ctrl.Call(m, "GetHead", arg0)
 	ret0, _ := ret[0].(*chainpb.GetHeadResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHead indicates an expected call of GetHead
-func (mr *MockChainClientMockRecorder) GetHead() *gomock.Call {
+func (mr *MockChainClientMockRecorder) GetHead(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHead", reflect.TypeOf((*MockChainClient)(nil).GetHead))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHead", reflect.TypeOf((*MockChainClient)(nil).GetHead), arg0)
 }
 
 // GetMetaByHeight mocks base method
-func (m *MockChainClient) GetMetaByHeight(arg0 int64) (*chainpb.GetMetaByHeightResponse, error) {
+func (m *MockChainClient) GetMetaByHeight(arg0 context.Context, arg1 int64) (*chainpb.GetMetaByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMetaByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetMetaByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*chainpb.GetMetaByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetMetaByHeight indicates an expected call of GetMetaByHeight
-func (mr *MockChainClientMockRecorder) GetMetaByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockChainClientMockRecorder) GetMetaByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetaByHeight", reflect.TypeOf((*MockChainClient)(nil).GetMetaByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetaByHeight", reflect.TypeOf((*MockChainClient)(nil).GetMetaByHeight), arg0, arg1)
 }
 
 // GetStatus mocks base method
-func (m *MockChainClient) GetStatus() (*chainpb.GetStatusResponse, error) {
+func (m *MockChainClient) GetStatus(arg0 context.Context) (*chainpb.GetStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStatus")
+	ret := m.ctrl.Call(m, "GetStatus", arg0)
 	ret0, _ := ret[0].(*chainpb.GetStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStatus indicates an expected call of GetStatus
-func (mr *MockChainClientMockRecorder) GetStatus() *gomock.Call {
+func (mr *MockChainClientMockRecorder) GetStatus(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockChainClient)(nil).GetStatus))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockChainClient)(nil).GetStatus), arg0)
 }
 
 // MockEventClient is a mock of EventClient interface
@@ -199,33 +200,33 @@ func (m *MockEventClient) EXPECT() *MockEventClientMockRecorder {
 }
 
 // GetEscrowEventsByHeight mocks base method
-func (m *MockEventClient) GetEscrowEventsByHeight(arg0 int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
+func (m *MockEventClient) GetEscrowEventsByHeight(arg0 context.Context, arg1 int64) (*eventpb.GetEscrowEventsByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEscrowEventsByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetEscrowEventsByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*eventpb.GetEscrowEventsByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEscrowEventsByHeight indicates an expected call of GetEscrowEventsByHeight
-func (mr *MockEventClientMockRecorder) GetEscrowEventsByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockEventClientMockRecorder) GetEscrowEventsByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowEventsByHeight", reflect.TypeOf((*MockEventClient)(nil).GetEscrowEventsByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowEventsByHeight", reflect.TypeOf((*MockEventClient)(nil).GetEscrowEventsByHeight), arg0, arg1)
 }
 
 // GetTransferEventsByHeight mocks base method
-func (m *MockEventClient) GetTransferEventsByHeight(arg0 int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
+func (m *MockEventClient) GetTransferEventsByHeight(arg0 context.Context, arg1 int64) (*eventpb.GetTransferEventsByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransferEventsByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetTransferEventsByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*eventpb.GetTransferEventsByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTransferEventsByHeight indicates an expected call of GetTransferEventsByHeight
-func (mr *MockEventClientMockRecorder) GetTransferEventsByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockEventClientMockRecorder) GetTransferEventsByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferEventsByHeight", reflect.TypeOf((*MockEventClient)(nil).GetTransferEventsByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferEventsByHeight", reflect.TypeOf((*MockEventClient)(nil).GetTransferEventsByHeight), arg0, arg1)
 }
 
 // MockStateClient is a mock of StateClient interface
@@ -252,33 +253,33 @@ func (m *MockStateClient) EXPECT() *MockStateClientMockRecorder {
 }
 
 // GetByHeight mocks base method
-func (m *MockStateClient) GetByHeight(arg0 int64) (*statepb.GetByHeightResponse, error) {
+func (m *MockStateClient) GetByHeight(arg0 context.Context, arg1 int64) (*statepb.GetByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*statepb.GetByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByHeight indicates an expected call of GetByHeight
-func (mr *MockStateClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockStateClientMockRecorder) GetByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockStateClient)(nil).GetByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockStateClient)(nil).GetByHeight), arg0, arg1)
 }
 
 // GetStakingByHeight mocks base method
-func (m *MockStateClient) GetStakingByHeight(arg0 int64) (*statepb.GetStakingByHeightResponse, error) {
+func (m *MockStateClient) GetStakingByHeight(arg0 context.Context, arg1 int64) (*statepb.GetStakingByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStakingByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetStakingByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*statepb.GetStakingByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStakingByHeight indicates an expected call of GetStakingByHeight
-func (mr *MockStateClientMockRecorder) GetStakingByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockStateClientMockRecorder) GetStakingByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingByHeight", reflect.TypeOf((*MockStateClient)(nil).GetStakingByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStakingByHeight", reflect.TypeOf((*MockStateClient)(nil).GetStakingByHeight), arg0, arg1)
 }
 
 // MockTransactionClient is a mock of TransactionClient interface
@@ -305,33 +306,33 @@ func (m *MockTransactionClient) EXPECT() *MockTransactionClientMockRecorder {
 }
 
 // Broadcast mocks base method
-func (m *MockTransactionClient) Broadcast(arg0 string) (*transactionpb.BroadcastResponse, error) {
+func (m *MockTransactionClient) Broadcast(arg0 context.Context, arg1 string) (*transactionpb.BroadcastResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Broadcast", arg0)
+	ret := m.ctrl.Call(m, "Broadcast", arg0, arg1)
 	ret0, _ := ret[0].(*transactionpb.BroadcastResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Broadcast indicates an expected call of Broadcast
-func (mr *MockTransactionClientMockRecorder) Broadcast(arg0 interface{}) *gomock.Call {
+func (mr *MockTransactionClientMockRecorder) Broadcast(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockTransactionClient)(nil).Broadcast), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Broadcast", reflect.TypeOf((*MockTransactionClient)(nil).Broadcast), arg0, arg1)
 }
 
 // GetByHeight mocks base method
-func (m *MockTransactionClient) GetByHeight(arg0 int64) (*transactionpb.GetByHeightResponse, error) {
+func (m *MockTransactionClient) GetByHeight(arg0 context.Context, arg1 int64) (*transactionpb.GetByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*transactionpb.GetByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByHeight indicates an expected call of GetByHeight
-func (mr *MockTransactionClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockTransactionClientMockRecorder) GetByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockTransactionClient)(nil).GetByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockTransactionClient)(nil).GetByHeight), arg0, arg1)
 }
 
 // MockValidatorClient is a mock of ValidatorClient interface
@@ -358,16 +359,16 @@ func (m *MockValidatorClient) EXPECT() *MockValidatorClientMockRecorder {
 }
 
 // GetByHeight mocks base method
-func (m *MockValidatorClient) GetByHeight(arg0 int64) (*validatorpb.GetByHeightResponse, error) {
+func (m *MockValidatorClient) GetByHeight(arg0 context.Context, arg1 int64) (*validatorpb.GetByHeightResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByHeight", arg0)
+	ret := m.ctrl.Call(m, "GetByHeight", arg0, arg1)
 	ret0, _ := ret[0].(*validatorpb.GetByHeightResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByHeight indicates an expected call of GetByHeight
-func (mr *MockValidatorClientMockRecorder) GetByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockValidatorClientMockRecorder) GetByHeight(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockValidatorClient)(nil).GetByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHeight", reflect.TypeOf((*MockValidatorClient)(nil).GetByHeight), arg0, arg1)
 }
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/oasishub-indexer/indexer (interfaces: AccountAggCreatorTaskStore,BackfillSourceStore,BalanceEventPersistorTaskStore,BlockSeqCreatorTaskStore,BlockSeqPersistorTaskStore,ConfigParser,DebondingDelegationSeqCreatorTaskStore,DelegationSeqCreatorTaskStore,SourceIndexStore,StakingSeqCreatorTaskStore,SyncerPersistorTaskStore,SyncerTaskStore,SystemEventCreatorStore,TransactionSeqCreatorTaskStore,ValidatorAggCreatorTaskStore,ValidatorAggPersistorTaskStore,ValidatorSeqCreatorTaskStore,ValidatorSeqPersistorTaskStore)
+// Source: github.com/figment-networks/oasishub-indexer/indexer (interfaces: AccountAggCreatorTaskStore,AccountAggPersistorTaskStore,BackfillSourceStore,BalanceEventPersistorTaskStore,BlockSeqCreatorTaskStore,BlockSeqPersistorTaskStore,ConfigParser,DebondingDelegationSeqCreatorTaskStore,DebondingDelegationSeqPersistorTaskStore,DelegationSeqCreatorTaskStore,DelegationSeqPersistorTaskStore,EscrowEventSeqCreatorTaskStore,EscrowEventSeqPersistorTaskStore,SourceIndexStore,StakingSeqCreatorTaskStore,StakingSeqPersistorTaskStore,SyncerPersistorTaskStore,SyncerTaskStore,SystemEventCreatorStore,TransactionSeqCreatorTaskStore,TransferEventSeqCreatorTaskStore,TransferEventSeqPersistorTaskStore,ValidatorAggCreatorTaskStore,ValidatorAggPersistorTaskStore,ValidatorSeqCreatorTaskStore,ValidatorSeqPersistorTaskStore)
 
 // Package mock_indexer is a generated GoMock package.
 package mock_indexer
@@ -34,20 +34,6 @@ func (m *MockAccountAggCreatorTaskStore) EXPECT() *MockAccountAggCreatorTaskStor
 	return m.recorder
 }
 
-// Create mocks base method
-func (m *MockAccountAggCreatorTaskStore) Create(arg0 interface{}) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Create indicates an expected call of Create
-func (mr *MockAccountAggCreatorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountAggCreatorTaskStore)(nil).Create), arg0)
-}
-
 // FindByPublicKey mocks base method
 func (m *MockAccountAggCreatorTaskStore) FindByPublicKey(arg0 string) (*model.AccountAgg, error) {
 	m.ctrl.T.Helper()
@@ -63,8 +49,45 @@ func (mr *MockAccountAggCreatorTaskStoreMockRecorder) FindByPublicKey(arg0 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPublicKey", reflect.TypeOf((*MockAccountAggCreatorTaskStore)(nil).FindByPublicKey), arg0)
 }
 
+// MockAccountAggPersistorTaskStore is a mock of AccountAggPersistorTaskStore interface
+type MockAccountAggPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountAggPersistorTaskStoreMockRecorder
+}
+
+// MockAccountAggPersistorTaskStoreMockRecorder is the mock recorder for MockAccountAggPersistorTaskStore
+type MockAccountAggPersistorTaskStoreMockRecorder struct {
+	mock *MockAccountAggPersistorTaskStore
+}
+
+// NewMockAccountAggPersistorTaskStore creates a new mock instance
+func NewMockAccountAggPersistorTaskStore(ctrl *gomock.Controller) *MockAccountAggPersistorTaskStore {
+	mock := &MockAccountAggPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockAccountAggPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAccountAggPersistorTaskStore) EXPECT() *MockAccountAggPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockAccountAggPersistorTaskStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockAccountAggPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountAggPersistorTaskStore)(nil).Create), arg0)
+}
+
 // Save mocks base method
-func (m *MockAccountAggCreatorTaskStore) Save(arg0 interface{}) error {
+func (m *MockAccountAggPersistorTaskStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Save", arg0)
 	ret0, _ := ret[0].(error)
@@ -72,9 +95,9 @@ func (m *MockAccountAggCreatorTaskStore) Save(arg0 interface{}) error {
 }
 
 // Save indicates an expected call of Save
-func (mr *MockAccountAggCreatorTaskStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+func (mr *MockAccountAggPersistorTaskStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountAggCreatorTaskStore)(nil).Save), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAccountAggPersistorTaskStore)(nil).Save), arg0)
 }
 
 // MockBackfillSourceStore is a mock of BackfillSourceStore interface
@@ -403,20 +426,6 @@ func (m *MockDebondingDelegationSeqCreatorTaskStore) EXPECT() *MockDebondingDele
 	return m.recorder
 }
 
-// Create mocks base method
-func (m *MockDebondingDelegationSeqCreatorTaskStore) Create(arg0 interface{}) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Create indicates an expected call of Create
-func (mr *MockDebondingDelegationSeqCreatorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDebondingDelegationSeqCreatorTaskStore)(nil).Create), arg0)
-}
-
 // FindByHeight mocks base method
 func (m *MockDebondingDelegationSeqCreatorTaskStore) FindByHeight(arg0 int64) ([]model.DebondingDelegationSeq, error) {
 	m.ctrl.T.Helper()
@@ -432,6 +441,43 @@ func (mr *MockDebondingDelegationSeqCreatorTaskStoreMockRecorder) FindByHeight(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDebondingDelegationSeqCreatorTaskStore)(nil).FindByHeight), arg0)
 }
 
+// MockDebondingDelegationSeqPersistorTaskStore is a mock of DebondingDelegationSeqPersistorTaskStore interface
+type MockDebondingDelegationSeqPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDebondingDelegationSeqPersistorTaskStoreMockRecorder
+}
+
+// MockDebondingDelegationSeqPersistorTaskStoreMockRecorder is the mock recorder for MockDebondingDelegationSeqPersistorTaskStore
+type MockDebondingDelegationSeqPersistorTaskStoreMockRecorder struct {
+	mock *MockDebondingDelegationSeqPersistorTaskStore
+}
+
+// NewMockDebondingDelegationSeqPersistorTaskStore creates a new mock instance
+func NewMockDebondingDelegationSeqPersistorTaskStore(ctrl *gomock.Controller) *MockDebondingDelegationSeqPersistorTaskStore {
+	mock := &MockDebondingDelegationSeqPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockDebondingDelegationSeqPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDebondingDelegationSeqPersistorTaskStore) EXPECT() *MockDebondingDelegationSeqPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockDebondingDelegationSeqPersistorTaskStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockDebondingDelegationSeqPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDebondingDelegationSeqPersistorTaskStore)(nil).Create), arg0)
+}
+
 // MockDelegationSeqCreatorTaskStore is a mock of DelegationSeqCreatorTaskStore interface
 type MockDelegationSeqCreatorTaskStore struct {
 	ctrl     *gomock.Controller
@@ -455,8 +501,46 @@ func (m *MockDelegationSeqCreatorTaskStore) EXPECT() *MockDelegationSeqCreatorTa
 	return m.recorder
 }
 
+// FindByHeight mocks base method
+func (m *MockDelegationSeqCreatorTaskStore) FindByHeight(arg0 int64) ([]model.DelegationSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.DelegationSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockDelegationSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDelegationSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+}
+
+// MockDelegationSeqPersistorTaskStore is a mock of DelegationSeqPersistorTaskStore interface
+type MockDelegationSeqPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDelegationSeqPersistorTaskStoreMockRecorder
+}
+
+// MockDelegationSeqPersistorTaskStoreMockRecorder is the mock recorder for MockDelegationSeqPersistorTaskStore
+type MockDelegationSeqPersistorTaskStoreMockRecorder struct {
+	mock *MockDelegationSeqPersistorTaskStore
+}
+
+// NewMockDelegationSeqPersistorTaskStore creates a new mock instance
+func NewMockDelegationSeqPersistorTaskStore(ctrl *gomock.Controller) *MockDelegationSeqPersistorTaskStore {
+	mock := &MockDelegationSeqPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockDelegationSeqPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDelegationSeqPersistorTaskStore) EXPECT() *MockDelegationSeqPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
 // Create mocks base method
-func (m *MockDelegationSeqCreatorTaskStore) Create(arg0 interface{}) error {
+func (m *MockDelegationSeqPersistorTaskStore) Create(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0)
 	ret0, _ := ret[0].(error)
@@ -464,24 +548,84 @@ func (m *MockDelegationSeqCreatorTaskStore) Create(arg0 interface{}) error {
 }
 
 // Create indicates an expected call of Create
-func (mr *MockDelegationSeqCreatorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockDelegationSeqPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDelegationSeqCreatorTaskStore)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDelegationSeqPersistorTaskStore)(nil).Create), arg0)
+}
+
+// MockEscrowEventSeqCreatorTaskStore is a mock of EscrowEventSeqCreatorTaskStore interface
+type MockEscrowEventSeqCreatorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockEscrowEventSeqCreatorTaskStoreMockRecorder
+}
+
+// MockEscrowEventSeqCreatorTaskStoreMockRecorder is the mock recorder for MockEscrowEventSeqCreatorTaskStore
+type MockEscrowEventSeqCreatorTaskStoreMockRecorder struct {
+	mock *MockEscrowEventSeqCreatorTaskStore
+}
+
+// NewMockEscrowEventSeqCreatorTaskStore creates a new mock instance
+func NewMockEscrowEventSeqCreatorTaskStore(ctrl *gomock.Controller) *MockEscrowEventSeqCreatorTaskStore {
+	mock := &MockEscrowEventSeqCreatorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockEscrowEventSeqCreatorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEscrowEventSeqCreatorTaskStore) EXPECT() *MockEscrowEventSeqCreatorTaskStoreMockRecorder {
+	return m.recorder
 }
 
 // FindByHeight mocks base method
-func (m *MockDelegationSeqCreatorTaskStore) FindByHeight(arg0 int64) ([]model.DelegationSeq, error) {
+func (m *MockEscrowEventSeqCreatorTaskStore) FindByHeight(arg0 int64) ([]model.EscrowEventSeq, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FindByHeight", arg0)
-	ret0, _ := ret[0].([]model.DelegationSeq)
+	ret0, _ := ret[0].([]model.EscrowEventSeq)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByHeight indicates an expected call of FindByHeight
-func (mr *MockDelegationSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+func (mr *MockEscrowEventSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDelegationSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockEscrowEventSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+}
+
+// MockEscrowEventSeqPersistorTaskStore is a mock of EscrowEventSeqPersistorTaskStore interface
+type MockEscrowEventSeqPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockEscrowEventSeqPersistorTaskStoreMockRecorder
+}
+
+// MockEscrowEventSeqPersistorTaskStoreMockRecorder is the mock recorder for MockEscrowEventSeqPersistorTaskStore
+type MockEscrowEventSeqPersistorTaskStoreMockRecorder struct {
+	mock *MockEscrowEventSeqPersistorTaskStore
+}
+
+// NewMockEscrowEventSeqPersistorTaskStore creates a new mock instance
+func NewMockEscrowEventSeqPersistorTaskStore(ctrl *gomock.Controller) *MockEscrowEventSeqPersistorTaskStore {
+	mock := &MockEscrowEventSeqPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockEscrowEventSeqPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEscrowEventSeqPersistorTaskStore) EXPECT() *MockEscrowEventSeqPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockEscrowEventSeqPersistorTaskStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockEscrowEventSeqPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEscrowEventSeqPersistorTaskStore)(nil).Create), arg0)
 }
 
 // MockSourceIndexStore is a mock of SourceIndexStore interface
@@ -545,8 +689,46 @@ func (m *MockStakingSeqCreatorTaskStore) EXPECT() *MockStakingSeqCreatorTaskStor
 	return m.recorder
 }
 
+// FindByHeight mocks base method
+func (m *MockStakingSeqCreatorTaskStore) FindByHeight(arg0 int64) (*model.StakingSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].(*model.StakingSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockStakingSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockStakingSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+}
+
+// MockStakingSeqPersistorTaskStore is a mock of StakingSeqPersistorTaskStore interface
+type MockStakingSeqPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStakingSeqPersistorTaskStoreMockRecorder
+}
+
+// MockStakingSeqPersistorTaskStoreMockRecorder is the mock recorder for MockStakingSeqPersistorTaskStore
+type MockStakingSeqPersistorTaskStoreMockRecorder struct {
+	mock *MockStakingSeqPersistorTaskStore
+}
+
+// NewMockStakingSeqPersistorTaskStore creates a new mock instance
+func NewMockStakingSeqPersistorTaskStore(ctrl *gomock.Controller) *MockStakingSeqPersistorTaskStore {
+	mock := &MockStakingSeqPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockStakingSeqPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStakingSeqPersistorTaskStore) EXPECT() *MockStakingSeqPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
 // Create mocks base method
-func (m *MockStakingSeqCreatorTaskStore) Create(arg0 interface{}) error {
+func (m *MockStakingSeqPersistorTaskStore) Create(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", arg0)
 	ret0, _ := ret[0].(error)
@@ -554,24 +736,23 @@ func (m *MockStakingSeqCreatorTaskStore) Create(arg0 interface{}) error {
 }
 
 // Create indicates an expected call of Create
-func (mr *MockStakingSeqCreatorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+func (mr *MockStakingSeqPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStakingSeqCreatorTaskStore)(nil).Create), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStakingSeqPersistorTaskStore)(nil).Create), arg0)
 }
 
-// FindByHeight mocks base method
-func (m *MockStakingSeqCreatorTaskStore) FindByHeight(arg0 int64) (*model.StakingSeq, error) {
+// Save mocks base method
+func (m *MockStakingSeqPersistorTaskStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByHeight", arg0)
-	ret0, _ := ret[0].(*model.StakingSeq)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// FindByHeight indicates an expected call of FindByHeight
-func (mr *MockStakingSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+// Save indicates an expected call of Save
+func (mr *MockStakingSeqPersistorTaskStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockStakingSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStakingSeqPersistorTaskStore)(nil).Save), arg0)
 }
 
 // MockSyncerPersistorTaskStore is a mock of SyncerPersistorTaskStore interface
@@ -754,6 +935,81 @@ func (mr *MockTransactionSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockTransactionSeqCreatorTaskStore)(nil).FindByHeight), arg0)
 }
 
+// MockTransferEventSeqCreatorTaskStore is a mock of TransferEventSeqCreatorTaskStore interface
+type MockTransferEventSeqCreatorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferEventSeqCreatorTaskStoreMockRecorder
+}
+
+// MockTransferEventSeqCreatorTaskStoreMockRecorder is the mock recorder for MockTransferEventSeqCreatorTaskStore
+type MockTransferEventSeqCreatorTaskStoreMockRecorder struct {
+	mock *MockTransferEventSeqCreatorTaskStore
+}
+
+// NewMockTransferEventSeqCreatorTaskStore creates a new mock instance
+func NewMockTransferEventSeqCreatorTaskStore(ctrl *gomock.Controller) *MockTransferEventSeqCreatorTaskStore {
+	mock := &MockTransferEventSeqCreatorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockTransferEventSeqCreatorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransferEventSeqCreatorTaskStore) EXPECT() *MockTransferEventSeqCreatorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// FindByHeight mocks base method
+func (m *MockTransferEventSeqCreatorTaskStore) FindByHeight(arg0 int64) ([]model.TransferEventSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeight", arg0)
+	ret0, _ := ret[0].([]model.TransferEventSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeight indicates an expected call of FindByHeight
+func (mr *MockTransferEventSeqCreatorTaskStoreMockRecorder) FindByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockTransferEventSeqCreatorTaskStore)(nil).FindByHeight), arg0)
+}
+
+// MockTransferEventSeqPersistorTaskStore is a mock of TransferEventSeqPersistorTaskStore interface
+type MockTransferEventSeqPersistorTaskStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferEventSeqPersistorTaskStoreMockRecorder
+}
+
+// MockTransferEventSeqPersistorTaskStoreMockRecorder is the mock recorder for MockTransferEventSeqPersistorTaskStore
+type MockTransferEventSeqPersistorTaskStoreMockRecorder struct {
+	mock *MockTransferEventSeqPersistorTaskStore
+}
+
+// NewMockTransferEventSeqPersistorTaskStore creates a new mock instance
+func NewMockTransferEventSeqPersistorTaskStore(ctrl *gomock.Controller) *MockTransferEventSeqPersistorTaskStore {
+	mock := &MockTransferEventSeqPersistorTaskStore{ctrl: ctrl}
+	mock.recorder = &MockTransferEventSeqPersistorTaskStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransferEventSeqPersistorTaskStore) EXPECT() *MockTransferEventSeqPersistorTaskStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockTransferEventSeqPersistorTaskStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockTransferEventSeqPersistorTaskStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferEventSeqPersistorTaskStore)(nil).Create), arg0)
+}
+
 // MockValidatorAggCreatorTaskStore is a mock of ValidatorAggCreatorTaskStore interface
 type MockValidatorAggCreatorTaskStore struct {
 	ctrl     *gomock.Controller
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/figment-networks/oasishub-indexer/store (interfaces: DatabaseStore,SyncablesStore,ReportsStore,SystemEventsStore,BlockSeqStore,DebondingDelegationSeqStore,DelegationSeqStore,StakingSeqStore,TransactionSeqStore,ValidatorSeqStore,BlockSummaryStore,ValidatorSummaryStore,AccountAggStore,ValidatorAggStore)
+// Source: github.com/figment-networks/oasishub-indexer/store (interfaces: DatabaseStore,SyncablesStore,ReportsStore,SystemEventsStore,BlockSeqStore,DebondingDelegationSeqStore,DelegationSeqStore,StakingSeqStore,TransactionSeqStore,ValidatorSeqStore,BlockSummaryStore,ValidatorSummaryStore,AccountAggStore,ValidatorAggStore,WebhookDeliveriesStore,WebhookSubscriptionsStore,VerifyStore,ReindexJobsStore)
 
 // Package mock_store is a generated GoMock package.
 package mock_store
@@ -162,6 +162,36 @@ func (mr *MockSyncablesStoreMockRecorder) FindMostRecentByDifferentIndexVersion(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentByDifferentIndexVersion", reflect.TypeOf((*MockSyncablesStore)(nil).FindMostRecentByDifferentIndexVersion), arg0)
 }
 
+// FindMostRecentProcessed mocks base method
+func (m *MockSyncablesStore) FindMostRecentProcessed() (*model.Syncable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMostRecentProcessed")
+	ret0, _ := ret[0].(*model.Syncable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMostRecentProcessed indicates an expected call of FindMostRecentProcessed
+func (mr *MockSyncablesStoreMockRecorder) FindMostRecentProcessed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecentProcessed", reflect.TypeOf((*MockSyncablesStore)(nil).FindMostRecentProcessed))
+}
+
+// FindProcessedSince mocks base method
+func (m *MockSyncablesStore) FindProcessedSince(arg0 types.Time, arg1 types.ID, arg2 int64) ([]model.Syncable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProcessedSince", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.Syncable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProcessedSince indicates an expected call of FindProcessedSince
+func (mr *MockSyncablesStoreMockRecorder) FindProcessedSince(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProcessedSince", reflect.TypeOf((*MockSyncablesStore)(nil).FindProcessedSince), arg0, arg1, arg2)
+}
+
 // FindSmallestIndexVersion mocks base method
 func (m *MockSyncablesStore) FindSmallestIndexVersion() (*int64, error) {
 	m.ctrl.T.Helper()
@@ -285,6 +315,21 @@ func (mr *MockReportsStoreMockRecorder) DeleteByKinds(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKinds", reflect.TypeOf((*MockReportsStore)(nil).DeleteByKinds), arg0)
 }
 
+// FindByID mocks base method
+func (m *MockReportsStore) FindByID(arg0 types.ID) (*model.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockReportsStoreMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockReportsStore)(nil).FindByID), arg0)
+}
+
 // FindNotCompletedByIndexVersion mocks base method
 func (m *MockReportsStore) FindNotCompletedByIndexVersion(arg0 int64, arg1 ...model.ReportKind) (*model.Report, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +369,20 @@ func (mr *MockReportsStoreMockRecorder) FindNotCompletedByKind(arg0 ...interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNotCompletedByKind", reflect.TypeOf((*MockReportsStore)(nil).FindNotCompletedByKind), arg0...)
 }
 
+// IncrementCounts mocks base method
+func (m *MockReportsStore) IncrementCounts(arg0 types.ID, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementCounts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementCounts indicates an expected call of IncrementCounts
+func (mr *MockReportsStoreMockRecorder) IncrementCounts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementCounts", reflect.TypeOf((*MockReportsStore)(nil).IncrementCounts), arg0, arg1, arg2)
+}
+
 // Last mocks base method
 func (m *MockReportsStore) Last() (*model.Report, error) {
 	m.ctrl.T.Helper()
@@ -433,15 +492,31 @@ func (mr *MockSystemEventsStoreMockRecorder) DeleteOlderThan(arg0 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockSystemEventsStore)(nil).DeleteOlderThan), arg0)
 }
 
-// FindByActor mocks base method
-func (m *MockSystemEventsStore) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+// FindAfterID mocks base method
+func (m *MockSystemEventsStore) FindAfterID(arg0 int64, arg1 store.FindSystemEventAfterIDQuery) ([]model.SystemEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1)
+	ret := m.ctrl.Call(m, "FindAfterID", arg0, arg1)
 	ret0, _ := ret[0].([]model.SystemEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// FindAfterID indicates an expected call of FindAfterID
+func (mr *MockSystemEventsStoreMockRecorder) FindAfterID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAfterID", reflect.TypeOf((*MockSystemEventsStore)(nil).FindAfterID), arg0, arg1)
+}
+
+// FindByActor mocks base method
+func (m *MockSystemEventsStore) FindByActor(arg0 string, arg1 store.FindSystemEventByActorQuery) ([]model.SystemEvent, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByActor", arg0, arg1)
+	ret0, _ := ret[0].([]model.SystemEvent)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // FindByActor indicates an expected call of FindByActor
 func (mr *MockSystemEventsStoreMockRecorder) FindByActor(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -713,6 +788,21 @@ func (mr *MockDebondingDelegationSeqStoreMockRecorder) Create(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDebondingDelegationSeqStore)(nil).Create), arg0)
 }
 
+// ExistsByHeight mocks base method
+func (m *MockDebondingDelegationSeqStore) ExistsByHeight(arg0 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByHeight", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByHeight indicates an expected call of ExistsByHeight
+func (mr *MockDebondingDelegationSeqStoreMockRecorder) ExistsByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByHeight", reflect.TypeOf((*MockDebondingDelegationSeqStore)(nil).ExistsByHeight), arg0)
+}
+
 // FindByHeight mocks base method
 func (m *MockDebondingDelegationSeqStore) FindByHeight(arg0 int64) ([]model.DebondingDelegationSeq, error) {
 	m.ctrl.T.Helper()
@@ -728,6 +818,21 @@ func (mr *MockDebondingDelegationSeqStoreMockRecorder) FindByHeight(arg0 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDebondingDelegationSeqStore)(nil).FindByHeight), arg0)
 }
 
+// FindByHeightAndValidatorUID mocks base method
+func (m *MockDebondingDelegationSeqStore) FindByHeightAndValidatorUID(arg0 int64, arg1 string) ([]model.DebondingDelegationSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeightAndValidatorUID", arg0, arg1)
+	ret0, _ := ret[0].([]model.DebondingDelegationSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeightAndValidatorUID indicates an expected call of FindByHeightAndValidatorUID
+func (mr *MockDebondingDelegationSeqStoreMockRecorder) FindByHeightAndValidatorUID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeightAndValidatorUID", reflect.TypeOf((*MockDebondingDelegationSeqStore)(nil).FindByHeightAndValidatorUID), arg0, arg1)
+}
+
 // FindRecentByDelegatorUID mocks base method
 func (m *MockDebondingDelegationSeqStore) FindRecentByDelegatorUID(arg0 string, arg1 int64) ([]model.DebondingDelegationSeq, error) {
 	m.ctrl.T.Helper()
@@ -823,6 +928,21 @@ func (mr *MockDelegationSeqStoreMockRecorder) Create(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDelegationSeqStore)(nil).Create), arg0)
 }
 
+// ExistsByHeight mocks base method
+func (m *MockDelegationSeqStore) ExistsByHeight(arg0 int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistsByHeight", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistsByHeight indicates an expected call of ExistsByHeight
+func (mr *MockDelegationSeqStoreMockRecorder) ExistsByHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByHeight", reflect.TypeOf((*MockDelegationSeqStore)(nil).ExistsByHeight), arg0)
+}
+
 // FindByHeight mocks base method
 func (m *MockDelegationSeqStore) FindByHeight(arg0 int64) ([]model.DelegationSeq, error) {
 	m.ctrl.T.Helper()
@@ -838,6 +958,21 @@ func (mr *MockDelegationSeqStoreMockRecorder) FindByHeight(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockDelegationSeqStore)(nil).FindByHeight), arg0)
 }
 
+// FindByHeightAndValidatorUID mocks base method
+func (m *MockDelegationSeqStore) FindByHeightAndValidatorUID(arg0 int64, arg1 string) ([]model.DelegationSeq, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHeightAndValidatorUID", arg0, arg1)
+	ret0, _ := ret[0].([]model.DelegationSeq)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHeightAndValidatorUID indicates an expected call of FindByHeightAndValidatorUID
+func (mr *MockDelegationSeqStoreMockRecorder) FindByHeightAndValidatorUID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeightAndValidatorUID", reflect.TypeOf((*MockDelegationSeqStore)(nil).FindByHeightAndValidatorUID), arg0, arg1)
+}
+
 // FindCurrentByDelegatorUID mocks base method
 func (m *MockDelegationSeqStore) FindCurrentByDelegatorUID(arg0 string) ([]model.DelegationSeq, error) {
 	m.ctrl.T.Helper()
@@ -992,6 +1127,21 @@ func (mr *MockStakingSeqStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStakingSeqStore)(nil).Save), arg0)
 }
 
+// Summarize mocks base method
+func (m *MockStakingSeqStore) Summarize(arg0 types.SummaryInterval, arg1 []store.ActivityPeriodRow) ([]store.StakingSeqSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summarize", arg0, arg1)
+	ret0, _ := ret[0].([]store.StakingSeqSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summarize indicates an expected call of Summarize
+func (mr *MockStakingSeqStoreMockRecorder) Summarize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summarize", reflect.TypeOf((*MockStakingSeqStore)(nil).Summarize), arg0, arg1)
+}
+
 // Update mocks base method
 func (m *MockStakingSeqStore) Update(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1058,6 +1208,22 @@ func (mr *MockTransactionSeqStoreMockRecorder) FindByHeight(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHeight", reflect.TypeOf((*MockTransactionSeqStore)(nil).FindByHeight), arg0)
 }
 
+// FindByPublicKey mocks base method
+func (m *MockTransactionSeqStore) FindByPublicKey(arg0 string, arg1 store.FindTransactionsByPublicKeyQuery) ([]model.TransactionSeq, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPublicKey", arg0, arg1)
+	ret0, _ := ret[0].([]model.TransactionSeq)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByPublicKey indicates an expected call of FindByPublicKey
+func (mr *MockTransactionSeqStoreMockRecorder) FindByPublicKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPublicKey", reflect.TypeOf((*MockTransactionSeqStore)(nil).FindByPublicKey), arg0, arg1)
+}
+
 // Save mocks base method
 func (m *MockTransactionSeqStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1183,6 +1349,21 @@ func (mr *MockValidatorSeqStoreMockRecorder) FindLastByAddress(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastByAddress", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindLastByAddress), arg0, arg1)
 }
 
+// FindLastHeightsByInterval mocks base method
+func (m *MockValidatorSeqStore) FindLastHeightsByInterval(arg0 types.SummaryInterval, arg1 *types.Time) ([]store.TimeBucketHeightRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastHeightsByInterval", arg0, arg1)
+	ret0, _ := ret[0].([]store.TimeBucketHeightRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastHeightsByInterval indicates an expected call of FindLastHeightsByInterval
+func (mr *MockValidatorSeqStoreMockRecorder) FindLastHeightsByInterval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastHeightsByInterval", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindLastHeightsByInterval), arg0, arg1)
+}
+
 // FindMostRecent mocks base method
 func (m *MockValidatorSeqStore) FindMostRecent() (*model.ValidatorSeq, error) {
 	m.ctrl.T.Helper()
@@ -1198,6 +1379,36 @@ func (mr *MockValidatorSeqStoreMockRecorder) FindMostRecent() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMostRecent", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindMostRecent))
 }
 
+// FindPrecommitsByAddress mocks base method
+func (m *MockValidatorSeqStore) FindPrecommitsByAddress(arg0 string, arg1, arg2 int64) ([]store.PrecommitRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrecommitsByAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]store.PrecommitRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrecommitsByAddress indicates an expected call of FindPrecommitsByAddress
+func (mr *MockValidatorSeqStoreMockRecorder) FindPrecommitsByAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrecommitsByAddress", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindPrecommitsByAddress), arg0, arg1, arg2)
+}
+
+// FindUptimeByAddress mocks base method
+func (m *MockValidatorSeqStore) FindUptimeByAddress(arg0 string, arg1, arg2 int64) (*store.UptimeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUptimeByAddress", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*store.UptimeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUptimeByAddress indicates an expected call of FindUptimeByAddress
+func (mr *MockValidatorSeqStoreMockRecorder) FindUptimeByAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUptimeByAddress", reflect.TypeOf((*MockValidatorSeqStore)(nil).FindUptimeByAddress), arg0, arg1, arg2)
+}
+
 // Save mocks base method
 func (m *MockValidatorSeqStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1594,6 +1805,21 @@ func (m *MockAccountAggStore) EXPECT() *MockAccountAggStoreMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method
+func (m *MockAccountAggStore) Count() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count
+func (mr *MockAccountAggStoreMockRecorder) Count() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockAccountAggStore)(nil).Count))
+}
+
 // Create mocks base method
 func (m *MockAccountAggStore) Create(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1608,6 +1834,36 @@ func (mr *MockAccountAggStoreMockRecorder) Create(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAccountAggStore)(nil).Create), arg0)
 }
 
+// FindAllByRecentAtHeight mocks base method
+func (m *MockAccountAggStore) FindAllByRecentAtHeight(arg0 int64) ([]model.AccountAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByRecentAtHeight", arg0)
+	ret0, _ := ret[0].([]model.AccountAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByRecentAtHeight indicates an expected call of FindAllByRecentAtHeight
+func (mr *MockAccountAggStoreMockRecorder) FindAllByRecentAtHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByRecentAtHeight", reflect.TypeOf((*MockAccountAggStore)(nil).FindAllByRecentAtHeight), arg0)
+}
+
+// FindAllRecentAfterHeight mocks base method
+func (m *MockAccountAggStore) FindAllRecentAfterHeight(arg0 int64) ([]model.AccountAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllRecentAfterHeight", arg0)
+	ret0, _ := ret[0].([]model.AccountAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllRecentAfterHeight indicates an expected call of FindAllRecentAfterHeight
+func (mr *MockAccountAggStoreMockRecorder) FindAllRecentAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllRecentAfterHeight", reflect.TypeOf((*MockAccountAggStore)(nil).FindAllRecentAfterHeight), arg0)
+}
+
 // FindBy mocks base method
 func (m *MockAccountAggStore) FindBy(arg0 string, arg1 interface{}) (*model.AccountAgg, error) {
 	m.ctrl.T.Helper()
@@ -1638,6 +1894,21 @@ func (mr *MockAccountAggStoreMockRecorder) FindByPublicKey(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPublicKey", reflect.TypeOf((*MockAccountAggStore)(nil).FindByPublicKey), arg0)
 }
 
+// FindTop mocks base method
+func (m *MockAccountAggStore) FindTop(arg0 store.FindTopAccountsQuery) ([]model.AccountAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTop", arg0)
+	ret0, _ := ret[0].([]model.AccountAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTop indicates an expected call of FindTop
+func (mr *MockAccountAggStoreMockRecorder) FindTop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTop", reflect.TypeOf((*MockAccountAggStore)(nil).FindTop), arg0)
+}
+
 // Save mocks base method
 func (m *MockAccountAggStore) Save(arg0 interface{}) error {
 	m.ctrl.T.Helper()
@@ -1717,6 +1988,21 @@ func (mr *MockValidatorAggStoreMockRecorder) CreateOrUpdate(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockValidatorAggStore)(nil).CreateOrUpdate), arg0)
 }
 
+// FindAllRecentAfterHeight mocks base method
+func (m *MockValidatorAggStore) FindAllRecentAfterHeight(arg0 int64) ([]model.ValidatorAgg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllRecentAfterHeight", arg0)
+	ret0, _ := ret[0].([]model.ValidatorAgg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllRecentAfterHeight indicates an expected call of FindAllRecentAfterHeight
+func (mr *MockValidatorAggStoreMockRecorder) FindAllRecentAfterHeight(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllRecentAfterHeight", reflect.TypeOf((*MockValidatorAggStore)(nil).FindAllRecentAfterHeight), arg0)
+}
+
 // FindBy mocks base method
 func (m *MockValidatorAggStore) FindBy(arg0 string, arg1 interface{}) (*model.ValidatorAgg, error) {
 	m.ctrl.T.Helper()
@@ -1804,3 +2090,440 @@ func (mr *MockValidatorAggStoreMockRecorder) Update(arg0 interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockValidatorAggStore)(nil).Update), arg0)
 }
+
+// MockWebhookDeliveriesStore is a mock of WebhookDeliveriesStore interface
+type MockWebhookDeliveriesStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveriesStoreMockRecorder
+}
+
+// MockWebhookDeliveriesStoreMockRecorder is the mock recorder for MockWebhookDeliveriesStore
+type MockWebhookDeliveriesStoreMockRecorder struct {
+	mock *MockWebhookDeliveriesStore
+}
+
+// NewMockWebhookDeliveriesStore creates a new mock instance
+func NewMockWebhookDeliveriesStore(ctrl *gomock.Controller) *MockWebhookDeliveriesStore {
+	mock := &MockWebhookDeliveriesStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveriesStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveriesStore) EXPECT() *MockWebhookDeliveriesStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookDeliveriesStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Create), arg0)
+}
+
+// Enqueue mocks base method
+func (m *MockWebhookDeliveriesStore) Enqueue(arg0 types.ID, arg1 int64, arg2 []model.WebhookDelivery, arg3 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Enqueue(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Enqueue), arg0, arg1, arg2, arg3)
+}
+
+// FindDue mocks base method
+func (m *MockWebhookDeliveriesStore) FindDue(arg0 time.Time, arg1 int64) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", arg0, arg1)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue
+func (mr *MockWebhookDeliveriesStoreMockRecorder) FindDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).FindDue), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockWebhookDeliveriesStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockWebhookDeliveriesStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookDeliveriesStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).Update), arg0)
+}
+
+// UpdateAttempt mocks base method
+func (m *MockWebhookDeliveriesStore) UpdateAttempt(arg0 *model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttempt", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttempt indicates an expected call of UpdateAttempt
+func (mr *MockWebhookDeliveriesStoreMockRecorder) UpdateAttempt(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttempt", reflect.TypeOf((*MockWebhookDeliveriesStore)(nil).UpdateAttempt), arg0)
+}
+
+// MockWebhookSubscriptionsStore is a mock of WebhookSubscriptionsStore interface
+type MockWebhookSubscriptionsStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionsStoreMockRecorder
+}
+
+// MockWebhookSubscriptionsStoreMockRecorder is the mock recorder for MockWebhookSubscriptionsStore
+type MockWebhookSubscriptionsStoreMockRecorder struct {
+	mock *MockWebhookSubscriptionsStore
+}
+
+// NewMockWebhookSubscriptionsStore creates a new mock instance
+func NewMockWebhookSubscriptionsStore(ctrl *gomock.Controller) *MockWebhookSubscriptionsStore {
+	mock := &MockWebhookSubscriptionsStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionsStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSubscriptionsStore) EXPECT() *MockWebhookSubscriptionsStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockWebhookSubscriptionsStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Create), arg0)
+}
+
+// DeleteByID mocks base method
+func (m *MockWebhookSubscriptionsStore) DeleteByID(arg0 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) DeleteByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).DeleteByID), arg0)
+}
+
+// FindAll mocks base method
+func (m *MockWebhookSubscriptionsStore) FindAll() ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll")
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAll indicates an expected call of FindAll
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) FindAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).FindAll))
+}
+
+// FindByID mocks base method
+func (m *MockWebhookSubscriptionsStore) FindByID(arg0 int64) (*model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", arg0)
+	ret0, _ := ret[0].(*model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) FindByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).FindByID), arg0)
+}
+
+// Save mocks base method
+func (m *MockWebhookSubscriptionsStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockWebhookSubscriptionsStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).Update), arg0)
+}
+
+// UpdateSettings mocks base method
+func (m *MockWebhookSubscriptionsStore) UpdateSettings(arg0 *model.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings
+func (mr *MockWebhookSubscriptionsStoreMockRecorder) UpdateSettings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockWebhookSubscriptionsStore)(nil).UpdateSettings), arg0)
+}
+
+// MockVerifyStore is a mock of VerifyStore interface
+type MockVerifyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyStoreMockRecorder
+}
+
+// MockVerifyStoreMockRecorder is the mock recorder for MockVerifyStore
+type MockVerifyStoreMockRecorder struct {
+	mock *MockVerifyStore
+}
+
+// NewMockVerifyStore creates a new mock instance
+func NewMockVerifyStore(ctrl *gomock.Controller) *MockVerifyStore {
+	mock := &MockVerifyStore{ctrl: ctrl}
+	mock.recorder = &MockVerifyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockVerifyStore) EXPECT() *MockVerifyStoreMockRecorder {
+	return m.recorder
+}
+
+// FindFirstHeightSince mocks base method
+func (m *MockVerifyStore) FindFirstHeightSince(arg0 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFirstHeightSince", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFirstHeightSince indicates an expected call of FindFirstHeightSince
+func (mr *MockVerifyStoreMockRecorder) FindFirstHeightSince(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFirstHeightSince", reflect.TypeOf((*MockVerifyStore)(nil).FindFirstHeightSince), arg0)
+}
+
+// FindLastProcessedHeight mocks base method
+func (m *MockVerifyStore) FindLastProcessedHeight() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastProcessedHeight")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastProcessedHeight indicates an expected call of FindLastProcessedHeight
+func (mr *MockVerifyStoreMockRecorder) FindLastProcessedHeight() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastProcessedHeight", reflect.TypeOf((*MockVerifyStore)(nil).FindLastProcessedHeight))
+}
+
+// Verify mocks base method
+func (m *MockVerifyStore) Verify(arg0, arg1, arg2 int64, arg3 time.Time) ([]store.VerifyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]store.VerifyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify
+func (mr *MockVerifyStoreMockRecorder) Verify(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockVerifyStore)(nil).Verify), arg0, arg1, arg2, arg3)
+}
+
+// MockReindexJobsStore is a mock of ReindexJobsStore interface
+type MockReindexJobsStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockReindexJobsStoreMockRecorder
+}
+
+// MockReindexJobsStoreMockRecorder is the mock recorder for MockReindexJobsStore
+type MockReindexJobsStoreMockRecorder struct {
+	mock *MockReindexJobsStore
+}
+
+// NewMockReindexJobsStore creates a new mock instance
+func NewMockReindexJobsStore(ctrl *gomock.Controller) *MockReindexJobsStore {
+	mock := &MockReindexJobsStore{ctrl: ctrl}
+	mock.recorder = &MockReindexJobsStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReindexJobsStore) EXPECT() *MockReindexJobsStoreMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method
+func (m *MockReindexJobsStore) Claim(arg0 string, arg1 time.Time, arg2 int64) (*model.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim
+func (mr *MockReindexJobsStoreMockRecorder) Claim(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockReindexJobsStore)(nil).Claim), arg0, arg1, arg2)
+}
+
+// CountByStatus mocks base method
+func (m *MockReindexJobsStore) CountByStatus(arg0 types.ID) (map[model.ReindexJobStatus]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus", arg0)
+	ret0, _ := ret[0].(map[model.ReindexJobStatus]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus
+func (mr *MockReindexJobsStoreMockRecorder) CountByStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockReindexJobsStore)(nil).CountByStatus), arg0)
+}
+
+// Create mocks base method
+func (m *MockReindexJobsStore) Create(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockReindexJobsStoreMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReindexJobsStore)(nil).Create), arg0)
+}
+
+// FailStale mocks base method
+func (m *MockReindexJobsStore) FailStale(arg0 time.Time, arg1 int64) ([]model.ReindexJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailStale", arg0, arg1)
+	ret0, _ := ret[0].([]model.ReindexJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailStale indicates an expected call of FailStale
+func (mr *MockReindexJobsStoreMockRecorder) FailStale(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailStale", reflect.TypeOf((*MockReindexJobsStore)(nil).FailStale), arg0, arg1)
+}
+
+// Finish mocks base method
+func (m *MockReindexJobsStore) Finish(arg0 *model.ReindexJob, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish
+func (mr *MockReindexJobsStoreMockRecorder) Finish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockReindexJobsStore)(nil).Finish), arg0, arg1)
+}
+
+// Heartbeat mocks base method
+func (m *MockReindexJobsStore) Heartbeat(arg0 types.ID, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Heartbeat indicates an expected call of Heartbeat
+func (mr *MockReindexJobsStoreMockRecorder) Heartbeat(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockReindexJobsStore)(nil).Heartbeat), arg0, arg1)
+}
+
+// Save mocks base method
+func (m *MockReindexJobsStore) Save(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockReindexJobsStoreMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockReindexJobsStore)(nil).Save), arg0)
+}
+
+// Update mocks base method
+func (m *MockReindexJobsStore) Update(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockReindexJobsStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockReindexJobsStore)(nil).Update), arg0)
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	EscrowEventKindAdd     EscrowEventKind = "add"
+	EscrowEventKindTake    EscrowEventKind = "take"
+	EscrowEventKindReclaim EscrowEventKind = "reclaim"
+)
+
+type EscrowEventKind string
+
+func (k EscrowEventKind) String() string {
+	return string(k)
+}
+
+type EscrowEventSeq struct {
+	*Model
+	*Sequence
+
+	Kind   EscrowEventKind `json:"kind"`
+	Owner  string          `json:"owner"`
+	Escrow string          `json:"escrow"`
+	Amount types.Quantity  `json:"amount"`
+	Shares types.Quantity  `json:"shares"`
+}
+
+func (EscrowEventSeq) TableName() string {
+	return "escrow_events"
+}
+
+func (e *EscrowEventSeq) Valid() bool {
+	return e.Sequence.Valid() &&
+		e.Kind != "" &&
+		e.Owner != "" &&
+		e.Amount.Valid()
+}
+
+func (e *EscrowEventSeq) Equal(m EscrowEventSeq) bool {
+	return e.Sequence.Equal(*m.Sequence) &&
+		e.Kind == m.Kind &&
+		e.Owner == m.Owner &&
+		e.Escrow == m.Escrow &&
+		e.Amount.Equals(m.Amount)
+}
@@ -0,0 +1,31 @@
+package model
+
+import "github.com/figment-networks/oasishub-indexer/types"
+
+// NetworkSummary contains network-wide staking metrics computed from validators at the last height of time bucket
+type NetworkSummary struct {
+	*Model
+	*Summary
+
+	Height              int64          `json:"height"`
+	TotalStaked         types.Quantity `json:"total_staked"`
+	StakingRatio        float64        `json:"staking_ratio"`
+	ActiveValidators    int64          `json:"active_validators"`
+	NakamotoCoefficient int64          `json:"nakamoto_coefficient"`
+	GiniCoefficient     float64        `json:"gini_coefficient"`
+	CommissionAvg       float64        `json:"commission_avg"`
+}
+
+func (NetworkSummary) TableName() string {
+	return "network_summary"
+}
+
+func (s *NetworkSummary) Update(m NetworkSummary) {
+	s.Height = m.Height
+	s.TotalStaked = m.TotalStaked
+	s.StakingRatio = m.StakingRatio
+	s.ActiveValidators = m.ActiveValidators
+	s.NakamotoCoefficient = m.NakamotoCoefficient
+	s.GiniCoefficient = m.GiniCoefficient
+	s.CommissionAvg = m.CommissionAvg
+}
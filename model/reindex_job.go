@@ -0,0 +1,81 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	ReindexJobStatusPending   ReindexJobStatus = "pending"
+	ReindexJobStatusRunning   ReindexJobStatus = "running"
+	ReindexJobStatusCompleted ReindexJobStatus = "completed"
+	ReindexJobStatusFailed    ReindexJobStatus = "failed"
+)
+
+type ReindexJobStatus string
+
+func (s ReindexJobStatus) String() string {
+	return string(s)
+}
+
+// ReindexJob is a chunk of distributed reindex claimed and processed by a single worker
+type ReindexJob struct {
+	*Model
+
+	ReportID    types.ID         `json:"report_id"`
+	StartHeight int64            `json:"start_height"`
+	EndHeight   int64            `json:"end_height"`
+	TargetIds   types.Jsonb      `json:"target_ids"`
+	Status      ReindexJobStatus `json:"status"`
+	WorkerID    *string          `json:"worker_id"`
+	Attempts    int64            `json:"attempts"`
+	HeartbeatAt *types.Time      `json:"heartbeat_at"`
+	LastError   *string          `json:"last_error"`
+	CompletedAt *types.Time      `json:"completed_at"`
+}
+
+func (ReindexJob) TableName() string {
+	return "reindex_jobs"
+}
+
+func (j *ReindexJob) Valid() bool {
+	return j.ReportID.Valid() &&
+		j.StartHeight >= 0 &&
+		j.EndHeight >= j.StartHeight &&
+		j.Status != ""
+}
+
+// Len returns number of heights in job
+func (j *ReindexJob) Len() int64 {
+	return j.EndHeight - j.StartHeight + 1
+}
+
+// Completed marks job as successfully processed
+func (j *ReindexJob) Completed() {
+	j.Status = ReindexJobStatusCompleted
+	j.CompletedAt = types.NewTimeFromTime(time.Now())
+	j.LastError = nil
+}
+
+// Failed records failed attempt and releases job so it can be claimed again.
+// Job is moved to failed state once maxAttempts is reached.
+func (j *ReindexJob) Failed(err error, maxAttempts int64) {
+	errMsg := err.Error()
+
+	j.LastError = &errMsg
+	j.WorkerID = nil
+
+	if j.Attempts >= maxAttempts {
+		j.Status = ReindexJobStatusFailed
+		j.CompletedAt = types.NewTimeFromTime(time.Now())
+		return
+	}
+
+	j.Status = ReindexJobStatusPending
+}
+
+// Finished returns true when job will not be processed again
+func (j *ReindexJob) Finished() bool {
+	return j.Status == ReindexJobStatusCompleted || j.Status == ReindexJobStatusFailed
+}
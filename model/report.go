@@ -9,6 +9,7 @@ const (
 	ReportKindIndex ReportKind = iota + 1
 	ReportKindParallelReindex
 	ReportKindSequentialReindex
+	ReportKindDistributedReindex
 )
 
 type Report struct {
@@ -35,6 +36,8 @@ func (k ReportKind) String() string {
 		return "parallel_reindex"
 	case ReportKindSequentialReindex:
 		return "sequential_reindex"
+	case ReportKindDistributedReindex:
+		return "distributed_reindex"
 	default:
 		return "unknown"
 	}
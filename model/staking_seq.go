@@ -29,3 +29,10 @@ func (ss *StakingSeq) Equal(m StakingSeq) bool {
 		ss.CommonPool.Equals(m.CommonPool) &&
 		ss.TotalSupply.Equals(m.TotalSupply)
 }
+
+func (ss *StakingSeq) Update(m StakingSeq) {
+	ss.TotalSupply = m.TotalSupply
+	ss.CommonPool = m.CommonPool
+	ss.DebondingInterval = m.DebondingInterval
+	ss.MinDelegationAmount = m.MinDelegationAmount
+}
@@ -0,0 +1,21 @@
+package model
+
+import "github.com/figment-networks/oasishub-indexer/types"
+
+type StakingSummary struct {
+	*Model
+	*Summary
+
+	TotalSupplyAvg         types.Quantity `json:"total_supply_avg"`
+	TotalSupplyMax         types.Quantity `json:"total_supply_max"`
+	TotalSupplyMin         types.Quantity `json:"total_supply_min"`
+	CommonPoolAvg          types.Quantity `json:"common_pool_avg"`
+	CommonPoolMax          types.Quantity `json:"common_pool_max"`
+	CommonPoolMin          types.Quantity `json:"common_pool_min"`
+	DebondingIntervalMax   uint64         `json:"debonding_interval_max"`
+	MinDelegationAmountMax types.Quantity `json:"min_delegation_amount_max"`
+}
+
+func (StakingSummary) TableName() string {
+	return "staking_summary"
+}
@@ -13,6 +13,7 @@ const (
 	SystemEventLeftActiveSet              SystemEventKind = "left_active_set"
 	SystemEventMissedNConsecutive         SystemEventKind = "missed_n_consecutive"
 	SystemEventMissedNofM                 SystemEventKind = "missed_n_of_m"
+	SystemEventValidatorSlashed           SystemEventKind = "validator_slashed"
 )
 
 type SystemEventKind string
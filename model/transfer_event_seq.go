@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type TransferEventSeq struct {
+	*Model
+	*Sequence
+
+	FromAddress string         `json:"from"`
+	ToAddress   string         `json:"to"`
+	Amount      types.Quantity `json:"amount"`
+}
+
+func (TransferEventSeq) TableName() string {
+	return "transfer_events"
+}
+
+func (t *TransferEventSeq) Valid() bool {
+	return t.Sequence.Valid() &&
+		t.FromAddress != "" &&
+		t.ToAddress != "" &&
+		t.Amount.Valid()
+}
+
+func (t *TransferEventSeq) Equal(m TransferEventSeq) bool {
+	return t.Sequence.Equal(*m.Sequence) &&
+		t.FromAddress == m.FromAddress &&
+		t.ToAddress == m.ToAddress &&
+		t.Amount.Equals(m.Amount)
+}
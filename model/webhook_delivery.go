@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+)
+
+type WebhookDeliveryStatus string
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+type WebhookDelivery struct {
+	*Model
+
+	SubscriptionID types.ID              `json:"subscription_id"`
+	SystemEventID  types.ID              `json:"system_event_id"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int64                 `json:"attempts"`
+	NextAttemptAt  types.Time            `json:"next_attempt_at"`
+	LastError      *string               `json:"last_error"`
+	DeliveredAt    *types.Time           `json:"delivered_at"`
+
+	Subscription *WebhookSubscription `json:"-" gorm:"foreignkey:SubscriptionID"`
+	SystemEvent  *SystemEvent         `json:"-" gorm:"foreignkey:SystemEventID"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
+
+func (d *WebhookDelivery) Valid() bool {
+	return d.SubscriptionID.Valid() &&
+		d.SystemEventID.Valid() &&
+		d.Status != ""
+}
+
+// Delivered marks delivery as successfully delivered
+func (d *WebhookDelivery) Delivered() {
+	d.Attempts++
+	d.Status = WebhookDeliveryStatusDelivered
+	d.DeliveredAt = types.NewTimeFromTime(time.Now())
+	d.LastError = nil
+}
+
+// Failed records failed delivery attempt and schedules next one using exponential backoff.
+// Delivery is moved to dead state once maxAttempts is reached.
+func (d *WebhookDelivery) Failed(err error, backoff time.Duration, maxAttempts int64) {
+	errMsg := err.Error()
+
+	d.Attempts++
+	d.LastError = &errMsg
+
+	if d.Attempts >= maxAttempts {
+		d.Status = WebhookDeliveryStatusDead
+		return
+	}
+
+	delay := backoff * time.Duration(1<<uint(d.Attempts-1))
+	d.NextAttemptAt = *types.NewTimeFromTime(time.Now().Add(delay))
+}
@@ -0,0 +1,20 @@
+package model
+
+type WebhookSubscription struct {
+	*Model
+
+	URL               string           `json:"url"`
+	Actor             *string          `json:"actor"`
+	Kind              *SystemEventKind `json:"kind"`
+	Secret            string           `json:"-"`
+	LastSystemEventID int64            `json:"-"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+func (s *WebhookSubscription) Valid() bool {
+	return s.URL != "" &&
+		s.Secret != ""
+}
@@ -1,11 +1,21 @@
 package server
 
 import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+
 	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 	"github.com/figment-networks/oasishub-indexer/utils/reporting"
 	"github.com/gin-gonic/gin"
 )
 
+var (
+	errAdminDisabled     = errors.New("admin api is disabled, set ADMIN_TOKEN to enable it")
+	errInvalidAdminToken = errors.New("invalid admin token")
+)
+
 var serverRequestDuration = metrics.MustNewHistogramWithTags(metrics.HistogramOptions{
 	Namespace: "indexer",
 	Subsystem: "oasis_http",
@@ -35,3 +45,21 @@ func ErrorReportingMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// AdminAuthMiddleware allows only requests with "Authorization: Bearer <token>" header matching admin token.
+// All requests are rejected when admin token is not configured.
+func AdminAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token == "" {
+			http.Forbidden(c, errAdminDisabled)
+			return
+		}
+
+		given := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			http.Unauthorized(c, errInvalidAdminToken)
+			return
+		}
+		c.Next()
+	}
+}
@@ -1,29 +1,373 @@
 package server
 
+import (
+	nethttp "net/http"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/account"
+	"github.com/figment-networks/oasishub-indexer/usecase/apr"
+	"github.com/figment-networks/oasishub-indexer/usecase/balance"
+	"github.com/figment-networks/oasishub-indexer/usecase/block"
+	"github.com/figment-networks/oasishub-indexer/usecase/chain"
+	"github.com/figment-networks/oasishub-indexer/usecase/debondingdelegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/delegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/escrowevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/graph"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/usecase/network"
+	"github.com/figment-networks/oasishub-indexer/usecase/staking"
+	"github.com/figment-networks/oasishub-indexer/usecase/stream"
+	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/transaction"
+	"github.com/figment-networks/oasishub-indexer/usecase/transfer"
+	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+)
+
 // setupRoutes sets up routes for gin application
 func (s *Server) setupRoutes() {
-	// Queries
-	s.engine.GET("/health", s.handlers.Health.Handle)
-	s.engine.GET("/status", s.handlers.GetStatus.Handle)
-	s.engine.GET("/block", s.handlers.GetBlockByHeight.Handle)
-	s.engine.GET("/block_times/:limit", s.handlers.GetBlockTimes.Handle)
-	s.engine.GET("/blocks_summary", s.handlers.GetBlockSummary.Handle)
-	s.engine.GET("/transactions", s.handlers.GetTransactionsByHeight.Handle)
-	s.engine.GET("/validator/:address", s.handlers.GetValidatorByAddress.Handle)
-	s.engine.GET("/validators/for_min_height/:height", s.handlers.GetValidatorsForMinHeight.Handle)
-	s.engine.GET("/validators", s.handlers.GetValidatorsByHeight.Handle)
-	s.engine.GET("/validators_summary", s.handlers.GetValidatorSummary.Handle)
-	s.engine.GET("/staking", s.handlers.GetStakingDetailsByHeight.Handle)
-	s.engine.GET("/delegations", s.handlers.GetDelegationsByHeight.Handle)
-	s.engine.GET("/delegations/:address", s.handlers.GetDelegationsByAddress.Handle)
-	s.engine.GET("/debonding_delegations", s.handlers.GetDebondingDelegationsByHeight.Handle)
-	s.engine.GET("/debonding_delegations/:address", s.handlers.GetDebondingDelegationsByAddress.Handle)
-	s.engine.GET("/account/:address", s.handlers.GetAccountByAddress.Handle)
-	s.engine.GET("/account/:address/summaries", s.handlers.GetAccountSummaries.Handle)
-	s.engine.GET("/system_events/:address", s.handlers.GetSystemEventsForAddress.Handle)
-	s.engine.GET("/balance/:address", s.handlers.GetBalanceForAddress.Handle)
-	s.engine.GET("/apr/:address", s.handlers.GetAPRByAddress.Handle)
-
-	// Commands
-	s.engine.POST("/transactions", s.handlers.BroadcastTransaction.Handle)
+	routes := s.routes()
+
+	for _, route := range routes {
+		var handlers []gin.HandlerFunc
+		if route.Admin {
+			handlers = append(handlers, AdminAuthMiddleware(s.cfg.AdminToken))
+		}
+		handlers = append(handlers, http.ValidateParams(http.Parameters(route.Params...)), route.Handler.Handle)
+
+		s.engine.Handle(route.Method, route.Path, handlers...)
+	}
+
+	spec := http.NewOpenAPI("Oasis Hub Indexer API", config.AppVersion, routes)
+	s.engine.GET("/openapi.json", func(c *gin.Context) {
+		http.JsonOK(c, spec)
+	})
+}
+
+// routes returns route table used both to register handlers and to generate OpenAPI document
+func (s *Server) routes() []http.Route {
+	return []http.Route{
+		// Queries
+		{
+			Name:    "Health",
+			Method:  nethttp.MethodGet,
+			Path:    "/health",
+			Summary: "health of the app",
+			Handler: s.handlers.Health,
+		},
+		{
+			Name:     "GetStatus",
+			Method:   nethttp.MethodGet,
+			Path:     "/status",
+			Summary:  "status of the application and chain",
+			Handler:  s.handlers.GetStatus,
+			Response: chain.DetailsView{},
+		},
+		{
+			Name:     "GetBlockByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/block",
+			Summary:  "block details by height",
+			Handler:  s.handlers.GetBlockByHeight,
+			Params:   []interface{}{block.Request{}},
+			Response: block.DetailsView{},
+		},
+		{
+			Name:     "GetBlockTimes",
+			Method:   nethttp.MethodGet,
+			Path:     "/block_times/:limit",
+			Summary:  "average block times of last blocks",
+			Handler:  s.handlers.GetBlockTimes,
+			Params:   []interface{}{block.GetBlockTimesRequest{}},
+			Response: store.GetAvgRecentTimesResult{},
+		},
+		{
+			Name:     "GetBlockSummary",
+			Method:   nethttp.MethodGet,
+			Path:     "/blocks_summary",
+			Summary:  "block summary for interval and period",
+			Handler:  s.handlers.GetBlockSummary,
+			Params:   []interface{}{block.GetBlockTimesForIntervalRequest{}},
+			Response: []model.BlockSummary{},
+		},
+		{
+			Name:     "GetTransactionsByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/transactions",
+			Summary:  "transactions by height",
+			Handler:  s.handlers.GetTransactionsByHeight,
+			Params:   []interface{}{transaction.Request{}},
+			Response: transaction.ListView{},
+		},
+		{
+			Name:     "GetTransactionsByPublicKey",
+			Method:   nethttp.MethodGet,
+			Path:     "/transactions/:public_key",
+			Summary:  "indexed transactions of public key",
+			Handler:  s.handlers.GetTransactionsByPublicKey,
+			Params:   []interface{}{transaction.GetByPublicKeyRequest{}},
+			Response: transaction.SeqListView{},
+		},
+		{
+			Name:     "GetValidatorByAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/validator/:address",
+			Summary:  "validator by address",
+			Handler:  s.handlers.GetValidatorByAddress,
+			Params:   []interface{}{validator.GetByEntityUidRequest{}},
+			Response: validator.AggDetailsView{},
+		},
+		{
+			Name:     "GetValidatorUptime",
+			Method:   nethttp.MethodGet,
+			Path:     "/validator/:address/uptime",
+			Summary:  "signed and missed blocks of validator",
+			Handler:  s.handlers.GetValidatorUptime,
+			Params:   []interface{}{validator.GetUptimeRequest{}},
+			Response: validator.UptimeView{},
+		},
+		{
+			Name:     "GetValidatorsForMinHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/validators/for_min_height/:height",
+			Summary:  "validators seen since height",
+			Handler:  s.handlers.GetValidatorsForMinHeight,
+			Params:   []interface{}{validator.GetForMinHeightRequest{}},
+			Response: validator.AggListView{},
+		},
+		{
+			Name:     "GetValidatorsByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/validators",
+			Summary:  "validators by height",
+			Handler:  s.handlers.GetValidatorsByHeight,
+			Params:   []interface{}{validator.GetByHeightRequest{}},
+			Response: validator.SeqListView{},
+		},
+		{
+			Name:    "GetValidatorSummary",
+			Method:  nethttp.MethodGet,
+			Path:    "/validators_summary",
+			Summary: "validator summary for interval and period",
+			Handler: s.handlers.GetValidatorSummary,
+			Params:  []interface{}{validator.GetSummaryRequest{}},
+		},
+		{
+			Name:     "GetStakingDetailsByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/staking",
+			Summary:  "staking details by height",
+			Handler:  s.handlers.GetStakingDetailsByHeight,
+			Params:   []interface{}{staking.Request{}},
+			Response: staking.DetailsView{},
+		},
+		{
+			Name:     "GetStakingHistory",
+			Method:   nethttp.MethodGet,
+			Path:     "/staking/history",
+			Summary:  "staking summaries for interval",
+			Handler:  s.handlers.GetStakingHistory,
+			Params:   []interface{}{staking.GetHistoryRequest{}},
+			Response: []model.StakingSummary{},
+		},
+		{
+			Name:     "GetNetworkSummary",
+			Method:   nethttp.MethodGet,
+			Path:     "/network/summary",
+			Summary:  "network staking analytics for interval",
+			Handler:  s.handlers.GetNetworkSummary,
+			Params:   []interface{}{network.GetSummaryRequest{}},
+			Response: []model.NetworkSummary{},
+		},
+		{
+			Name:     "GetDelegationsByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/delegations",
+			Summary:  "delegations by height",
+			Handler:  s.handlers.GetDelegationsByHeight,
+			Params:   []interface{}{delegation.Request{}},
+			Response: delegation.ListView{},
+		},
+		{
+			Name:     "GetDelegationsByAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/delegations/:address",
+			Summary:  "delegations of account",
+			Handler:  s.handlers.GetDelegationsByAddress,
+			Params:   []interface{}{delegation.GetByAddressRequest{}},
+			Response: delegation.ListView{},
+		},
+		{
+			Name:     "GetDebondingDelegationsByHeight",
+			Method:   nethttp.MethodGet,
+			Path:     "/debonding_delegations",
+			Summary:  "debonding delegations by height",
+			Handler:  s.handlers.GetDebondingDelegationsByHeight,
+			Params:   []interface{}{debondingdelegation.Request{}},
+			Response: debondingdelegation.ListView{},
+		},
+		{
+			Name:     "GetDebondingDelegationsByAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/debonding_delegations/:address",
+			Summary:  "debonding delegations of account",
+			Handler:  s.handlers.GetDebondingDelegationsByAddress,
+			Params:   []interface{}{debondingdelegation.GetByAddressRequest{}},
+			Response: debondingdelegation.ListView{},
+		},
+		{
+			Name:     "GetAccountByAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/account/:address",
+			Summary:  "account details by address",
+			Handler:  s.handlers.GetAccountByAddress,
+			Params:   []interface{}{account.Request{}},
+			Response: account.DetailsView{},
+		},
+		{
+			Name:     "GetAccountSummaries",
+			Method:   nethttp.MethodGet,
+			Path:     "/account/:address/summaries",
+			Summary:  "daily balance summaries of account",
+			Handler:  s.handlers.GetAccountSummaries,
+			Params:   []interface{}{account.UriParams{}, account.QueryParams{}},
+			Response: account.DailyBalanceViewResult{},
+		},
+		{
+			Name:     "GetTopAccounts",
+			Method:   nethttp.MethodGet,
+			Path:     "/accounts/top",
+			Summary:  "accounts with highest balances",
+			Handler:  s.handlers.GetTopAccounts,
+			Params:   []interface{}{account.GetTopRequest{}},
+			Response: account.TopListView{},
+		},
+		{
+			Name:     "GetSystemEventsForAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/system_events/:address",
+			Summary:  "system events of validator",
+			Handler:  s.handlers.GetSystemEventsForAddress,
+			Params:   []interface{}{systemevent.GetForAddressRequest{}},
+			Response: systemevent.ListView{},
+		},
+		{
+			Name:     "GetBalanceForAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/balance/:address",
+			Summary:  "daily balance summaries of delegator",
+			Handler:  s.handlers.GetBalanceForAddress,
+			Params:   []interface{}{balance.GetForAddressRequest{}},
+			Response: []model.BalanceSummary{},
+		},
+		{
+			Name:     "GetAPRByAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/apr/:address",
+			Summary:  "monthly annualized rewards rates of account",
+			Handler:  s.handlers.GetAPRByAddress,
+			Params:   []interface{}{apr.UriParams{}, apr.QueryParams{}},
+			Response: []apr.DailyApr{},
+		},
+		{
+			Name:     "GetTransfersForAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/transfers/:address",
+			Summary:  "transfers of account",
+			Handler:  s.handlers.GetTransfersForAddress,
+			Params:   []interface{}{transfer.GetForAddressRequest{}},
+			Response: transfer.ListView{},
+		},
+		{
+			Name:     "GetEscrowEventsForAddress",
+			Method:   nethttp.MethodGet,
+			Path:     "/escrow_events/:address",
+			Summary:  "escrow events of account",
+			Handler:  s.handlers.GetEscrowEventsForAddress,
+			Params:   []interface{}{escrowevent.GetForAddressRequest{}},
+			Response: escrowevent.ListView{},
+		},
+		{
+			Name:     "GetWebhookSubscriptions",
+			Method:   nethttp.MethodGet,
+			Path:     "/webhooks",
+			Summary:  "list webhook subscriptions",
+			Handler:  s.handlers.GetWebhookSubscriptions,
+			Response: webhook.ListView{},
+			Admin:    true,
+		},
+		{
+			Name:     "GetWebhookSubscriptionByID",
+			Method:   nethttp.MethodGet,
+			Path:     "/webhooks/:id",
+			Summary:  "webhook subscription by id",
+			Handler:  s.handlers.GetWebhookSubscriptionByID,
+			Params:   []interface{}{webhook.IDRequest{}},
+			Response: webhook.DetailsView{},
+			Admin:    true,
+		},
+		{
+			Name:    "GetStream",
+			Method:  nethttp.MethodGet,
+			Path:    "/stream",
+			Summary: "Server-Sent Events stream of newly indexed heights",
+			Handler: s.handlers.GetStream,
+			Params:  []interface{}{stream.GetRequest{}},
+		},
+		{
+			Name:     "GraphQL",
+			Method:   nethttp.MethodPost,
+			Path:     "/graphql",
+			Summary:  "GraphQL query over indexed data",
+			Handler:  s.handlers.GraphQL,
+			Body:     graph.QueryRequest{},
+			Response: graphql.Result{},
+		},
+
+		// Commands
+		{
+			Name:     "BroadcastTransaction",
+			Method:   nethttp.MethodPost,
+			Path:     "/transactions",
+			Summary:  "broadcast raw transaction",
+			Handler:  s.handlers.BroadcastTransaction,
+			Body:     transaction.BroadcastRequest{},
+			Response: transaction.BroadcastResponse{},
+		},
+		{
+			Name:     "CreateWebhookSubscription",
+			Method:   nethttp.MethodPost,
+			Path:     "/webhooks",
+			Summary:  "create webhook subscription",
+			Handler:  s.handlers.CreateWebhookSubscription,
+			Body:     webhook.SubscriptionRequest{},
+			Response: webhook.DetailsView{},
+			Admin:    true,
+		},
+		{
+			Name:     "UpdateWebhookSubscription",
+			Method:   nethttp.MethodPut,
+			Path:     "/webhooks/:id",
+			Summary:  "update webhook subscription",
+			Handler:  s.handlers.UpdateWebhookSubscription,
+			Params:   []interface{}{webhook.IDRequest{}},
+			Body:     webhook.SubscriptionRequest{},
+			Response: webhook.DetailsView{},
+			Admin:    true,
+		},
+		{
+			Name:     "DeleteWebhookSubscription",
+			Method:   nethttp.MethodDelete,
+			Path:     "/webhooks/:id",
+			Summary:  "delete webhook subscription",
+			Handler:  s.handlers.DeleteWebhookSubscription,
+			Params:   []interface{}{webhook.IDRequest{}},
+			Response: webhook.DeleteResponse{},
+			Admin:    true,
+		},
+	}
 }
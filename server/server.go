@@ -1,6 +1,10 @@
 package server
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/figment-networks/indexing-engine/metrics/prometheusmetrics"
 	"github.com/figment-networks/oasishub-indexer/config"
@@ -9,6 +13,10 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	shutdownTimeout = 10 * time.Second
+)
+
 // Server handles HTTP requests
 type Server struct {
 	cfg      *config.Config
@@ -27,8 +35,8 @@ func New(cfg *config.Config, handlers *usecase.HttpHandlers) *Server {
 	return app.init()
 }
 
-// Index starts the server
-func (s *Server) Start(listenAdd string) error {
+// Start starts the server and shuts it down once ctx is done
+func (s *Server) Start(ctx context.Context, listenAdd string) error {
 	logger.Info("starting server...", logger.Field("app", "server"))
 
 	prom := prometheusmetrics.New()
@@ -42,7 +50,27 @@ func (s *Server) Start(listenAdd string) error {
 	}
 	s.engine.GET(s.cfg.MetricServerUrl, gin.WrapH(metrics.Handler()))
 
-	return s.engine.Run(listenAdd)
+	srv := &http.Server{
+		Addr:    listenAdd,
+		Handler: s.engine,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	logger.Info("stopping server...", logger.Field("app", "server"))
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return srv.Shutdown(shutdownCtx)
 }
 
 // init initializes the server
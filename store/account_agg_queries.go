@@ -0,0 +1,7 @@
+package store
+
+const (
+	accountAggGeneralBalance = `recent_general_balance`
+	accountAggEscrowBalance  = `(recent_escrow_active_balance + recent_escrow_debonding_balance)`
+	accountAggTotalBalance   = `(recent_general_balance + recent_escrow_active_balance + recent_escrow_debonding_balance)`
+)
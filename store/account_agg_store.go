@@ -1,12 +1,28 @@
 package store
 
 import (
+	"errors"
+
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/jinzhu/gorm"
 )
 
+const (
+	AccountAggTopByGeneral = "general"
+	AccountAggTopByEscrow  = "escrow"
+	AccountAggTopByTotal   = "total"
+)
+
 var (
 	_ AccountAggStore = (*accountAggStore)(nil)
+
+	ErrInvalidAccountAggTopBy = errors.New("invalid account balance to order by")
+
+	accountAggTopOrders = map[string]string{
+		AccountAggTopByGeneral: accountAggGeneralBalance,
+		AccountAggTopByEscrow:  accountAggEscrowBalance,
+		AccountAggTopByTotal:   accountAggTotalBalance,
+	}
 )
 
 type AccountAggStore interface {
@@ -14,6 +30,10 @@ type AccountAggStore interface {
 
 	FindBy(string, interface{}) (*model.AccountAgg, error)
 	FindByPublicKey(string) (*model.AccountAgg, error)
+	FindAllByRecentAtHeight(int64) ([]model.AccountAgg, error)
+	FindAllRecentAfterHeight(int64) ([]model.AccountAgg, error)
+	FindTop(FindTopAccountsQuery) ([]model.AccountAgg, error)
+	Count() (int64, error)
 }
 
 func NewAccountAggStore(db *gorm.DB) *accountAggStore {
@@ -36,3 +56,72 @@ func (s accountAggStore) FindBy(key string, value interface{}) (*model.AccountAg
 func (s accountAggStore) FindByPublicKey(key string) (*model.AccountAgg, error) {
 	return s.FindBy("public_key", key)
 }
+
+// FindAllByRecentAtHeight returns accounts last updated at height
+func (s accountAggStore) FindAllByRecentAtHeight(h int64) ([]model.AccountAgg, error) {
+	var result []model.AccountAgg
+
+	err := s.db.
+		Where("recent_at_height = ?", h).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FindAllRecentAfterHeight returns accounts updated after height which were already aggregated at height
+func (s accountAggStore) FindAllRecentAfterHeight(h int64) ([]model.AccountAgg, error) {
+	var result []model.AccountAgg
+
+	err := s.db.
+		Where(accountAggRecomputeCondition, h, h).
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+type FindTopAccountsQuery struct {
+	By     string
+	Desc   bool
+	Limit  int64
+	Offset int64
+}
+
+// FindTop returns page of accounts ordered by general, escrow or total balance
+func (s accountAggStore) FindTop(query FindTopAccountsQuery) ([]model.AccountAgg, error) {
+	balance, ok := accountAggTopOrders[query.By]
+	if !ok {
+		return nil, ErrInvalidAccountAggTopBy
+	}
+
+	order := balance + " ASC"
+	if query.Desc {
+		order = balance + " DESC"
+	}
+
+	var result []model.AccountAgg
+
+	err := s.db.
+		Order(order).
+		Order("public_key").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// Count returns number of aggregated accounts
+func (s accountAggStore) Count() (int64, error) {
+	var count int64
+
+	err := s.db.
+		Table(model.AccountAgg{}.TableName()).
+		Count(&count).
+		Error
+
+	return count, checkErr(err)
+}
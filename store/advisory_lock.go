@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+)
+
+// AdvisoryLock is a session level Postgres advisory lock held on a dedicated connection.
+// Postgres releases the lock as soon as the connection drops, so other session can acquire it.
+type AdvisoryLock struct {
+	db  *sql.DB
+	key int64
+
+	conn *sql.Conn
+}
+
+// NewAdvisoryLock returns advisory lock with given key
+func (s *Store) NewAdvisoryLock(key int64) *AdvisoryLock {
+	return &AdvisoryLock{db: s.db.DB(), key: key}
+}
+
+// TryAcquire returns true when lock was acquired or is already held, it does not wait for other session to release it
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, err
+	}
+
+	if !acquired {
+		return false, conn.Close()
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Check returns an error when connection holding the lock is no longer usable, the lock is lost in that case
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	if l.conn == nil {
+		return sql.ErrConnDone
+	}
+
+	var one int
+	err := l.conn.QueryRowContext(ctx, "SELECT 1").Scan(&one)
+	if err != nil {
+		l.discard()
+	}
+	return err
+}
+
+// discard closes connection holding the lock instead of returning it to the pool, so Postgres releases the lock
+func (l *AdvisoryLock) discard() {
+	l.conn.Raw(func(interface{}) error {
+		return driver.ErrBadConn
+	})
+	l.conn.Close()
+	l.conn = nil
+}
+
+// Release unlocks the lock and closes its connection
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	if closeErr := l.conn.Close(); err == nil {
+		err = closeErr
+	}
+	l.conn = nil
+	return err
+}
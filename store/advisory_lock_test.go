@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+// testAdvisoryLockKey differs from worker leader lock key so test can run against database used by worker
+const testAdvisoryLockKey int64 = 7311001
+
+// newTestStore connects to database from TEST_DATABASE_DSN, test is skipped when it is not set
+func newTestStore(t *testing.T) *Store {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is required")
+	}
+
+	db, err := New(dsn)
+	if err != nil {
+		t.Fatalf("cannot connect to database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestAdvisoryLock(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("only one replica acquires lock", func(t *testing.T) {
+		first := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		second := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		defer first.Release(ctx)
+		defer second.Release(ctx)
+
+		if acquired, err := first.TryAcquire(ctx); err != nil || !acquired {
+			t.Fatalf("first lock should be acquired, got: %v, %v", acquired, err)
+		}
+		if acquired, err := first.TryAcquire(ctx); err != nil || !acquired {
+			t.Errorf("held lock should be acquired again, got: %v, %v", acquired, err)
+		}
+		if err := first.Check(ctx); err != nil {
+			t.Errorf("held lock check should pass, got: %v", err)
+		}
+		if acquired, err := second.TryAcquire(ctx); err != nil || acquired {
+			t.Errorf("second lock should not be acquired, got: %v, %v", acquired, err)
+		}
+	})
+
+	t.Run("lock is released for other replica", func(t *testing.T) {
+		first := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		second := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		defer second.Release(ctx)
+
+		if acquired, err := first.TryAcquire(ctx); err != nil || !acquired {
+			t.Fatalf("first lock should be acquired, got: %v, %v", acquired, err)
+		}
+		if err := first.Release(ctx); err != nil {
+			t.Fatalf("unexpected release error: %v", err)
+		}
+		if acquired, err := second.TryAcquire(ctx); err != nil || !acquired {
+			t.Errorf("second lock should be acquired after release, got: %v, %v", acquired, err)
+		}
+	})
+
+	t.Run("lock is lost on check error", func(t *testing.T) {
+		first := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		second := newTestStore(t).NewAdvisoryLock(testAdvisoryLockKey)
+		defer first.Release(ctx)
+		defer second.Release(ctx)
+
+		if acquired, err := first.TryAcquire(ctx); err != nil || !acquired {
+			t.Fatalf("first lock should be acquired, got: %v, %v", acquired, err)
+		}
+
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		if err := first.Check(cancelledCtx); err == nil {
+			t.Fatalf("check should fail with cancelled context")
+		}
+		if err := first.Check(ctx); err == nil {
+			t.Errorf("check should fail once lock is lost")
+		}
+		if acquired, err := second.TryAcquire(ctx); err != nil || !acquired {
+			t.Errorf("second lock should be acquired after first lost it, got: %v, %v", acquired, err)
+		}
+	})
+}
@@ -18,6 +18,7 @@ type BalanceEventsStore interface {
 
 	GetLastEventTime() (types.Time, error)
 	CreateOrUpdate(*model.BalanceEvent) error
+	FindRecent(FindRecentBalanceEventsQuery) ([]model.BalanceEvent, error)
 	DeleteOlderThan(time.Time) (*int64, error)
 	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]model.BalanceSummary, error)
 }
@@ -45,6 +46,38 @@ func (s *balanceEventsStore) CreateOrUpdate(val *model.BalanceEvent) error {
 	return s.Save(existing)
 }
 
+type FindRecentBalanceEventsQuery struct {
+	Address       *string
+	EscrowAddress *string
+	Kind          *model.BalanceEventKind
+	Limit         int64
+}
+
+// FindRecent finds most recent balance events of given delegator and/or escrow account
+func (s *balanceEventsStore) FindRecent(query FindRecentBalanceEventsQuery) ([]model.BalanceEvent, error) {
+	var result []model.BalanceEvent
+
+	statement := s.db
+
+	if query.Address != nil {
+		statement = statement.Where("address = ?", *query.Address)
+	}
+	if query.EscrowAddress != nil {
+		statement = statement.Where("escrow_address = ?", *query.EscrowAddress)
+	}
+	if query.Kind != nil {
+		statement = statement.Where("kind = ?", *query.Kind)
+	}
+
+	err := statement.
+		Order("height DESC").
+		Limit(query.Limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // DeleteOlderThan deletes balance events older than given threshold
 func (s *balanceEventsStore) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
 	query := s.db.Table("syncables").Select("height").Where("time < ?", purgeThreshold).QueryExpr()
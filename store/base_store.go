@@ -3,6 +3,8 @@ package store
 import (
 	"errors"
 	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -64,6 +66,28 @@ func findMostRecent(db *gorm.DB, orderField string, record interface{}) error {
 		Error
 }
 
+// withHeightRange narrows query to heights within given inclusive range
+func withHeightRange(db *gorm.DB, startHeight, endHeight *int64) *gorm.DB {
+	if startHeight != nil {
+		db = db.Where("height >= ?", *startHeight)
+	}
+	if endHeight != nil {
+		db = db.Where("height <= ?", *endHeight)
+	}
+	return db
+}
+
+// withTimeRange narrows query to times within given inclusive range
+func withTimeRange(db *gorm.DB, startTime, endTime *types.Time) *gorm.DB {
+	if startTime != nil && !startTime.IsZero() {
+		db = db.Where("time >= ?", startTime)
+	}
+	if endTime != nil && !endTime.IsZero() {
+		db = db.Where("time <= ?", endTime)
+	}
+	return db
+}
+
 func checkErr(err error) error {
 	if gorm.IsRecordNotFoundError(err) {
 		return ErrNotFound
@@ -14,6 +14,8 @@ type DebondingDelegationSeqStore interface {
 	BaseStore
 
 	FindByHeight(int64) ([]model.DebondingDelegationSeq, error)
+	FindByHeightAndValidatorUID(int64, string) ([]model.DebondingDelegationSeq, error)
+	ExistsByHeight(int64) (bool, error)
 	FindRecentByValidatorUID(string, int64) ([]model.DebondingDelegationSeq, error)
 	FindRecentByDelegatorUID(string, int64) ([]model.DebondingDelegationSeq, error)
 }
@@ -40,6 +42,33 @@ func (s debondingDelegationSeqStore) FindByHeight(h int64) ([]model.DebondingDel
 	return result, checkErr(err)
 }
 
+// FindByHeightAndValidatorUID finds debonding delegations for validator by height
+func (s debondingDelegationSeqStore) FindByHeightAndValidatorUID(h int64, key string) ([]model.DebondingDelegationSeq, error) {
+	q := model.DebondingDelegationSeq{
+		Sequence: &model.Sequence{
+			Height: h,
+		},
+		ValidatorUID: key,
+	}
+	var result []model.DebondingDelegationSeq
+
+	err := s.db.Where(&q).Find(&result).Error
+	return result, checkErr(err)
+}
+
+// ExistsByHeight checks if debonding delegations were indexed for given height
+func (s debondingDelegationSeqStore) ExistsByHeight(h int64) (bool, error) {
+	var count int64
+
+	err := s.db.
+		Table(model.DebondingDelegationSeq{}.TableName()).
+		Where("height = ?", h).
+		Count(&count).
+		Error
+
+	return count > 0, checkErr(err)
+}
+
 // FindRecentByValidatorUID gets recent debonding delegations for validator
 func (s *debondingDelegationSeqStore) FindRecentByValidatorUID(key string, limit int64) ([]model.DebondingDelegationSeq, error) {
 	q := model.DebondingDelegationSeq{
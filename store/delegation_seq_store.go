@@ -13,6 +13,8 @@ type DelegationSeqStore interface {
 	BaseStore
 
 	FindByHeight(int64) ([]model.DelegationSeq, error)
+	FindByHeightAndValidatorUID(int64, string) ([]model.DelegationSeq, error)
+	ExistsByHeight(int64) (bool, error)
 	FindLastByValidatorUID(string) ([]model.DelegationSeq, error)
 	FindCurrentByDelegatorUID(string) ([]model.DelegationSeq, error)
 }
@@ -39,6 +41,33 @@ func (s delegationSeqStore) FindByHeight(h int64) ([]model.DelegationSeq, error)
 	return result, checkErr(err)
 }
 
+// FindByHeightAndValidatorUID finds delegations for validator by height
+func (s delegationSeqStore) FindByHeightAndValidatorUID(h int64, key string) ([]model.DelegationSeq, error) {
+	q := model.DelegationSeq{
+		Sequence: &model.Sequence{
+			Height: h,
+		},
+		ValidatorUID: key,
+	}
+	var result []model.DelegationSeq
+
+	err := s.db.Where(&q).Find(&result).Error
+	return result, checkErr(err)
+}
+
+// ExistsByHeight checks if delegations were indexed for given height
+func (s delegationSeqStore) ExistsByHeight(h int64) (bool, error) {
+	var count int64
+
+	err := s.db.
+		Table(model.DelegationSeq{}.TableName()).
+		Where("height = ?", h).
+		Count(&count).
+		Error
+
+	return count > 0, checkErr(err)
+}
+
 // GetLastByValidatorUID finds last delegations for validator
 func (s *delegationSeqStore) FindLastByValidatorUID(key string) ([]model.DelegationSeq, error) {
 	q := model.DelegationSeq{
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ EscrowEventSeqStore = (*escrowEventSeqStore)(nil)
+)
+
+type EscrowEventSeqStore interface {
+	BaseStore
+
+	FindByHeight(int64) ([]model.EscrowEventSeq, error)
+	FindByAddress(string, FindEscrowEventsByAddressQuery) ([]model.EscrowEventSeq, int64, error)
+}
+
+func NewEscrowEventSeqStore(db *gorm.DB) *escrowEventSeqStore {
+	return &escrowEventSeqStore{scoped(db, model.EscrowEventSeq{})}
+}
+
+// escrowEventSeqStore handles operations on escrow events
+type escrowEventSeqStore struct {
+	baseStore
+}
+
+// FindByHeight finds escrow events by height
+func (s escrowEventSeqStore) FindByHeight(h int64) ([]model.EscrowEventSeq, error) {
+	q := model.EscrowEventSeq{
+		Sequence: &model.Sequence{
+			Height: h,
+		},
+	}
+	var result []model.EscrowEventSeq
+
+	err := s.db.
+		Where(&q).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+type FindEscrowEventsByAddressQuery struct {
+	Kind        *model.EscrowEventKind
+	StartHeight *int64
+	EndHeight   *int64
+	StartTime   *types.Time
+	EndTime     *types.Time
+	Order       string
+	Limit       int64
+	Offset      int64
+}
+
+// FindByAddress finds escrow events where given address is either an owner or an escrow account
+func (s escrowEventSeqStore) FindByAddress(address string, query FindEscrowEventsByAddressQuery) ([]model.EscrowEventSeq, int64, error) {
+	var result []model.EscrowEventSeq
+	var count int64
+
+	statement := s.db.
+		Where("owner = ? OR escrow = ?", address, address)
+
+	if query.Kind != nil {
+		statement = statement.Where("kind = ?", *query.Kind)
+	}
+
+	statement = withHeightRange(statement, query.StartHeight, query.EndHeight)
+	statement = withTimeRange(statement, query.StartTime, query.EndTime)
+
+	if err := statement.Count(&count).Error; err != nil {
+		return nil, 0, checkErr(err)
+	}
+
+	err := statement.
+		Order(query.Order).
+		Order("id").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&result).
+		Error
+
+	return result, count, checkErr(err)
+}
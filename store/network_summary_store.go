@@ -0,0 +1,77 @@
+package store
+
+import (
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ NetworkSummaryStore = (*networkSummaryStore)(nil)
+)
+
+type NetworkSummaryStore interface {
+	BaseStore
+
+	Find(*model.NetworkSummary) (*model.NetworkSummary, error)
+	FindMostRecentByInterval(types.SummaryInterval) (*model.NetworkSummary, error)
+	FindSummariesByInterval(interval types.SummaryInterval, start, end *types.Time) ([]model.NetworkSummary, error)
+}
+
+func NewNetworkSummaryStore(db *gorm.DB) *networkSummaryStore {
+	return &networkSummaryStore{scoped(db, model.NetworkSummary{})}
+}
+
+// networkSummaryStore handles operations on network summary
+type networkSummaryStore struct {
+	baseStore
+}
+
+// Find find network summary by query
+func (s networkSummaryStore) Find(query *model.NetworkSummary) (*model.NetworkSummary, error) {
+	var result model.NetworkSummary
+
+	err := s.db.
+		Where(query).
+		First(&result).
+		Error
+
+	return &result, checkErr(err)
+}
+
+// FindMostRecentByInterval finds most recent network summary for interval
+func (s *networkSummaryStore) FindMostRecentByInterval(interval types.SummaryInterval) (*model.NetworkSummary, error) {
+	query := &model.NetworkSummary{
+		Summary: &model.Summary{TimeInterval: interval},
+	}
+	result := model.NetworkSummary{}
+
+	err := s.db.
+		Where(query).
+		Order("time_bucket DESC").
+		Take(&result).
+		Error
+
+	return &result, checkErr(err)
+}
+
+// FindSummariesByInterval Gets network summaries for interval between start and end time
+func (s *networkSummaryStore) FindSummariesByInterval(interval types.SummaryInterval, start, end *types.Time) ([]model.NetworkSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("NetworkSummaryStore_FindSummariesByInterval"))
+	defer t.ObserveDuration()
+
+	tx := s.db.
+		Where("time_interval = ?", interval).
+		Order("time_bucket")
+
+	if !end.IsZero() {
+		tx = tx.Where("time_bucket <= ?", end)
+	}
+	if !start.IsZero() {
+		tx = tx.Where("time_bucket >= ?", start)
+	}
+
+	var res []model.NetworkSummary
+	return res, checkErr(tx.Find(&res).Error)
+}
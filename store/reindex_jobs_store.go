@@ -0,0 +1,151 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// claimReindexJobQuery marks first pending job, or running one with stale heartbeat and attempts left, as claimed by worker.
+	// Rows locked by other workers are skipped so concurrent claims never return the same job.
+	claimReindexJobQuery = `
+UPDATE reindex_jobs
+SET status = ?, worker_id = ?, attempts = attempts + 1, heartbeat_at = NOW(), updated_at = NOW()
+WHERE id = (
+  SELECT id
+  FROM reindex_jobs
+  WHERE status = ? OR (status = ? AND heartbeat_at < ? AND attempts < ?)
+  ORDER BY start_height
+  LIMIT 1
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *
+`
+
+	// failStaleReindexJobsQuery marks running jobs with stale heartbeat and no attempts left as failed
+	failStaleReindexJobsQuery = `
+UPDATE reindex_jobs
+SET status = ?, worker_id = NULL, last_error = ?, completed_at = NOW(), updated_at = NOW()
+WHERE id IN (
+  SELECT id
+  FROM reindex_jobs
+  WHERE status = ? AND heartbeat_at < ? AND attempts >= ?
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING *
+`
+
+	staleReindexJobError = "heartbeat timed out and no attempts left"
+)
+
+var (
+	_ ReindexJobsStore = (*reindexJobsStore)(nil)
+)
+
+type ReindexJobsStore interface {
+	BaseStore
+
+	Claim(string, time.Time, int64) (*model.ReindexJob, error)
+	FailStale(time.Time, int64) ([]model.ReindexJob, error)
+	Heartbeat(types.ID, string) error
+	Finish(*model.ReindexJob, string) error
+	CountByStatus(types.ID) (map[model.ReindexJobStatus]int64, error)
+}
+
+func NewReindexJobsStore(db *gorm.DB) *reindexJobsStore {
+	return &reindexJobsStore{scoped(db, model.ReindexJob{})}
+}
+
+// reindexJobsStore handles operations on reindex jobs
+type reindexJobsStore struct {
+	baseStore
+}
+
+// Claim assigns next available job to worker. Jobs which heartbeat is older than staleBefore
+// are considered abandoned by crashed worker and can be claimed again until maxAttempts is reached.
+func (s reindexJobsStore) Claim(workerID string, staleBefore time.Time, maxAttempts int64) (*model.ReindexJob, error) {
+	result := &model.ReindexJob{}
+
+	err := s.db.
+		Raw(claimReindexJobQuery, model.ReindexJobStatusRunning, workerID, model.ReindexJobStatusPending, model.ReindexJobStatusRunning, staleBefore, maxAttempts).
+		Scan(result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// FailStale moves jobs abandoned by crashed worker which reached maxAttempts to failed state and returns them
+func (s reindexJobsStore) FailStale(staleBefore time.Time, maxAttempts int64) ([]model.ReindexJob, error) {
+	var result []model.ReindexJob
+
+	err := s.db.
+		Raw(failStaleReindexJobsQuery, model.ReindexJobStatusFailed, staleReindexJobError, model.ReindexJobStatusRunning, staleBefore, maxAttempts).
+		Scan(&result).
+		Error
+
+	return result, err
+}
+
+// Heartbeat refreshes heartbeat of job still owned by worker, ErrNotFound is returned when job was claimed by other worker
+func (s reindexJobsStore) Heartbeat(id types.ID, workerID string) error {
+	res := s.db.
+		Model(&model.ReindexJob{}).
+		Where("id = ? AND worker_id = ? AND status = ?", id, workerID, model.ReindexJobStatusRunning).
+		Update("heartbeat_at", time.Now())
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Finish saves result of job still owned by worker, ErrNotFound is returned when job was claimed by other worker
+func (s reindexJobsStore) Finish(job *model.ReindexJob, workerID string) error {
+	res := s.db.
+		Model(&model.ReindexJob{}).
+		Where("id = ? AND worker_id = ? AND status = ?", job.ID, workerID, model.ReindexJobStatusRunning).
+		Updates(map[string]interface{}{
+			"status":       job.Status,
+			"worker_id":    job.WorkerID,
+			"attempts":     job.Attempts,
+			"last_error":   job.LastError,
+			"completed_at": job.CompletedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// CountByStatus returns number of report jobs in every status
+func (s reindexJobsStore) CountByStatus(reportID types.ID) (map[model.ReindexJobStatus]int64, error) {
+	var rows []struct {
+		Status model.ReindexJobStatus
+		Count  int64
+	}
+
+	err := s.db.
+		Model(&model.ReindexJob{}).
+		Select("status, COUNT(*) AS count").
+		Where("report_id = ?", reportID).
+		Group("status").
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[model.ReindexJobStatus]int64{}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -16,6 +17,8 @@ type ReportsStore interface {
 	FindNotCompletedByKind(...model.ReportKind) (*model.Report, error)
 	Last() (*model.Report, error)
 	DeleteByKinds([]model.ReportKind) error
+	FindByID(types.ID) (*model.Report, error)
+	IncrementCounts(types.ID, int64, int64) error
 }
 
 
@@ -76,4 +79,29 @@ func (s *reportsStore) DeleteByKinds(kinds []model.ReportKind) error {
 		Error
 
 	return checkErr(err)
-}
\ No newline at end of file
+}
+
+// FindByID returns the report by id
+func (s reportsStore) FindByID(id types.ID) (*model.Report, error) {
+	result := &model.Report{}
+
+	err := s.db.
+		Where("id = ?", id).
+		First(result).Error
+
+	return result, checkErr(err)
+}
+
+// IncrementCounts atomically adds to success and error counts of report updated concurrently by workers
+func (s reportsStore) IncrementCounts(id types.ID, successCount int64, errorCount int64) error {
+	err := s.db.
+		Model(&model.Report{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"success_count": gorm.Expr("COALESCE(success_count, 0) + ?", successCount),
+			"error_count":   gorm.Expr("COALESCE(error_count, 0) + ?", errorCount),
+		}).
+		Error
+
+	return checkErr(err)
+}
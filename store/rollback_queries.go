@@ -0,0 +1,45 @@
+package store
+
+const (
+	// validatorAggAccumulatedRollbackQuery removes counts of sequences above height from accumulated fields
+	// and moves recent proposed height back to last proposed sequence at or below height.
+	// Sequences purged before rollback cannot be counted, so accumulated fields are only lowered by remaining ones.
+	// Precommits validated nil are also stored as not validated, so uptime count is never lowered below 0.
+	validatorAggAccumulatedRollbackQuery = `
+UPDATE validator_aggregates SET
+  recent_proposed_height = CASE WHEN recent_proposed_height <= ? THEN recent_proposed_height ELSE COALESCE((
+    SELECT MAX(height) FROM validator_sequences
+    WHERE entity_uid = validator_aggregates.entity_uid AND proposed AND height <= ?
+  ), 0) END,
+  accumulated_proposed_count = GREATEST(accumulated_proposed_count - (
+    SELECT COUNT(*) FROM validator_sequences
+    WHERE entity_uid = validator_aggregates.entity_uid AND proposed AND height > ?
+  ), 0),
+  accumulated_uptime = GREATEST(accumulated_uptime - (
+    SELECT COUNT(*) FROM validator_sequences
+    WHERE entity_uid = validator_aggregates.entity_uid AND precommit_validated AND height > ?
+  ), 0),
+  accumulated_uptime_count = GREATEST(accumulated_uptime_count - (
+    SELECT COUNT(precommit_validated) FROM validator_sequences
+    WHERE entity_uid = validator_aggregates.entity_uid AND height > ?
+  ), 0),
+  updated_at = NOW()
+WHERE ` + validatorAggRecomputeCondition + `
+`
+
+	// accountAggRecomputeCondition matches accounts which existed at height and have to be restored to ledger state at height
+	accountAggRecomputeCondition = `recent_at_height > ? AND started_at_height <= ?`
+
+	// accountAggRemovedCondition matches accounts first aggregated above height
+	accountAggRemovedCondition = `started_at_height > ?`
+
+	// validatorAggRecomputeCondition matches validators which existed at height and have to be restored to chain state at height
+	validatorAggRecomputeCondition = `recent_at_height > ? AND started_at_height <= ?`
+
+	// validatorAggRemovedCondition matches validators first aggregated above height
+	validatorAggRemovedCondition = `started_at_height > ?`
+
+	summaryRollbackCondition = `time_bucket >= date_trunc(time_interval, (SELECT MIN(time) FROM syncables WHERE height > ?))`
+
+	heightRollbackCondition = `height > ?`
+)
@@ -0,0 +1,269 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	RollbackActionDelete = "delete"
+	RollbackActionUpdate = "update"
+)
+
+var (
+	_ RollbackStore = (*rollbackStore)(nil)
+
+	ErrAccountAggsNotRecomputed   = errors.New("account aggregates updated above rollback height were not recomputed")
+	ErrValidatorAggsNotRecomputed = errors.New("validator aggregates updated above rollback height were not recomputed")
+
+	// rollbackHeightTables holds tables with data indexed per height, syncables are removed last
+	rollbackHeightTables = []string{
+		"block_sequences",
+		"validator_sequences",
+		"transaction_sequences",
+		"staking_sequences",
+		"delegation_sequences",
+		"debonding_delegation_sequences",
+		"transfer_events",
+		"escrow_events",
+		"system_events",
+		"balance_events",
+		"syncables",
+	}
+
+	rollbackSummaryTables = []string{
+		"block_summary",
+		"validator_summary",
+		"balance_summary",
+		"staking_summary",
+		"network_summary",
+	}
+)
+
+type RollbackStore interface {
+	Count(int64) ([]RollbackRow, error)
+	Rollback(int64, []model.AccountAgg, []model.ValidatorAgg) ([]RollbackRow, error)
+}
+
+func NewRollbackStore(db *gorm.DB) *rollbackStore {
+	return &rollbackStore{db: db}
+}
+
+// rollbackStore handles removing of indexed data above height
+type rollbackStore struct {
+	db *gorm.DB
+}
+
+// RollbackRow contains number of rows affected by rollback in table
+type RollbackRow struct {
+	Table  string
+	Action string
+	Count  int64
+}
+
+// rollbackStep is a single statement of rollback
+type rollbackStep struct {
+	table     string
+	action    string
+	condition string
+	args      []interface{}
+
+	// exec updates rows with values computed outside of database, used for update action
+	exec func(tx *gorm.DB) (int64, error)
+}
+
+// steps returns rollback statements in order of execution.
+// Aggregates and summaries are handled first as they are recomputed from sequences and syncables which are removed after.
+func (s *rollbackStore) steps(height int64, accountAggs []model.AccountAgg, validatorAggs []model.ValidatorAgg) []rollbackStep {
+	steps := []rollbackStep{
+		{
+			table:     "validator_aggregates",
+			action:    RollbackActionDelete,
+			condition: validatorAggRemovedCondition,
+			args:      []interface{}{height},
+		},
+		{
+			// Validator sequences at or below height may be purged, so recent fields are set to chain state at height fetched by caller
+			table:     "validator_aggregates",
+			action:    RollbackActionUpdate,
+			condition: validatorAggRecomputeCondition,
+			args:      []interface{}{height, height},
+			exec: func(tx *gorm.DB) (int64, error) {
+				return updateValidatorAggs(tx, height, validatorAggs)
+			},
+		},
+		{
+			table:     "account_aggregates",
+			action:    RollbackActionDelete,
+			condition: accountAggRemovedCondition,
+			args:      []interface{}{height},
+		},
+		{
+			// Account aggregates are not backed by sequences, so recent fields are set to ledger state at height fetched by caller
+			table:     "account_aggregates",
+			action:    RollbackActionUpdate,
+			condition: accountAggRecomputeCondition,
+			args:      []interface{}{height, height},
+			exec: func(tx *gorm.DB) (int64, error) {
+				return updateAccountAggs(tx, height, accountAggs)
+			},
+		},
+	}
+
+	for _, table := range rollbackSummaryTables {
+		steps = append(steps, rollbackStep{
+			table:     table,
+			action:    RollbackActionDelete,
+			condition: summaryRollbackCondition,
+			args:      []interface{}{height},
+		})
+	}
+
+	for _, table := range rollbackHeightTables {
+		steps = append(steps, rollbackStep{
+			table:     table,
+			action:    RollbackActionDelete,
+			condition: heightRollbackCondition,
+			args:      []interface{}{height},
+		})
+	}
+
+	return steps
+}
+
+// Count returns number of rows which would be affected by rollback to height
+func (s *rollbackStore) Count(height int64) ([]RollbackRow, error) {
+	var rows []RollbackRow
+	for _, step := range s.steps(height, nil, nil) {
+		var count int64
+		err := s.db.
+			Table(step.table).
+			Where(step.condition, step.args...).
+			Count(&count).
+			Error
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, RollbackRow{Table: step.table, Action: step.action, Count: count})
+	}
+	return rows, nil
+}
+
+// Rollback removes data above height and recomputes aggregates in single transaction.
+// Account and validator aggregates updated above height are replaced with given ones recomputed from chain state at height.
+func (s *rollbackStore) Rollback(height int64, accountAggs []model.AccountAgg, validatorAggs []model.ValidatorAgg) ([]RollbackRow, error) {
+	var rows []RollbackRow
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for _, step := range s.steps(height, accountAggs, validatorAggs) {
+			if step.exec != nil {
+				count, err := step.exec(tx)
+				if err != nil {
+					return err
+				}
+				rows = append(rows, RollbackRow{Table: step.table, Action: step.action, Count: count})
+				continue
+			}
+
+			result := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s", step.table, step.condition), step.args...)
+			if result.Error != nil {
+				return result.Error
+			}
+			rows = append(rows, RollbackRow{Table: step.table, Action: step.action, Count: result.RowsAffected})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// updateAccountAggs sets recent fields of account aggregates which were updated above height
+func updateAccountAggs(tx *gorm.DB, height int64, accountAggs []model.AccountAgg) (int64, error) {
+	var count int64
+	for _, agg := range accountAggs {
+		result := tx.
+			Model(&model.AccountAgg{}).
+			Where("public_key = ?", agg.PublicKey).
+			Where(accountAggRecomputeCondition, height, height).
+			Updates(map[string]interface{}{
+				"recent_at_height":                     agg.RecentAtHeight,
+				"recent_at":                            agg.RecentAt,
+				"recent_general_balance":               &agg.RecentGeneralBalance,
+				"recent_general_nonce":                 agg.RecentGeneralNonce,
+				"recent_escrow_active_balance":         &agg.RecentEscrowActiveBalance,
+				"recent_escrow_active_total_shares":    &agg.RecentEscrowActiveTotalShares,
+				"recent_escrow_debonding_balance":      &agg.RecentEscrowDebondingBalance,
+				"recent_escrow_debonding_total_shares": &agg.RecentEscrowDebondingTotalShares,
+			})
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		count += result.RowsAffected
+	}
+
+	var remaining int64
+	err := tx.
+		Table(model.AccountAgg{}.TableName()).
+		Where(accountAggRecomputeCondition, height, height).
+		Count(&remaining).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	if remaining > 0 {
+		return 0, ErrAccountAggsNotRecomputed
+	}
+	return count, nil
+}
+
+// updateValidatorAggs lowers accumulated fields and sets recent fields of validator aggregates which were updated above height
+func updateValidatorAggs(tx *gorm.DB, height int64, validatorAggs []model.ValidatorAgg) (int64, error) {
+	// Accumulated fields are updated first as recent fields update moves aggregates out of recompute condition
+	err := tx.
+		Exec(validatorAggAccumulatedRollbackQuery, height, height, height, height, height, height, height).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, agg := range validatorAggs {
+		result := tx.
+			Model(&model.ValidatorAgg{}).
+			Where("entity_uid = ?", agg.EntityUID).
+			Where(validatorAggRecomputeCondition, height, height).
+			Updates(map[string]interface{}{
+				"recent_at_height":             agg.RecentAtHeight,
+				"recent_at":                    agg.RecentAt,
+				"recent_tendermint_address":    agg.RecentTendermintAddress,
+				"recent_voting_power":          agg.RecentVotingPower,
+				"recent_total_shares":          &agg.RecentTotalShares,
+				"recent_active_escrow_balance": &agg.RecentActiveEscrowBalance,
+				"recent_commission":            &agg.RecentCommission,
+				"recent_rewards":               &agg.RecentRewards,
+				"recent_as_validator_height":   agg.RecentAsValidatorHeight,
+			})
+		if result.Error != nil {
+			return 0, result.Error
+		}
+		count += result.RowsAffected
+	}
+
+	var remaining int64
+	err = tx.
+		Table(model.ValidatorAgg{}.TableName()).
+		Where(validatorAggRecomputeCondition, height, height).
+		Count(&remaining).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	if remaining > 0 {
+		return 0, ErrValidatorAggsNotRecomputed
+	}
+	return count, nil
+}
@@ -0,0 +1,15 @@
+package store
+
+const (
+	summarizeStakingQuerySelect = `
+	DATE_TRUNC(?, time)               AS time_bucket,
+	ROUND(AVG(total_supply))          AS total_supply_avg,
+	MAX(total_supply)                 AS total_supply_max,
+	MIN(total_supply)                 AS total_supply_min,
+	ROUND(AVG(common_pool))           AS common_pool_avg,
+	MAX(common_pool)                  AS common_pool_max,
+	MIN(common_pool)                  AS common_pool_min,
+	MAX(debonding_interval)           AS debonding_interval_max,
+	MAX(min_delegation_amount)        AS min_delegation_amount_max
+`
+)
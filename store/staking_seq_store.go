@@ -1,9 +1,11 @@
 package store
 
 import (
+	"github.com/figment-networks/indexing-engine/metrics"
 	"github.com/jinzhu/gorm"
 
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
 )
 
 var (
@@ -16,6 +18,7 @@ type StakingSeqStore interface {
 	FindBy(key string, value interface{}) (*model.StakingSeq, error)
 	FindByHeight(height int64) (*model.StakingSeq, error)
 	Recent() (*model.StakingSeq, error)
+	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]StakingSeqSummary, error)
 }
 
 
@@ -52,3 +55,48 @@ func (s stakingSeqStore) Recent() (*model.StakingSeq, error) {
 	return staking, checkErr(err)
 }
 
+type StakingSeqSummary struct {
+	TimeBucket             types.Time     `json:"time_bucket"`
+	TotalSupplyAvg         types.Quantity `json:"total_supply_avg"`
+	TotalSupplyMax         types.Quantity `json:"total_supply_max"`
+	TotalSupplyMin         types.Quantity `json:"total_supply_min"`
+	CommonPoolAvg          types.Quantity `json:"common_pool_avg"`
+	CommonPoolMax          types.Quantity `json:"common_pool_max"`
+	CommonPoolMin          types.Quantity `json:"common_pool_min"`
+	DebondingIntervalMax   uint64         `json:"debonding_interval_max"`
+	MinDelegationAmountMax types.Quantity `json:"min_delegation_amount_max"`
+}
+
+// Summarize gets the summarized version of staking sequences
+func (s *stakingSeqStore) Summarize(interval types.SummaryInterval, activityPeriods []ActivityPeriodRow) ([]StakingSeqSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("StakingSeqStore_Summarize"))
+	defer t.ObserveDuration()
+
+	tx := s.db.
+		Table(model.StakingSeq{}.TableName()).
+		Select(summarizeStakingQuerySelect, interval).
+		Order("time_bucket").
+		Group("time_bucket")
+
+	if len(activityPeriods) == 1 {
+		activityPeriod := activityPeriods[0]
+		tx = tx.Or("time < ? OR time >= ?", activityPeriod.Min, activityPeriod.Max)
+	} else {
+		for i, activityPeriod := range activityPeriods {
+			isLast := i == len(activityPeriods)-1
+
+			if isLast {
+				tx = tx.Or("time >= ?", activityPeriod.Max)
+			} else {
+				duration, err := interval.ToDuration()
+				if err != nil {
+					return nil, err
+				}
+				tx = tx.Or("time >= ? AND time < ?", activityPeriod.Max.Add(duration), activityPeriods[i+1].Min)
+			}
+		}
+	}
+
+	var models []StakingSeqSummary
+	return models, tx.Find(&models).Error
+}
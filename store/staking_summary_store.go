@@ -0,0 +1,74 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ StakingSummaryStore = (*stakingSummaryStore)(nil)
+)
+
+type StakingSummaryStore interface {
+	BaseStore
+
+	Find(*model.StakingSummary) (*model.StakingSummary, error)
+	FindActivityPeriods(types.SummaryInterval, int64) ([]ActivityPeriodRow, error)
+	FindSummariesByInterval(interval types.SummaryInterval, start, end *types.Time) ([]model.StakingSummary, error)
+}
+
+func NewStakingSummaryStore(db *gorm.DB) *stakingSummaryStore {
+	return &stakingSummaryStore{scoped(db, model.StakingSummary{})}
+}
+
+// stakingSummaryStore handles operations on staking summary
+type stakingSummaryStore struct {
+	baseStore
+}
+
+// Find find staking summary by query
+func (s stakingSummaryStore) Find(query *model.StakingSummary) (*model.StakingSummary, error) {
+	var result model.StakingSummary
+
+	err := s.db.
+		Where(query).
+		First(&result).
+		Error
+
+	return &result, checkErr(err)
+}
+
+// FindActivityPeriods Finds activity periods
+func (s *stakingSummaryStore) FindActivityPeriods(interval types.SummaryInterval, indexVersion int64) ([]ActivityPeriodRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("StakingSummaryStore_FindActivityPeriods"))
+	defer t.ObserveDuration()
+
+	query := getActivityPeriodsQuery(model.StakingSummary{}.TableName())
+
+	var res []ActivityPeriodRow
+	return res, s.db.Raw(query, fmt.Sprintf("1%s", interval), interval, indexVersion).Find(&res).Error
+}
+
+// FindSummariesByInterval Gets staking summaries for interval between start and end time
+func (s *stakingSummaryStore) FindSummariesByInterval(interval types.SummaryInterval, start, end *types.Time) ([]model.StakingSummary, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("StakingSummaryStore_FindSummariesByInterval"))
+	defer t.ObserveDuration()
+
+	tx := s.db.
+		Where("time_interval = ?", interval).
+		Order("time_bucket")
+
+	if !end.IsZero() {
+		tx = tx.Where("time_bucket <= ?", end)
+	}
+	if !start.IsZero() {
+		tx = tx.Where("time_bucket >= ?", start)
+	}
+
+	var res []model.StakingSummary
+	return res, checkErr(tx.Find(&res).Error)
+}
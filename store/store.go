@@ -29,21 +29,31 @@ func New(connStr string) (*Store, error) {
 		db: conn,
 
 		Database:      NewDatabaseStore(conn),
+		Rollback:      NewRollbackStore(conn),
+		Verify:        NewVerifyStore(conn),
 		Syncables:     NewSyncablesStore(conn),
 		Reports:       NewReportsStore(conn),
+		ReindexJobs:   NewReindexJobsStore(conn),
 		SystemEvents:  NewSystemEventsStore(conn),
 		BalanceEvents: NewBalanceEventsStore(conn),
 
+		WebhookSubscriptions: NewWebhookSubscriptionsStore(conn),
+		WebhookDeliveries:    NewWebhookDeliveriesStore(conn),
+
 		BlockSeq:               NewBlockSeqStore(conn),
 		DebondingDelegationSeq: NewDebondingDelegationSeqStore(conn),
 		DelegationSeq:          NewDelegationSeqStore(conn),
 		StakingSeq:             NewStakingSeqStore(conn),
 		TransactionSeq:         NewTransactionSeqStore(conn),
 		ValidatorSeq:           NewValidatorSeqStore(conn),
+		TransferEventSeq:       NewTransferEventSeqStore(conn),
+		EscrowEventSeq:         NewEscrowEventSeqStore(conn),
 
 		BlockSummary:     NewBlockSummaryStore(conn),
 		ValidatorSummary: NewValidatorSummaryStore(conn),
 		BalanceSummary:   NewBalanceSummaryStore(conn),
+		StakingSummary:   NewStakingSummaryStore(conn),
+		NetworkSummary:   NewNetworkSummaryStore(conn),
 
 		AccountAgg:   NewAccountAggStore(conn),
 		ValidatorAgg: NewValidatorAggStore(conn),
@@ -55,21 +65,31 @@ type Store struct {
 	db *gorm.DB
 
 	Database      DatabaseStore
+	Rollback      RollbackStore
+	Verify        VerifyStore
 	Syncables     SyncablesStore
 	Reports       ReportsStore
+	ReindexJobs   ReindexJobsStore
 	SystemEvents  SystemEventsStore
 	BalanceEvents BalanceEventsStore
 
+	WebhookSubscriptions WebhookSubscriptionsStore
+	WebhookDeliveries    WebhookDeliveriesStore
+
 	BlockSeq               BlockSeqStore
 	DebondingDelegationSeq DebondingDelegationSeqStore
 	DelegationSeq          DelegationSeqStore
 	StakingSeq             StakingSeqStore
 	TransactionSeq         TransactionSeqStore
 	ValidatorSeq           ValidatorSeqStore
+	TransferEventSeq       TransferEventSeqStore
+	EscrowEventSeq         EscrowEventSeqStore
 
 	BlockSummary     BlockSummaryStore
 	ValidatorSummary ValidatorSummaryStore
 	BalanceSummary   BalanceSummaryStore
+	StakingSummary   StakingSummaryStore
+	NetworkSummary   NetworkSummaryStore
 
 	AccountAgg   AccountAggStore
 	ValidatorAgg ValidatorAggStore
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
 	"github.com/jinzhu/gorm"
 )
 
@@ -16,6 +17,8 @@ type SyncablesStore interface {
 
 	FindByHeight(int64) (*model.Syncable, error)
 	FindMostRecent() (*model.Syncable, error)
+	FindMostRecentProcessed() (*model.Syncable, error)
+	FindProcessedSince(types.Time, types.ID, int64) ([]model.Syncable, error)
 	FindSmallestIndexVersion() (*int64, error)
 	FindFirstByDifferentIndexVersion(int64) (*model.Syncable, error)
 	FindMostRecentByDifferentIndexVersion(int64) (*model.Syncable, error)
@@ -70,6 +73,32 @@ func (s syncablesStore) FindMostRecent() (*model.Syncable, error) {
 	return result, checkErr(err)
 }
 
+// FindMostRecentProcessed returns the syncable processed most recently
+func (s syncablesStore) FindMostRecentProcessed() (*model.Syncable, error) {
+	result := &model.Syncable{}
+
+	err := s.db.
+		Where("processed_at IS NOT NULL").
+		Order("processed_at desc, id desc").
+		First(result).Error
+
+	return result, checkErr(err)
+}
+
+// FindProcessedSince returns syncables processed after given (processed at, id) cursor in processing order
+func (s syncablesStore) FindProcessedSince(processedAt types.Time, id types.ID, limit int64) ([]model.Syncable, error) {
+	var result []model.Syncable
+
+	err := s.db.
+		Where("processed_at > ? OR (processed_at = ? AND id > ?)", processedAt, processedAt, id).
+		Order("processed_at, id").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // FindSmallestIndexVersion returns smallest index version
 func (s syncablesStore) FindSmallestIndexVersion() (*int64, error) {
 	result := &model.Syncable{}
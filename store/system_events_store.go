@@ -14,7 +14,8 @@ type SystemEventsStore interface {
 	BaseStore
 
 	FindByHeight(int64) ([]model.SystemEvent, error)
-	FindByActor(string, FindSystemEventByActorQuery) ([]model.SystemEvent, error)
+	FindByActor(string, FindSystemEventByActorQuery) ([]model.SystemEvent, int64, error)
+	FindAfterID(int64, FindSystemEventAfterIDQuery) ([]model.SystemEvent, error)
 	FindUnique(int64, string, model.SystemEventKind) (*model.SystemEvent, error)
 	CreateOrUpdate(*model.SystemEvent) error
 	FindMostRecent() (*model.SystemEvent, error)
@@ -36,7 +37,7 @@ func (s systemEventsStore) FindByHeight(height int64) ([]model.SystemEvent, erro
 
 	err := s.db.
 		Where("height = ?", height).
-		Find(result).
+		Find(&result).
 		Error
 
 	return result, checkErr(err)
@@ -45,11 +46,15 @@ func (s systemEventsStore) FindByHeight(height int64) ([]model.SystemEvent, erro
 type FindSystemEventByActorQuery struct {
 	Kind      *model.SystemEventKind
 	MinHeight *int64
+	Order     string
+	Limit     int64
+	Offset    int64
 }
 
-// FindByActor returns system events by actor
-func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEventByActorQuery) ([]model.SystemEvent, error) {
+// FindByActor returns page of system events by actor together with total count of matching events
+func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEventByActorQuery) ([]model.SystemEvent, int64, error) {
 	var result []model.SystemEvent
+	var count int64
 	q := model.SystemEvent{}
 	if query.Kind != nil {
 		q.Kind = *query.Kind
@@ -63,7 +68,48 @@ func (s systemEventsStore) FindByActor(actorAddress string, query FindSystemEven
 		statement = statement.Where("height > ?", query.MinHeight)
 	}
 
+	if err := statement.Count(&count).Error; err != nil {
+		return nil, 0, checkErr(err)
+	}
+
+	err := statement.
+		Order(query.Order).
+		Order("id").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&result).
+		Error
+
+	return result, count, checkErr(err)
+}
+
+type FindSystemEventAfterIDQuery struct {
+	Actor         *string
+	Kind          *model.SystemEventKind
+	CreatedBefore *time.Time
+	Limit         int64
+}
+
+// FindAfterID returns system events created after event with given id
+func (s systemEventsStore) FindAfterID(id int64, query FindSystemEventAfterIDQuery) ([]model.SystemEvent, error) {
+	var result []model.SystemEvent
+
+	statement := s.db.
+		Where("id > ?", id)
+
+	if query.CreatedBefore != nil {
+		statement = statement.Where("created_at < ?", *query.CreatedBefore)
+	}
+	if query.Actor != nil {
+		statement = statement.Where("actor = ?", *query.Actor)
+	}
+	if query.Kind != nil {
+		statement = statement.Where("kind = ?", *query.Kind)
+	}
+
 	err := statement.
+		Order("id").
+		Limit(query.Limit).
 		Find(&result).
 		Error
 
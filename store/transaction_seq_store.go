@@ -4,6 +4,7 @@ import (
 	"github.com/jinzhu/gorm"
 
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
 )
 
 var (
@@ -14,6 +15,7 @@ type TransactionSeqStore interface {
 	BaseStore
 
 	FindByHeight(h int64) ([]model.TransactionSeq, error)
+	FindByPublicKey(key string, query FindTransactionsByPublicKeyQuery) ([]model.TransactionSeq, int64, error)
 }
 
 func NewTransactionSeqStore(db *gorm.DB) *transactionSeqStore {
@@ -41,4 +43,46 @@ func (s transactionSeqStore) FindByHeight(h int64) ([]model.TransactionSeq, erro
 	return result, checkErr(err)
 }
 
+type FindTransactionsByPublicKeyQuery struct {
+	Method      *string
+	StartHeight *int64
+	EndHeight   *int64
+	StartTime   *types.Time
+	EndTime     *types.Time
+	Order       string
+	Limit       int64
+	Offset      int64
+}
+
+// FindByPublicKey finds page of transactions sent by given public key together with total count of matching transactions
+func (s transactionSeqStore) FindByPublicKey(key string, query FindTransactionsByPublicKeyQuery) ([]model.TransactionSeq, int64, error) {
+	q := model.TransactionSeq{
+		PublicKey: key,
+	}
+	var result []model.TransactionSeq
+	var count int64
+
+	statement := s.db.
+		Where(&q)
+
+	if query.Method != nil {
+		statement = statement.Where("method = ?", *query.Method)
+	}
 
+	statement = withHeightRange(statement, query.StartHeight, query.EndHeight)
+	statement = withTimeRange(statement, query.StartTime, query.EndTime)
+
+	if err := statement.Count(&count).Error; err != nil {
+		return nil, 0, checkErr(err)
+	}
+
+	err := statement.
+		Order(query.Order).
+		Order("id").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&result).
+		Error
+
+	return result, count, checkErr(err)
+}
@@ -0,0 +1,100 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+func TestTransactionSeqStore_FindByPublicKey(t *testing.T) {
+	db := newTestStore(t)
+
+	const publicKey = "test-transaction-seq-store-key"
+	const startHeight int64 = 900000201
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cleanup := func() {
+		db.db.Exec("DELETE FROM transaction_sequences WHERE public_key = ?", publicKey)
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	// Transactions are sent one per hour, every other one is a transfer
+	methods := []string{"staking.Transfer", "staking.AddEscrow", "staking.Transfer", "staking.AddEscrow", "staking.Transfer"}
+	for i, method := range methods {
+		err := db.db.Exec(`INSERT INTO transaction_sequences (created_at, updated_at, height, time, public_key, hash, nonce, fee, gas_limit, gas_price, method)
+VALUES (NOW(), NOW(), ?, ?, ?, ?, ?, 0, 0, 0, ?)`, startHeight+int64(i), startTime.Add(time.Duration(i)*time.Hour), publicKey, "hash", i, method).Error
+		if err != nil {
+			t.Fatalf("cannot insert transaction sequence: %v", err)
+		}
+	}
+
+	method := "staking.Transfer"
+	otherMethod := "staking.Burn"
+	heightFrom, heightTo := startHeight+1, startHeight+3
+	timeFrom, timeTo := types.NewTimeFromTime(startTime.Add(2*time.Hour)), types.NewTimeFromTime(startTime.Add(4*time.Hour))
+
+	tests := []struct {
+		description   string
+		query         FindTransactionsByPublicKeyQuery
+		expectHeights []int64
+		expectTotal   int64
+	}{
+		{
+			description:   "returns all transactions of public key",
+			query:         FindTransactionsByPublicKeyQuery{Order: "height asc", Limit: 10},
+			expectHeights: []int64{startHeight, startHeight + 1, startHeight + 2, startHeight + 3, startHeight + 4},
+			expectTotal:   5,
+		},
+		{
+			description:   "filters by method",
+			query:         FindTransactionsByPublicKeyQuery{Method: &method, Order: "height asc", Limit: 10},
+			expectHeights: []int64{startHeight, startHeight + 2, startHeight + 4},
+			expectTotal:   3,
+		},
+		{
+			description: "returns nothing for other method",
+			query:       FindTransactionsByPublicKeyQuery{Method: &otherMethod, Order: "height asc", Limit: 10},
+		},
+		{
+			description:   "filters by inclusive height range",
+			query:         FindTransactionsByPublicKeyQuery{StartHeight: &heightFrom, EndHeight: &heightTo, Order: "height asc", Limit: 10},
+			expectHeights: []int64{startHeight + 1, startHeight + 2, startHeight + 3},
+			expectTotal:   3,
+		},
+		{
+			description:   "filters by inclusive time range",
+			query:         FindTransactionsByPublicKeyQuery{StartTime: timeFrom, EndTime: timeTo, Order: "height asc", Limit: 10},
+			expectHeights: []int64{startHeight + 2, startHeight + 3, startHeight + 4},
+			expectTotal:   3,
+		},
+		{
+			description:   "combines filters and returns total of all pages",
+			query:         FindTransactionsByPublicKeyQuery{Method: &method, StartHeight: &heightFrom, Order: "height desc", Limit: 1, Offset: 1},
+			expectHeights: []int64{startHeight + 2},
+			expectTotal:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			got, total, err := db.TransactionSeq.FindByPublicKey(publicKey, tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if total != tt.expectTotal {
+				t.Errorf("unexpected total, want: %d; got: %d", tt.expectTotal, total)
+			}
+			if len(got) != len(tt.expectHeights) {
+				t.Fatalf("unexpected number of transactions, want: %d; got: %d", len(tt.expectHeights), len(got))
+			}
+			for i, seq := range got {
+				if seq.Height != tt.expectHeights[i] {
+					t.Errorf("unexpected height at %d, want: %d; got: %d", i, tt.expectHeights[i], seq.Height)
+				}
+			}
+		})
+	}
+}
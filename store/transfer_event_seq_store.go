@@ -0,0 +1,80 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ TransferEventSeqStore = (*transferEventSeqStore)(nil)
+)
+
+type TransferEventSeqStore interface {
+	BaseStore
+
+	FindByHeight(int64) ([]model.TransferEventSeq, error)
+	FindByAddress(string, FindTransferEventsByAddressQuery) ([]model.TransferEventSeq, int64, error)
+}
+
+func NewTransferEventSeqStore(db *gorm.DB) *transferEventSeqStore {
+	return &transferEventSeqStore{scoped(db, model.TransferEventSeq{})}
+}
+
+// transferEventSeqStore handles operations on transfer events
+type transferEventSeqStore struct {
+	baseStore
+}
+
+// FindByHeight finds transfer events by height
+func (s transferEventSeqStore) FindByHeight(h int64) ([]model.TransferEventSeq, error) {
+	q := model.TransferEventSeq{
+		Sequence: &model.Sequence{
+			Height: h,
+		},
+	}
+	var result []model.TransferEventSeq
+
+	err := s.db.
+		Where(&q).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+type FindTransferEventsByAddressQuery struct {
+	StartHeight *int64
+	EndHeight   *int64
+	StartTime   *types.Time
+	EndTime     *types.Time
+	Order       string
+	Limit       int64
+	Offset      int64
+}
+
+// FindByAddress finds transfer events sent from or received by given address
+func (s transferEventSeqStore) FindByAddress(address string, query FindTransferEventsByAddressQuery) ([]model.TransferEventSeq, int64, error) {
+	var result []model.TransferEventSeq
+	var count int64
+
+	statement := s.db.
+		Where("from_address = ? OR to_address = ?", address, address)
+
+	statement = withHeightRange(statement, query.StartHeight, query.EndHeight)
+	statement = withTimeRange(statement, query.StartTime, query.EndTime)
+
+	if err := statement.Count(&count).Error; err != nil {
+		return nil, 0, checkErr(err)
+	}
+
+	err := statement.
+		Order(query.Order).
+		Order("id").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&result).
+		Error
+
+	return result, count, checkErr(err)
+}
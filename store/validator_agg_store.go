@@ -16,6 +16,7 @@ type ValidatorAggStore interface {
 	FindByAddress(string) (*model.ValidatorAgg, error)
 	FindByEntityUID(string) (*model.ValidatorAgg, error)
 	GetAllForHeightGreaterThan(int64) ([]model.ValidatorAgg, error)
+	FindAllRecentAfterHeight(int64) ([]model.ValidatorAgg, error)
 	CreateOrUpdate(val *model.ValidatorAgg) error
 }
 
@@ -58,6 +59,19 @@ func (s *validatorAggStore) GetAllForHeightGreaterThan(height int64) ([]model.Va
 	return result, checkErr(err)
 }
 
+// FindAllRecentAfterHeight returns validators updated after height which were already aggregated at height
+func (s *validatorAggStore) FindAllRecentAfterHeight(h int64) ([]model.ValidatorAgg, error) {
+	var result []model.ValidatorAgg
+
+	err := s.db.
+		Where(validatorAggRecomputeCondition, h, h).
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
 // CreateOrUpdate creates a new validator or updates an existing one
 func (s validatorAggStore) CreateOrUpdate(val *model.ValidatorAgg) error {
 	_, err := s.FindByEntityUID(val.EntityUID)
@@ -21,4 +21,14 @@ const (
    	COUNT(*) - SUM(precommit_validated::INT) AS not_validated_sum,
    	SUM(proposed::INT)                       AS proposed_sum
 `
+
+	lastHeightsByIntervalQuerySelect = `
+	DATE_TRUNC(?, time) AS time_bucket,
+	MAX(height)         AS height
+`
+
+	uptimeByAddressQuerySelect = `
+	COUNT(*)                                         AS count,
+	COALESCE(SUM(precommit_validated::INT), 0)       AS validated
+`
 )
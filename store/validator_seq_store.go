@@ -20,8 +20,11 @@ type ValidatorSeqStore interface {
 	FindByHeight(int64) ([]model.ValidatorSeq, error)
 	FindLastByAddress(string, int64) ([]model.ValidatorSeq, error)
 	FindMostRecent() (*model.ValidatorSeq, error)
+	FindPrecommitsByAddress(address string, startHeight, endHeight int64) ([]PrecommitRow, error)
+	FindUptimeByAddress(address string, startHeight, endHeight int64) (*UptimeRow, error)
 	DeleteOlderThan(time.Time) (*int64, error)
 	Summarize(types.SummaryInterval, []ActivityPeriodRow) ([]ValidatorSeqSummary, error)
+	FindLastHeightsByInterval(types.SummaryInterval, *types.Time) ([]TimeBucketHeightRow, error)
 }
 
 func NewValidatorSeqStore(db *gorm.DB) *validatorSeqStore {
@@ -94,6 +97,49 @@ func (s *validatorSeqStore) FindMostRecent() (*model.ValidatorSeq, error) {
 	return validatorSeq, nil
 }
 
+type PrecommitRow struct {
+	Height             int64
+	PrecommitValidated bool
+}
+
+// FindPrecommitsByAddress finds precommit status of validator for every height in given range
+func (s *validatorSeqStore) FindPrecommitsByAddress(address string, startHeight, endHeight int64) ([]PrecommitRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_FindPrecommitsByAddress"))
+	defer t.ObserveDuration()
+
+	var res []PrecommitRow
+	err := s.db.
+		Table(model.ValidatorSeq{}.TableName()).
+		Select("height, precommit_validated").
+		Where("address = ? AND height >= ? AND height <= ? AND precommit_validated IS NOT NULL", address, startHeight, endHeight).
+		Order("height").
+		Find(&res).
+		Error
+
+	return res, checkErr(err)
+}
+
+type UptimeRow struct {
+	Count     int64
+	Validated int64
+}
+
+// FindUptimeByAddress counts heights with known precommit status and validated precommits of validator in given range
+func (s *validatorSeqStore) FindUptimeByAddress(address string, startHeight, endHeight int64) (*UptimeRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_FindUptimeByAddress"))
+	defer t.ObserveDuration()
+
+	var res UptimeRow
+	err := s.db.
+		Table(model.ValidatorSeq{}.TableName()).
+		Select(uptimeByAddressQuerySelect).
+		Where("address = ? AND height >= ? AND height <= ? AND precommit_validated IS NOT NULL", address, startHeight, endHeight).
+		Scan(&res).
+		Error
+
+	return &res, checkErr(err)
+}
+
 // DeleteOlderThan deletes validator sequence older than given threshold
 func (s *validatorSeqStore) DeleteOlderThan(purgeThreshold time.Time) (*int64, error) {
 	tx := s.db.
@@ -162,3 +208,27 @@ func (s *validatorSeqStore) Summarize(interval types.SummaryInterval, activityPe
 	var models []ValidatorSeqSummary
 	return models, tx.Find(&models).Error
 }
+
+type TimeBucketHeightRow struct {
+	TimeBucket types.Time
+	Height     int64
+}
+
+// FindLastHeightsByInterval gets last indexed height of every time bucket starting from given time
+func (s *validatorSeqStore) FindLastHeightsByInterval(interval types.SummaryInterval, since *types.Time) ([]TimeBucketHeightRow, error) {
+	t := metrics.NewTimer(databaseQueryDuration.WithLabels("ValidatorSeqStore_FindLastHeightsByInterval"))
+	defer t.ObserveDuration()
+
+	tx := s.db.
+		Table(model.ValidatorSeq{}.TableName()).
+		Select(lastHeightsByIntervalQuerySelect, interval).
+		Order("time_bucket").
+		Group("time_bucket")
+
+	if !since.IsZero() {
+		tx = tx.Where("time >= ?", since)
+	}
+
+	var res []TimeBucketHeightRow
+	return res, tx.Find(&res).Error
+}
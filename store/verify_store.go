@@ -0,0 +1,143 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	VerifyIssueMissing = "missing"
+	VerifyIssueRunning = "running"
+
+	// missingHeightsQuery returns heights in range without any row in table, %s is replaced with table name
+	missingHeightsQuery = `
+SELECT h.height
+FROM generate_series(?::bigint, ?::bigint) AS h(height)
+WHERE NOT EXISTS (SELECT 1 FROM %s t WHERE t.height = h.height)
+ORDER BY h.height
+`
+)
+
+var (
+	_ VerifyStore = (*verifyStore)(nil)
+
+	// verifySequenceTables holds sequence tables expected to have rows for every indexed height which was not purged yet.
+	// Sequences of transactions, delegations and events are not checked as heights without them are valid.
+	verifySequenceTables = []string{
+		"block_sequences",
+		"validator_sequences",
+	}
+)
+
+type VerifyStore interface {
+	Verify(int64, int64, int64, time.Time) ([]VerifyRow, error)
+	FindLastProcessedHeight() (int64, error)
+	FindFirstHeightSince(time.Time) (int64, error)
+}
+
+func NewVerifyStore(db *gorm.DB) *verifyStore {
+	return &verifyStore{db: db}
+}
+
+// verifyStore handles finding heights which were not indexed or were not fully processed
+type verifyStore struct {
+	db *gorm.DB
+}
+
+// VerifyRow contains heights with issue found in table
+type VerifyRow struct {
+	Table   string
+	Issue   string
+	Heights []int64
+}
+
+// Verify returns missing heights of syncables and sequence tables and heights of syncables still marked as running.
+// Sequence tables are checked from sequencesStartHeight only, as older sequences may be purged.
+// Syncables started at or after runningStartedBefore are skipped as they may still be processed.
+func (s *verifyStore) Verify(startHeight int64, endHeight int64, sequencesStartHeight int64, runningStartedBefore time.Time) ([]VerifyRow, error) {
+	heights, err := s.findMissingHeights("syncables", startHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+	rows := []VerifyRow{{Table: "syncables", Issue: VerifyIssueMissing, Heights: heights}}
+
+	if sequencesStartHeight < startHeight {
+		sequencesStartHeight = startHeight
+	}
+	for _, table := range verifySequenceTables {
+		heights, err := s.findMissingHeights(table, sequencesStartHeight, endHeight)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, VerifyRow{Table: table, Issue: VerifyIssueMissing, Heights: heights})
+	}
+
+	heights, err = s.findRunningHeights(startHeight, endHeight, runningStartedBefore)
+	if err != nil {
+		return nil, err
+	}
+	rows = append(rows, VerifyRow{Table: "syncables", Issue: VerifyIssueRunning, Heights: heights})
+
+	return rows, nil
+}
+
+func (s *verifyStore) findMissingHeights(table string, startHeight int64, endHeight int64) ([]int64, error) {
+	var result []heightRow
+	err := s.db.
+		Raw(fmt.Sprintf(missingHeightsQuery, table), startHeight, endHeight).
+		Scan(&result).
+		Error
+	return toHeights(result), err
+}
+
+func (s *verifyStore) findRunningHeights(startHeight int64, endHeight int64, startedBefore time.Time) ([]int64, error) {
+	var result []heightRow
+	err := s.db.
+		Model(&model.Syncable{}).
+		Select("height").
+		Where("height >= ? AND height <= ? AND status = ?", startHeight, endHeight, model.SyncableStatusRunning).
+		Where("started_at < ?", startedBefore).
+		Order("height").
+		Scan(&result).
+		Error
+	return toHeights(result), err
+}
+
+// FindLastProcessedHeight returns highest processed height, or 0 when nothing is processed yet
+func (s *verifyStore) FindLastProcessedHeight() (int64, error) {
+	var result heightRow
+	err := s.db.
+		Model(&model.Syncable{}).
+		Select("COALESCE(MAX(height), 0) AS height").
+		Where("processed_at IS NOT NULL").
+		Scan(&result).
+		Error
+	return result.Height, err
+}
+
+// FindFirstHeightSince returns lowest height of syncable with time at or after given time, or 0 when there is none
+func (s *verifyStore) FindFirstHeightSince(since time.Time) (int64, error) {
+	var result heightRow
+	err := s.db.
+		Model(&model.Syncable{}).
+		Select("COALESCE(MIN(height), 0) AS height").
+		Where("time >= ?", since).
+		Scan(&result).
+		Error
+	return result.Height, err
+}
+
+type heightRow struct {
+	Height int64
+}
+
+func toHeights(rows []heightRow) []int64 {
+	heights := make([]int64, len(rows))
+	for i, row := range rows {
+		heights[i] = row.Height
+	}
+	return heights
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+)
+
+func TestVerifyStore_VerifyPurgedRange(t *testing.T) {
+	db := newTestStore(t)
+
+	const startHeight, endHeight, purgedBeforeHeight int64 = 900000101, 900000105, 900000104
+	lastTime := time.Now().Add(-48 * time.Hour).UTC()
+
+	cleanup := func() {
+		for _, table := range []string{"syncables", "block_sequences", "validator_sequences"} {
+			db.db.Exec("DELETE FROM "+table+" WHERE height >= ? AND height <= ?", startHeight, endHeight)
+		}
+	}
+	cleanup()
+	t.Cleanup(cleanup)
+
+	for height := startHeight; height <= endHeight; height++ {
+		heightTime := lastTime.Add(time.Duration(height-endHeight) * time.Hour)
+
+		err := db.db.Exec(`INSERT INTO syncables (created_at, updated_at, height, time, app_version, block_version, index_version, status, started_at, processed_at)
+VALUES (NOW(), NOW(), ?, ?, 1, 1, 1, ?, ?, ?)`, height, heightTime, model.SyncableStatusCompleted, heightTime, heightTime).Error
+		if err != nil {
+			t.Fatalf("cannot insert syncable: %v", err)
+		}
+
+		// Sequences below purge threshold were removed by purge
+		if height < purgedBeforeHeight {
+			continue
+		}
+		if err := db.db.Exec("INSERT INTO block_sequences (height, time) VALUES (?, ?)", height, heightTime).Error; err != nil {
+			t.Fatalf("cannot insert block sequence: %v", err)
+		}
+		err = db.db.Exec(`INSERT INTO validator_sequences (height, time, entity_uid, voting_power, total_shares, proposed, address)
+VALUES (?, ?, 'entity', 1, 1, false, 'address')`, height, heightTime).Error
+		if err != nil {
+			t.Fatalf("cannot insert validator sequence: %v", err)
+		}
+	}
+
+	sequencesStartHeight, err := db.Verify.FindFirstHeightSince(lastTime.Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sequencesStartHeight != purgedBeforeHeight {
+		t.Fatalf("unexpected sequences start height, want: %d; got: %d", purgedBeforeHeight, sequencesStartHeight)
+	}
+
+	rows, err := db.Verify.Verify(startHeight, endHeight, sequencesStartHeight, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, row := range rows {
+		if len(row.Heights) > 0 {
+			t.Errorf("purged heights should not be reported [table=%s] [issue=%s], got: %v", row.Table, row.Issue, row.Heights)
+		}
+	}
+
+	rows, err = db.Verify.Verify(startHeight, endHeight, 0, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectMissing := []int64{startHeight, startHeight + 1, startHeight + 2}
+	for _, row := range rows {
+		if row.Table == "syncables" {
+			continue
+		}
+		if !reflect.DeepEqual(row.Heights, expectMissing) {
+			t.Errorf("heights without sequences should be reported when checked from start height [table=%s], want: %v; got: %v", row.Table, expectMissing, row.Heights)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ WebhookDeliveriesStore = (*webhookDeliveriesStore)(nil)
+)
+
+type WebhookDeliveriesStore interface {
+	BaseStore
+
+	FindDue(time.Time, int64) ([]model.WebhookDelivery, error)
+	Enqueue(subscriptionID types.ID, lastSystemEventID int64, deliveries []model.WebhookDelivery, newLastSystemEventID int64) error
+	UpdateAttempt(*model.WebhookDelivery) error
+}
+
+func NewWebhookDeliveriesStore(db *gorm.DB) *webhookDeliveriesStore {
+	return &webhookDeliveriesStore{scoped(db, model.WebhookDelivery{})}
+}
+
+// webhookDeliveriesStore handles operations on webhook deliveries
+type webhookDeliveriesStore struct {
+	baseStore
+}
+
+// FindDue returns pending deliveries which are scheduled to be attempted before given time
+func (s webhookDeliveriesStore) FindDue(now time.Time, limit int64) ([]model.WebhookDelivery, error) {
+	var result []model.WebhookDelivery
+
+	err := s.db.
+		Preload("Subscription").
+		Preload("SystemEvent").
+		Where("status = ?", model.WebhookDeliveryStatusPending).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// Enqueue creates deliveries and moves system events cursor of subscription in single transaction.
+// ErrNotFound is returned when subscription was deleted or its cursor was already moved by other run.
+func (s webhookDeliveriesStore) Enqueue(subscriptionID types.ID, lastSystemEventID int64, deliveries []model.WebhookDelivery, newLastSystemEventID int64) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		res := tx.
+			Model(&model.WebhookSubscription{}).
+			Where("id = ? AND last_system_event_id = ?", subscriptionID, lastSystemEventID).
+			Update("last_system_event_id", newLastSystemEventID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		for i := range deliveries {
+			if err := tx.Create(&deliveries[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateAttempt saves result of delivery attempt. Only delivery columns are updated so loaded
+// subscription and system event are never written back.
+func (s webhookDeliveriesStore) UpdateAttempt(delivery *model.WebhookDelivery) error {
+	res := s.db.
+		Model(&model.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]interface{}{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"last_error":      delivery.LastError,
+			"delivered_at":    delivery.DeliveredAt,
+		})
+	if res.Error != nil {
+		return checkErr(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
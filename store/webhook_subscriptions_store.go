@@ -0,0 +1,86 @@
+package store
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	_ WebhookSubscriptionsStore = (*webhookSubscriptionsStore)(nil)
+)
+
+type WebhookSubscriptionsStore interface {
+	BaseStore
+
+	FindByID(int64) (*model.WebhookSubscription, error)
+	FindAll() ([]model.WebhookSubscription, error)
+	UpdateSettings(*model.WebhookSubscription) error
+	DeleteByID(int64) error
+}
+
+func NewWebhookSubscriptionsStore(db *gorm.DB) *webhookSubscriptionsStore {
+	return &webhookSubscriptionsStore{scoped(db, model.WebhookSubscription{})}
+}
+
+// webhookSubscriptionsStore handles operations on webhook subscriptions
+type webhookSubscriptionsStore struct {
+	baseStore
+}
+
+// FindByID returns webhook subscription by id
+func (s webhookSubscriptionsStore) FindByID(id int64) (*model.WebhookSubscription, error) {
+	result := &model.WebhookSubscription{}
+
+	err := findBy(s.db, result, "id", id)
+
+	return result, checkErr(err)
+}
+
+// FindAll returns all webhook subscriptions
+func (s webhookSubscriptionsStore) FindAll() ([]model.WebhookSubscription, error) {
+	var result []model.WebhookSubscription
+
+	err := s.db.
+		Order("id").
+		Find(&result).
+		Error
+
+	return result, checkErr(err)
+}
+
+// UpdateSettings updates columns editable by user. System events cursor is left untouched
+// since it is moved concurrently by delivery worker.
+func (s webhookSubscriptionsStore) UpdateSettings(subscription *model.WebhookSubscription) error {
+	res := s.db.
+		Model(&model.WebhookSubscription{}).
+		Where("id = ?", subscription.ID).
+		Updates(map[string]interface{}{
+			"url":    subscription.URL,
+			"actor":  subscription.Actor,
+			"kind":   subscription.Kind,
+			"secret": subscription.Secret,
+		})
+	if res.Error != nil {
+		return checkErr(res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByID deletes webhook subscription together with its deliveries
+func (s webhookSubscriptionsStore) DeleteByID(id int64) error {
+	tx := s.db.
+		Unscoped().
+		Where("id = ?", id).
+		Delete(&model.WebhookSubscription{})
+
+	if tx.Error != nil {
+		return checkErr(tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -9,5 +11,5 @@ type HttpHandler interface {
 }
 
 type WorkerHandler interface {
-	Handle()
+	Handle(context.Context)
 }
@@ -1,6 +1,8 @@
 package account
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
 )
@@ -17,8 +19,8 @@ func NewGetByAddressUseCase(db *store.Store, c *client.Client) *getByAddressUseC
 	}
 }
 
-func (uc *getByAddressUseCase) Execute(address string, height int64) (*DetailsView, error) {
-	rawAccount, err := uc.client.Account.GetByAddress(address, height)
+func (uc *getByAddressUseCase) Execute(ctx context.Context, address string, height int64) (*DetailsView, error) {
+	rawAccount, err := uc.client.Account.GetByAddress(ctx, address, height)
 	if err != nil {
 		return nil, err
 	}
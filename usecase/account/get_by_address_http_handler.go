@@ -43,7 +43,7 @@ func (h *getByAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, req.Height)
+	resp, err := h.getUseCase().Execute(c.Request.Context(), req.Address, req.Height)
 	if http.ShouldReturn(c, err) {
 		return
 	}
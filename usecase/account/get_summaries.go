@@ -1,6 +1,7 @@
 package account
 
 import (
+	"context"
 	"time"
 
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
@@ -25,7 +26,7 @@ type dataRow struct {
 	account  *accountpb.Account
 }
 
-func (uc *getSummariesUseCase) Execute(address string, start, end time.Time) (DailyBalanceViewResult, error) {
+func (uc *getSummariesUseCase) Execute(ctx context.Context, address string, start, end time.Time) (DailyBalanceViewResult, error) {
 	dayStart := start
 	var rows []dataRow
 
@@ -39,7 +40,7 @@ func (uc *getSummariesUseCase) Execute(address string, start, end time.Time) (Da
 			return DailyBalanceViewResult{}, err
 		}
 
-		resp, err := uc.client.Account.GetByAddress(address, syncable.Height)
+		resp, err := uc.client.Account.GetByAddress(ctx, address, syncable.Height)
 		if err != nil {
 			return DailyBalanceViewResult{}, err
 		}
@@ -29,28 +29,28 @@ func NewGetSummariesHttpHandler(db *store.Store, c *client.Client) *getSummaries
 	}
 }
 
-type uriParams struct {
+type UriParams struct {
 	Address string `uri:"address" binding:"required"`
 }
-type queryParams struct {
+type QueryParams struct {
 	Start time.Time `form:"start" binding:"required" time_format:"2006-01-02 15:04:05"`
 	End   time.Time `form:"end" binding:"required" time_format:"2006-01-02 15:04:05"`
 }
 
 func (h *getSummariesHttpHandler) Handle(c *gin.Context) {
-	var uri uriParams
+	var uri UriParams
 	if err := c.ShouldBindUri(&uri); err != nil {
 		http.BadRequest(c, errors.New("invalid address"))
 		return
 	}
 
-	var params queryParams
+	var params QueryParams
 	if err := c.ShouldBindQuery(&params); err != nil {
 		http.BadRequest(c, errors.New("invalid start and/or end params: must be in format \"2006-01-02 15:04:05\""))
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(uri.Address, params.Start, params.End)
+	resp, err := h.getUseCase().Execute(c.Request.Context(), uri.Address, params.Start, params.End)
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -0,0 +1,35 @@
+package account
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+type getTopUseCase struct {
+	db *store.Store
+}
+
+func NewGetTopUseCase(db *store.Store) *getTopUseCase {
+	return &getTopUseCase{
+		db: db,
+	}
+}
+
+func (uc *getTopUseCase) Execute(pageReq http.PageRequest) (*TopListView, error) {
+	accountAggs, err := uc.db.AccountAgg.FindTop(store.FindTopAccountsQuery{
+		By:     pageReq.Sort,
+		Desc:   pageReq.Desc(),
+		Limit:  pageReq.Limit,
+		Offset: pageReq.Offset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := uc.db.AccountAgg.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToTopListView(accountAggs, pageReq, total), nil
+}
@@ -0,0 +1,66 @@
+package account
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getTopHttpHandler)(nil)
+
+	TopByFields = []string{store.AccountAggTopByGeneral, store.AccountAggTopByEscrow, store.AccountAggTopByTotal}
+)
+
+type getTopHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getTopUseCase
+}
+
+func NewGetTopHttpHandler(db *store.Store, c *client.Client) *getTopHttpHandler {
+	return &getTopHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetTopRequest struct {
+	By string `form:"by" binding:"-"`
+	http.PageRequest
+}
+
+func (h *getTopHttpHandler) Handle(c *gin.Context) {
+	var req GetTopRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid by or/and pagination params"))
+		return
+	}
+
+	// by is kept as an alias of sort
+	if req.By != "" {
+		req.Sort = req.By
+	}
+	if err := req.PageRequest.Validate(TopByFields, store.AccountAggTopByTotal, http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.PageRequest)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getTopHttpHandler) getUseCase() *getTopUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetTopUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -1,10 +1,13 @@
 package account
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type DetailsView struct {
@@ -52,3 +55,43 @@ func toDailyBalanceViewResult(data []dataRow) DailyBalanceViewResult {
 	}
 	return DailyBalanceViewResult{Result: summaries}
 }
+
+type TopListItem struct {
+	PublicKey              string         `json:"public_key"`
+	RecentAtHeight         int64          `json:"recent_at_height"`
+	RecentAt               types.Time     `json:"recent_at"`
+	GeneralBalance         types.Quantity `json:"general_balance"`
+	EscrowActiveBalance    types.Quantity `json:"escrow_active_balance"`
+	EscrowDebondingBalance types.Quantity `json:"escrow_debonding_balance"`
+	TotalBalance           types.Quantity `json:"total_balance"`
+}
+
+type TopListView struct {
+	Items []TopListItem `json:"items"`
+
+	http.Pagination
+}
+
+func ToTopListView(accountAggs []model.AccountAgg, pageReq http.PageRequest, total int64) *TopListView {
+	items := []TopListItem{}
+	for _, agg := range accountAggs {
+		total := new(big.Int).Add(&agg.RecentGeneralBalance.Int, &agg.RecentEscrowActiveBalance.Int)
+		total = total.Add(total, &agg.RecentEscrowDebondingBalance.Int)
+
+		items = append(items, TopListItem{
+			PublicKey:              agg.PublicKey,
+			RecentAtHeight:         agg.RecentAtHeight,
+			RecentAt:               agg.RecentAt,
+			GeneralBalance:         agg.RecentGeneralBalance,
+			EscrowActiveBalance:    agg.RecentEscrowActiveBalance,
+			EscrowDebondingBalance: agg.RecentEscrowDebondingBalance,
+			TotalBalance:           types.NewQuantity(total),
+		})
+	}
+
+	return &TopListView{
+		Items: items,
+
+		Pagination: http.NewPagination(pageReq, total),
+	}
+}
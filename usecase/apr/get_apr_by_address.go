@@ -1,6 +1,7 @@
 package apr
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
@@ -27,7 +28,7 @@ func NewGetAprByAddressUseCase(db *store.Store, c *client.Client) *getAprByAddre
 	}
 }
 
-func (uc *getAprByAddressUseCase) Execute(address string, start, end *types.Time) (dA []DailyApr, err error) {
+func (uc *getAprByAddressUseCase) Execute(ctx context.Context, address string, start, end *types.Time) (dA []DailyApr, err error) {
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
 		return dA, err
@@ -55,7 +56,7 @@ func (uc *getAprByAddressUseCase) Execute(address string, start, end *types.Time
 
 		delegationLookupKey := fmt.Sprintf("%s.%d", r.Address, r.StartHeight)
 		if _, ok := delegationLookup[delegationLookupKey]; !ok {
-			delegation, err := uc.client.Delegation.GetByAddress(r.Address, r.StartHeight) // fetches shares
+			delegation, err := uc.client.Delegation.GetByAddress(ctx, r.Address, r.StartHeight) // fetches shares
 			if err != nil {
 				return dA, err
 			}
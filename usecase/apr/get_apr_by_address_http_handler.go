@@ -29,29 +29,29 @@ func NewGetAprByAddressHttpHandler(db *store.Store, c *client.Client) *getAprByA
 	}
 }
 
-type uriParams struct {
+type UriParams struct {
 	Address string `uri:"address" binding:"required"`
 }
 
-type queryParams struct {
+type QueryParams struct {
 	Start time.Time `form:"start" binding:"required" time_format:"2006-01-02"`
 	End   time.Time `form:"end" binding:"-" time_format:"2006-01-02"`
 }
 
 func (h *getAprByAddressHttpHandler) Handle(c *gin.Context) {
-	var req uriParams
+	var req UriParams
 	if err := c.ShouldBindUri(&req); err != nil {
 		http.BadRequest(c, errors.New("missing parameter"))
 		return
 	}
 
-	var params queryParams
+	var params QueryParams
 	if err := c.ShouldBindQuery(&params); err != nil {
 		http.BadRequest(c, errors.New("invalid start and/or end date"))
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, types.NewTimeFromTime(params.Start), types.NewTimeFromTime(params.End))
+	resp, err := h.getUseCase().Execute(c.Request.Context(), req.Address, types.NewTimeFromTime(params.Start), types.NewTimeFromTime(params.End))
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -1,6 +1,8 @@
 package block
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/pkg/errors"
@@ -18,7 +20,7 @@ func NewGetByHeightUseCase(db *store.Store, c *client.Client) *getByHeightUseCas
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(height *int64) (*DetailsView, error) {
+func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64) (*DetailsView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -35,7 +37,7 @@ func (uc *getByHeightUseCase) Execute(height *int64) (*DetailsView, error) {
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	res, err := uc.client.Block.GetByHeight(*height)
+	res, err := uc.client.Block.GetByHeight(ctx, *height)
 	if err != nil {
 		return nil, err
 	}
@@ -24,15 +24,20 @@ func (uc *getStatusUseCase) Execute(ctx  context.Context) (*DetailsView, error)
 		return nil, err
 	}
 
-	getHeadRes, err := uc.client.Chain.GetHead()
+	getHeadRes, err := uc.client.Chain.GetHead(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	getStatusRes, err := uc.client.Chain.GetStatus()
+	getStatusRes, err := uc.client.Chain.GetStatus(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToDetailsView(mostRecentSyncable, getHeadRes, getStatusRes), nil
+	totalAccounts, err := uc.db.AccountAgg.Count()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(mostRecentSyncable, getHeadRes, getStatusRes, totalAccounts), nil
 }
\ No newline at end of file
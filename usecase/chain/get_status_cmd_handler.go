@@ -55,6 +55,7 @@ func (h *GetStatusCmdHandler) Handle(ctx context.Context) {
 	fmt.Println("Last indexed time:", details.LastIndexedTime)
 	fmt.Println("Last indexed at:", details.LastIndexedAt)
 	fmt.Println("Lag behind head:", details.Lag)
+	fmt.Println("Total accounts:", details.TotalAccounts)
 	fmt.Println("")
 }
 
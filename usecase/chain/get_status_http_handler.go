@@ -27,7 +27,7 @@ func NewGetStatusHttpHandler(db *store.Store, client *client.Client) *getStatusH
 }
 
 func (h *getStatusHttpHandler) Handle(c *gin.Context) {
-	resp, err := h.getUseCase().Execute(c)
+	resp, err := h.getUseCase().Execute(c.Request.Context())
 	if http.ShouldReturn(c, err) {
 		return
 	}
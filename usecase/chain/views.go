@@ -25,9 +25,11 @@ type DetailsView struct {
 	LastIndexedTime   types.Time `json:"last_indexed_time"`
 	LastIndexedAt     types.Time `json:"last_indexed_at"`
 	Lag               int64      `json:"indexing_lag"`
+
+	TotalAccounts int64 `json:"total_accounts"`
 }
 
-func ToDetailsView(recentSyncable *model.Syncable, headResponse *chainpb.GetHeadResponse, statusResponse *chainpb.GetStatusResponse) *DetailsView {
+func ToDetailsView(recentSyncable *model.Syncable, headResponse *chainpb.GetHeadResponse, statusResponse *chainpb.GetStatusResponse, totalAccounts int64) *DetailsView {
 	return &DetailsView{
 		AppName:    config.AppName,
 		AppVersion: config.AppVersion,
@@ -46,5 +48,7 @@ func ToDetailsView(recentSyncable *model.Syncable, headResponse *chainpb.GetHead
 		LastIndexedTime:   recentSyncable.Time,
 		LastIndexedAt:     recentSyncable.CreatedAt,
 		Lag:               headResponse.Height - recentSyncable.Height,
+
+		TotalAccounts: totalAccounts,
 	}
 }
@@ -13,9 +13,15 @@ func NewCmdHandlers(cfg *config.Config, db *store.Store, c *client.Client) *CmdH
 	return &CmdHandlers{
 		GetStatus:          chain.NewGetStatusCmdHandler(db, c),
 		IndexerIndex:       indexing.NewIndexCmdHandler(cfg, db, c),
+		IndexerFollow:      indexing.NewFollowCmdHandler(cfg, db, c),
+		IndexerCacheWarm:   indexing.NewCacheWarmCmdHandler(cfg, db, c),
+		IndexerRecord:      indexing.NewRecordCmdHandler(cfg, db, c),
 		IndexerBackfill:    indexing.NewBackfillCmdHandler(cfg, db, c),
 		IndexerPurge:       indexing.NewPurgeCmdHandler(cfg, db, c),
 		IndexerReindex:     indexing.NewReindexCmdHandler(cfg, db, c),
+		IndexerRollback:    indexing.NewRollbackCmdHandler(cfg, db, c),
+		IndexerVerify:      indexing.NewVerifyCmdHandler(cfg, db, c),
+		IndexerAudit:       indexing.NewAuditCmdHandler(cfg, db, c),
 		IndexerSummarize:   indexing.NewSummarizeCmdHandler(cfg, db, c),
 		DecorateValidators: validator.NewDecorateCmdHandler(cfg, db, c),
 	}
@@ -24,9 +30,15 @@ func NewCmdHandlers(cfg *config.Config, db *store.Store, c *client.Client) *CmdH
 type CmdHandlers struct {
 	GetStatus          *chain.GetStatusCmdHandler
 	IndexerIndex       *indexing.IndexCmdHandler
+	IndexerFollow      *indexing.FollowCmdHandler
+	IndexerCacheWarm   *indexing.CacheWarmCmdHandler
+	IndexerRecord      *indexing.RecordCmdHandler
 	IndexerBackfill    *indexing.BackfillCmdHandler
 	IndexerPurge       *indexing.PurgeCmdHandler
 	IndexerReindex     *indexing.ReindexCmdHandler
+	IndexerRollback    *indexing.RollbackCmdHandler
+	IndexerVerify      *indexing.VerifyCmdHandler
+	IndexerAudit       *indexing.AuditCmdHandler
 	IndexerSummarize   *indexing.SummarizeCmdHandler
 	DecorateValidators *validator.DecorateCmdHandler
 }
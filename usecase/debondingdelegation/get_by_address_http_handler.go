@@ -30,6 +30,8 @@ func NewGetByAddressHttpHandler(db *store.Store, c *client.Client) *getByAddress
 type GetByAddressRequest struct {
 	Address string `uri:"address" binding:"required"`
 	Height  *int64 `form:"height" binding:"-"`
+
+	http.PageRequest
 }
 
 func (h *getByAddressHttpHandler) Handle(c *gin.Context) {
@@ -39,11 +41,15 @@ func (h *getByAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 	if err := c.ShouldBindQuery(&req); err != nil {
-		http.BadRequest(c, errors.New("invalid height"))
+		http.BadRequest(c, errors.New("invalid height, page, limit, sort or/and order"))
+		return
+	}
+	if err := req.PageRequest.ValidateOptional(ListSortFields, "shares", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, req.Height)
+	resp, err := h.getUseCase().Execute(c.Request.Context(), req.Address, req.Height, req.PageRequest)
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -1,8 +1,11 @@
 package debondingdelegation
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 	"github.com/pkg/errors"
 )
 
@@ -18,7 +21,7 @@ func NewGetByHeightUseCase(db *store.Store, c *client.Client) *getByHeightUseCas
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(height *int64) (*ListView, error) {
+func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64, pageReq http.PageRequest) (*ListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -35,10 +38,24 @@ func (uc *getByHeightUseCase) Execute(height *int64) (*ListView, error) {
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	res, err := uc.client.State.GetStakingByHeight(*height)
+	// Height without debonding delegation sequences, ie. purged or with no debonding delegations, is fetched from the chain
+	indexed, err := uc.db.DebondingDelegationSeq.ExistsByHeight(*height)
+	if err != nil {
+		return nil, err
+	}
+
+	if indexed {
+		debondingDelegationSeqs, err := uc.db.DebondingDelegationSeq.FindByHeight(*height)
+		if err != nil && err != store.ErrNotFound {
+			return nil, err
+		}
+		return ToListViewFromSeqs(debondingDelegationSeqs, pageReq), nil
+	}
+
+	res, err := uc.client.State.GetStakingByHeight(ctx, *height)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToListView(res.GetStaking().GetDebondingDelegations()), nil
+	return ToListView(res.GetStaking().GetDebondingDelegations(), pageReq), nil
 }
@@ -2,7 +2,13 @@ package debondingdelegation
 
 import (
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/debondingdelegation/debondingdelegationpb"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	ListSortFields = []string{"validator_uid", "delegator_uid", "shares", "debond_end"}
 )
 
 type ListItem struct {
@@ -14,9 +20,11 @@ type ListItem struct {
 
 type ListView struct {
 	Items []ListItem `json:"items"`
+
+	http.Pagination
 }
 
-func ToListView(rawDebondingDelegations map[string]*debondingdelegationpb.DebondingDelegationEntry) *ListView {
+func ToListView(rawDebondingDelegations map[string]*debondingdelegationpb.DebondingDelegationEntry, pageReq http.PageRequest) *ListView {
 	var items []ListItem
 	for validatorUID, delegationsMap := range rawDebondingDelegations {
 		for delegatorUID, infoArray := range delegationsMap.GetEntries() {
@@ -32,12 +40,10 @@ func ToListView(rawDebondingDelegations map[string]*debondingdelegationpb.Debond
 			}
 		}
 	}
-	return &ListView{
-		Items: items,
-	}
+	return toPaginatedListView(items, pageReq)
 }
 
-func ToListViewForAddress(rawDelegations map[string]*debondingdelegationpb.DebondingDelegationInnerEntry) *ListView {
+func ToListViewForAddress(rawDelegations map[string]*debondingdelegationpb.DebondingDelegationInnerEntry, pageReq http.PageRequest) *ListView {
 	var items []ListItem
 	for delegatorUID, infoArray := range rawDelegations {
 		for _, delegation := range infoArray.GetDebondingDelegations() {
@@ -51,7 +57,71 @@ func ToListViewForAddress(rawDelegations map[string]*debondingdelegationpb.Debon
 		}
 	}
 
+	return toPaginatedListView(items, pageReq)
+}
+
+func ToListViewFromSeqs(debondingDelegationSeqs []model.DebondingDelegationSeq, pageReq http.PageRequest) *ListView {
+	var items []ListItem
+	for _, seq := range debondingDelegationSeqs {
+		item := ListItem{
+			ValidatorUID: seq.ValidatorUID,
+			DelegatorUID: seq.DelegatorUID,
+			Shares:       seq.Shares,
+			DebondEnd:    seq.DebondEnd,
+		}
+
+		items = append(items, item)
+	}
+
+	return toPaginatedListView(items, pageReq)
+}
+
+func ToListViewForAddressFromSeqs(debondingDelegationSeqs []model.DebondingDelegationSeq, pageReq http.PageRequest) *ListView {
+	var items []ListItem
+	for _, seq := range debondingDelegationSeqs {
+		item := ListItem{
+			DelegatorUID: seq.DelegatorUID,
+			Shares:       seq.Shares,
+			DebondEnd:    seq.DebondEnd,
+		}
+
+		items = append(items, item)
+	}
+
+	return toPaginatedListView(items, pageReq)
+}
+
+// toPaginatedListView sorts debonding delegations and returns requested page. Map entries are returned in random order
+// so ties are broken by validator, delegator and debond end to keep pages stable.
+func toPaginatedListView(items []ListItem, pageReq http.PageRequest) *ListView {
+	pageReq.SortSlice(items, func(i, j int) bool {
+		switch pageReq.Sort {
+		case "shares":
+			if cmp := items[i].Shares.Cmp(items[j].Shares); cmp != 0 {
+				return cmp < 0
+			}
+		case "debond_end":
+			if items[i].DebondEnd != items[j].DebondEnd {
+				return items[i].DebondEnd < items[j].DebondEnd
+			}
+		case "delegator_uid":
+			if items[i].DelegatorUID != items[j].DelegatorUID {
+				return items[i].DelegatorUID < items[j].DelegatorUID
+			}
+		}
+		if items[i].ValidatorUID != items[j].ValidatorUID {
+			return items[i].ValidatorUID < items[j].ValidatorUID
+		}
+		if items[i].DelegatorUID != items[j].DelegatorUID {
+			return items[i].DelegatorUID < items[j].DelegatorUID
+		}
+		return items[i].DebondEnd < items[j].DebondEnd
+	})
+
+	start, end := pageReq.Bounds(len(items))
 	return &ListView{
-		Items: items,
+		Items: items[start:end],
+
+		Pagination: http.NewPagination(pageReq, int64(len(items))),
 	}
-}
\ No newline at end of file
+}
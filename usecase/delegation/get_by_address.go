@@ -1,8 +1,11 @@
 package delegation
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 	"github.com/pkg/errors"
 )
 
@@ -18,7 +21,7 @@ func NewGetByAddressUseCase(db *store.Store, c *client.Client) *getByAddressUseC
 	}
 }
 
-func (uc *getByAddressUseCase) Execute(address string, height *int64) (*ListView, error) {
+func (uc *getByAddressUseCase) Execute(ctx context.Context, address string, height *int64, pageReq http.PageRequest) (*ListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -35,10 +38,24 @@ func (uc *getByAddressUseCase) Execute(address string, height *int64) (*ListView
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	res, err := uc.client.Delegation.GetByAddress(address, *height)
+	// Delegations are served from database for indexed heights
+	indexed, err := uc.db.DelegationSeq.ExistsByHeight(*height)
+	if err != nil {
+		return nil, err
+	}
+
+	if indexed {
+		delegationSeqs, err := uc.db.DelegationSeq.FindByHeightAndValidatorUID(*height, address)
+		if err != nil && err != store.ErrNotFound {
+			return nil, err
+		}
+		return ToListViewForAddressFromSeqs(delegationSeqs, pageReq), nil
+	}
+
+	res, err := uc.client.Delegation.GetByAddress(ctx, address, *height)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToListViewForAddress(res.GetDelegations()), nil
+	return ToListViewForAddress(res.GetDelegations(), pageReq), nil
 }
@@ -1,10 +1,13 @@
 package delegation
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type getByHeightUseCase struct {
@@ -19,7 +22,7 @@ func NewGetByHeightUseCase(db *store.Store, c *client.Client) *getByHeightUseCas
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(height *int64) (*ListView, error) {
+func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64, pageReq http.PageRequest) (*ListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -36,10 +39,24 @@ func (uc *getByHeightUseCase) Execute(height *int64) (*ListView, error) {
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	res, err := uc.client.State.GetStakingByHeight(*height)
+	// Delegations are served from database for indexed heights
+	indexed, err := uc.db.DelegationSeq.ExistsByHeight(*height)
+	if err != nil {
+		return nil, err
+	}
+
+	if indexed {
+		delegationSeqs, err := uc.db.DelegationSeq.FindByHeight(*height)
+		if err != nil && err != store.ErrNotFound {
+			return nil, err
+		}
+		return ToListViewFromSeqs(delegationSeqs, pageReq), nil
+	}
+
+	res, err := uc.client.State.GetStakingByHeight(ctx, *height)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToListView(res.GetStaking().GetDelegations()), nil
+	return ToListView(res.GetStaking().GetDelegations(), pageReq), nil
 }
@@ -29,16 +29,22 @@ func NewGetByHeightHttpHandler(db *store.Store, c *client.Client) *getByHeightHt
 
 type Request struct {
 	Height *int64 `form:"height" binding:"-"`
+
+	http.PageRequest
 }
 
 func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 	var req Request
 	if err := c.ShouldBindQuery(&req); err != nil {
-		http.BadRequest(c, errors.New("invalid height"))
+		http.BadRequest(c, errors.New("invalid height, page, limit, sort or/and order"))
+		return
+	}
+	if err := req.PageRequest.ValidateOptional(ListSortFields, "shares", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Height)
+	resp, err := h.getUseCase().Execute(c.Request.Context(), req.Height, req.PageRequest)
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -2,7 +2,13 @@ package delegation
 
 import (
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	ListSortFields = []string{"validator_uid", "delegator_uid", "shares"}
 )
 
 type ListItem struct {
@@ -13,9 +19,11 @@ type ListItem struct {
 
 type ListView struct {
 	Items []ListItem `json:"items"`
+
+	http.Pagination
 }
 
-func ToListView(rawDelegations map[string]*delegationpb.DelegationEntry) *ListView {
+func ToListView(rawDelegations map[string]*delegationpb.DelegationEntry, pageReq http.PageRequest) *ListView {
 	var items []ListItem
 	for validatorUID, delegationsMap := range rawDelegations {
 		for delegatorUID, info := range delegationsMap.GetEntries() {
@@ -29,12 +37,10 @@ func ToListView(rawDelegations map[string]*delegationpb.DelegationEntry) *ListVi
 		}
 	}
 
-	return &ListView{
-		Items: items,
-	}
+	return toPaginatedListView(items, pageReq)
 }
 
-func ToListViewForAddress(rawDelegations map[string]*delegationpb.Delegation) *ListView {
+func ToListViewForAddress(rawDelegations map[string]*delegationpb.Delegation, pageReq http.PageRequest) *ListView {
 	var items []ListItem
 	for delegatorUID, info := range rawDelegations {
 		item := ListItem{
@@ -45,7 +51,62 @@ func ToListViewForAddress(rawDelegations map[string]*delegationpb.Delegation) *L
 		items = append(items, item)
 	}
 
+	return toPaginatedListView(items, pageReq)
+}
+
+func ToListViewFromSeqs(delegationSeqs []model.DelegationSeq, pageReq http.PageRequest) *ListView {
+	var items []ListItem
+	for _, seq := range delegationSeqs {
+		item := ListItem{
+			ValidatorUID: seq.ValidatorUID,
+			DelegatorUID: seq.DelegatorUID,
+			Shares:       seq.Shares,
+		}
+
+		items = append(items, item)
+	}
+
+	return toPaginatedListView(items, pageReq)
+}
+
+func ToListViewForAddressFromSeqs(delegationSeqs []model.DelegationSeq, pageReq http.PageRequest) *ListView {
+	var items []ListItem
+	for _, seq := range delegationSeqs {
+		item := ListItem{
+			DelegatorUID: seq.DelegatorUID,
+			Shares:       seq.Shares,
+		}
+
+		items = append(items, item)
+	}
+
+	return toPaginatedListView(items, pageReq)
+}
+
+// toPaginatedListView sorts delegations and returns requested page. Map entries are returned in random order
+// so ties are broken by validator and delegator to keep pages stable.
+func toPaginatedListView(items []ListItem, pageReq http.PageRequest) *ListView {
+	pageReq.SortSlice(items, func(i, j int) bool {
+		switch pageReq.Sort {
+		case "shares":
+			if cmp := items[i].Shares.Cmp(items[j].Shares); cmp != 0 {
+				return cmp < 0
+			}
+		case "delegator_uid":
+			if items[i].DelegatorUID != items[j].DelegatorUID {
+				return items[i].DelegatorUID < items[j].DelegatorUID
+			}
+		}
+		if items[i].ValidatorUID != items[j].ValidatorUID {
+			return items[i].ValidatorUID < items[j].ValidatorUID
+		}
+		return items[i].DelegatorUID < items[j].DelegatorUID
+	})
+
+	start, end := pageReq.Bounds(len(items))
 	return &ListView{
-		Items: items,
+		Items: items[start:end],
+
+		Pagination: http.NewPagination(pageReq, int64(len(items))),
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,29 @@
+package escrowevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+type getForAddressUseCase struct {
+	db *store.Store
+}
+
+func NewGetForAddressUseCase(db *store.Store) *getForAddressUseCase {
+	return &getForAddressUseCase{
+		db: db,
+	}
+}
+
+func (uc *getForAddressUseCase) Execute(address string, pageReq http.PageRequest, query store.FindEscrowEventsByAddressQuery) (*ListView, error) {
+	query.Order = pageReq.OrderBy(pageReq.Sort)
+	query.Limit = pageReq.Limit
+	query.Offset = pageReq.Offset()
+
+	events, total, err := uc.db.EscrowEventSeq.FindByAddress(address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(events, pageReq, total), nil
+}
@@ -0,0 +1,78 @@
+package escrowevent
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getForAddressHttpHandler)(nil)
+)
+
+type getForAddressHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getForAddressUseCase
+}
+
+func NewGetForAddressHttpHandler(db *store.Store, c *client.Client) *getForAddressHttpHandler {
+	return &getForAddressHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetForAddressRequest struct {
+	Address     string                 `uri:"address" binding:"required"`
+	Kind        *model.EscrowEventKind `form:"kind" binding:"-"`
+	StartHeight *int64                 `form:"start_height" binding:"-"`
+	EndHeight   *int64                 `form:"end_height" binding:"-"`
+	StartTime   time.Time              `form:"start_time" binding:"-" time_format:"2006-01-02 15:04:05"`
+	EndTime     time.Time              `form:"end_time" binding:"-" time_format:"2006-01-02 15:04:05"`
+
+	http.PageRequest
+}
+
+func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
+	var req GetForAddressRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid kind, page, limit, sort, order, height or/and time range: time must be in format \"2006-01-02 15:04:05\""))
+		return
+	}
+	if err := req.PageRequest.Validate(ListSortFields, "height", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, req.PageRequest, store.FindEscrowEventsByAddressQuery{
+		Kind:        req.Kind,
+		StartHeight: req.StartHeight,
+		EndHeight:   req.EndHeight,
+		StartTime:   types.NewTimeFromTime(req.StartTime),
+		EndTime:     types.NewTimeFromTime(req.EndTime),
+	})
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getForAddressHttpHandler) getUseCase() *getForAddressUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetForAddressUseCase(h.db)
+	}
+	return h.useCase
+}
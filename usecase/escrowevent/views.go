@@ -0,0 +1,51 @@
+package escrowevent
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	// ListSortFields are sortable columns of escrow events
+	ListSortFields = []string{"height", "amount"}
+)
+
+type ListItem struct {
+	Height int64          `json:"height"`
+	Time   types.Time     `json:"time"`
+	Kind   string         `json:"kind"`
+	Owner  string         `json:"owner"`
+	Escrow string         `json:"escrow"`
+	Amount types.Quantity `json:"amount"`
+	Shares types.Quantity `json:"shares"`
+}
+
+type ListView struct {
+	Items []ListItem `json:"items"`
+
+	http.Pagination
+}
+
+func ToListView(events []model.EscrowEventSeq, pageReq http.PageRequest, total int64) *ListView {
+	var items []ListItem
+	for _, m := range events {
+		item := ListItem{
+			Height: m.Height,
+			Time:   m.Time,
+			Kind:   m.Kind.String(),
+			Owner:  m.Owner,
+			Escrow: m.Escrow,
+			Amount: m.Amount,
+			Shares: m.Shares,
+		}
+
+		items = append(items, item)
+	}
+
+	return &ListView{
+		Items: items,
+
+		Pagination: http.NewPagination(pageReq, total),
+	}
+}
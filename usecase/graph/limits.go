@@ -0,0 +1,175 @@
+package graph
+
+import (
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrMaxDepthExceeded      = errors.New("query exceeds maximum depth")
+	ErrMaxComplexityExceeded = errors.New("query exceeds maximum complexity")
+)
+
+// Limits holds maximum depth and complexity allowed for a query
+type Limits struct {
+	MaxDepth      int64
+	MaxComplexity int64
+}
+
+// QueryCost is depth and complexity of parsed query
+type QueryCost struct {
+	Depth      int64
+	Complexity int64
+}
+
+// Check returns error if cost is over given limits
+func (c QueryCost) Check(limits Limits) error {
+	if limits.MaxDepth > 0 && c.Depth > limits.MaxDepth {
+		return errors.Wrapf(ErrMaxDepthExceeded, "depth %d is over limit of %d", c.Depth, limits.MaxDepth)
+	}
+	if limits.MaxComplexity > 0 && c.Complexity > limits.MaxComplexity {
+		return errors.Wrapf(ErrMaxComplexityExceeded, "complexity %d is over limit of %d", c.Complexity, limits.MaxComplexity)
+	}
+	return nil
+}
+
+// CalculateCost calculates depth and complexity of all operations in document.
+//
+// Every field adds 1 to complexity. Complexity of fields selected on a list is multiplied
+// by the list limit argument, or by the default list limit when field has no limit argument.
+func CalculateCost(schema graphql.Schema, doc *ast.Document, variables map[string]interface{}) QueryCost {
+	c := &costCalculator{
+		variables: variables,
+		fragments: map[string]*ast.FragmentDefinition{},
+		visiting:  map[string]bool{},
+	}
+
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			c.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	var cost QueryCost
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		var root *graphql.Object
+		if op.Operation == ast.OperationTypeQuery {
+			root = schema.QueryType()
+		}
+
+		depth, complexity := c.selectionSet(root, op.SelectionSet)
+		if depth > cost.Depth {
+			cost.Depth = depth
+		}
+		cost.Complexity += complexity
+	}
+	return cost
+}
+
+type costCalculator struct {
+	variables map[string]interface{}
+	fragments map[string]*ast.FragmentDefinition
+	visiting  map[string]bool
+}
+
+func (c *costCalculator) selectionSet(parent *graphql.Object, set *ast.SelectionSet) (int64, int64) {
+	if set == nil {
+		return 0, 0
+	}
+
+	var depth, complexity int64
+	for _, selection := range set.Selections {
+		var d, cx int64
+		switch s := selection.(type) {
+		case *ast.Field:
+			d, cx = c.field(parent, s)
+		case *ast.InlineFragment:
+			d, cx = c.selectionSet(parent, s.SelectionSet)
+		case *ast.FragmentSpread:
+			fragment, ok := c.fragments[s.Name.Value]
+			// Cyclic spreads are rejected later by query validation
+			if !ok || c.visiting[s.Name.Value] {
+				continue
+			}
+			c.visiting[s.Name.Value] = true
+			d, cx = c.selectionSet(parent, fragment.SelectionSet)
+			c.visiting[s.Name.Value] = false
+		}
+
+		if d > depth {
+			depth = d
+		}
+		complexity += cx
+	}
+	return depth, complexity
+}
+
+func (c *costCalculator) field(parent *graphql.Object, field *ast.Field) (int64, int64) {
+	var child *graphql.Object
+	var isList bool
+	if parent != nil {
+		if def, ok := parent.Fields()[field.Name.Value]; ok {
+			child, isList = unwrapType(def.Type)
+		}
+	}
+
+	depth, complexity := c.selectionSet(child, field.SelectionSet)
+	if isList {
+		complexity *= c.listLimit(field)
+	}
+	return depth + 1, complexity + 1
+}
+
+// listLimit returns value of limit argument of list field
+func (c *costCalculator) listLimit(field *ast.Field) int64 {
+	for _, arg := range field.Arguments {
+		if arg.Name.Value != "limit" {
+			continue
+		}
+
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			if limit, err := strconv.ParseInt(v.Value, 10, 64); err == nil && limit > 0 {
+				return limit
+			}
+		case *ast.Variable:
+			switch limit := c.variables[v.Name.Value].(type) {
+			case float64:
+				if limit > 0 {
+					return int64(limit)
+				}
+			case int:
+				if limit > 0 {
+					return int64(limit)
+				}
+			}
+		}
+	}
+	return defaultListLimit
+}
+
+// unwrapType returns object type of field and whether field is a list
+func unwrapType(t graphql.Output) (*graphql.Object, bool) {
+	var isList bool
+	for {
+		switch v := t.(type) {
+		case *graphql.NonNull:
+			t = v.OfType
+		case *graphql.List:
+			isList = true
+			t = v.OfType
+		case *graphql.Object:
+			return v, isList
+		default:
+			return nil, isList
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+func TestCalculateCost(t *testing.T) {
+	schema, err := NewSchema(&store.Store{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		description        string
+		query              string
+		variables          map[string]interface{}
+		expectedDepth      int64
+		expectedComplexity int64
+	}{
+		{
+			description:        "counts scalar fields",
+			query:              `{ block { height time } }`,
+			expectedDepth:      2,
+			expectedComplexity: 3,
+		},
+		{
+			description:        "multiplies list fields by default limit",
+			query:              `{ validators { address } }`,
+			expectedDepth:      2,
+			expectedComplexity: 1 + defaultListLimit,
+		},
+		{
+			description:        "multiplies list fields by limit argument",
+			query:              `{ validator(address: "a") { sequences(limit: 5) { height proposed } } }`,
+			expectedDepth:      3,
+			expectedComplexity: 1 + 1 + 5*2,
+		},
+		{
+			description:        "multiplies list fields by limit variable",
+			query:              `query($limit: Int) { validators(limit: $limit) { sequences(limit: 2) { height } } }`,
+			variables:          map[string]interface{}{"limit": float64(3)},
+			expectedDepth:      3,
+			expectedComplexity: 1 + 3*(1+2*1),
+		},
+		{
+			description:        "includes fragments",
+			query:              `{ validator(address: "a") { ...fields ... on Validator { uptime } } } fragment fields on Validator { address entityName }`,
+			expectedDepth:      2,
+			expectedComplexity: 4,
+		},
+		{
+			description:        "ignores cyclic fragments",
+			query:              `{ validator(address: "a") { ...fields } } fragment fields on Validator { address ...fields }`,
+			expectedDepth:      2,
+			expectedComplexity: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			cost := CalculateCost(schema, doc, tt.variables)
+			if cost.Depth != tt.expectedDepth {
+				t.Errorf("unexpected depth, want %v; got %v", tt.expectedDepth, cost.Depth)
+			}
+			if cost.Complexity != tt.expectedComplexity {
+				t.Errorf("unexpected complexity, want %v; got %v", tt.expectedComplexity, cost.Complexity)
+			}
+		})
+	}
+}
+
+func TestQueryCost_Check(t *testing.T) {
+	limits := Limits{MaxDepth: 3, MaxComplexity: 100}
+
+	tests := []struct {
+		description string
+		cost        QueryCost
+		expectErr   bool
+	}{
+		{"returns nil when within limits", QueryCost{Depth: 3, Complexity: 100}, false},
+		{"returns error when depth is over limit", QueryCost{Depth: 4, Complexity: 10}, true},
+		{"returns error when complexity is over limit", QueryCost{Depth: 2, Complexity: 101}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.cost.Check(limits)
+			if tt.expectErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
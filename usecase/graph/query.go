@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/pkg/errors"
+)
+
+type queryUseCase struct {
+	cfg    *config.Config
+	schema graphql.Schema
+}
+
+func NewQueryUseCase(cfg *config.Config, db *store.Store) (*queryUseCase, error) {
+	schema, err := NewSchema(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryUseCase{
+		cfg:    cfg,
+		schema: schema,
+	}, nil
+}
+
+func (uc *queryUseCase) Execute(ctx context.Context, req QueryRequest) (*graphql.Result, error) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid query")
+	}
+
+	validation := graphql.ValidateDocument(&uc.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, nil
+	}
+
+	cost := CalculateCost(uc.schema, doc, req.Variables)
+	if err := cost.Check(Limits{MaxDepth: uc.cfg.GraphQLMaxDepth, MaxComplexity: uc.cfg.GraphQLMaxComplexity}); err != nil {
+		return nil, err
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        uc.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	}), nil
+}
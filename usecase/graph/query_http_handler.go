@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*queryHttpHandler)(nil)
+)
+
+type queryHttpHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *queryUseCase
+}
+
+func NewQueryHttpHandler(cfg *config.Config, db *store.Store, c *client.Client) *queryHttpHandler {
+	return &queryHttpHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type QueryRequest struct {
+	Query         string                 `json:"query" binding:"required"`
+	Variables     map[string]interface{} `json:"variables" binding:"-"`
+	OperationName string                 `json:"operationName" binding:"-"`
+}
+
+func (h *queryHttpHandler) Handle(c *gin.Context) {
+	var req QueryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid query, variables or/and operationName"))
+		return
+	}
+
+	uc, err := h.getUseCase()
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	resp, err := uc.Execute(c.Request.Context(), req)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *queryHttpHandler) getUseCase() (*queryUseCase, error) {
+	if h.useCase == nil {
+		uc, err := NewQueryUseCase(h.cfg, h.db)
+		if err != nil {
+			return nil, err
+		}
+		h.useCase = uc
+	}
+	return h.useCase, nil
+}
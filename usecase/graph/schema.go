@@ -0,0 +1,501 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/graphql-go/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultListLimit = 20
+)
+
+var (
+	ErrInvalidInterval = errors.New("invalid interval: interval must be hour or day")
+)
+
+// NewSchema creates GraphQL schema with resolvers backed by given store
+func NewSchema(db *store.Store) (graphql.Schema, error) {
+	r := &resolver{db: db}
+
+	limitArg := &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultListLimit}
+
+	systemEventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SystemEvent",
+		Fields: graphql.Fields{
+			"height": &graphql.Field{Type: graphql.Int},
+			"time":   &graphql.Field{Type: graphql.DateTime},
+			"actor":  &graphql.Field{Type: graphql.String},
+			"kind":   &graphql.Field{Type: graphql.String},
+			"data":   &graphql.Field{Type: graphql.String, Description: "JSON encoded event data"},
+		},
+	})
+
+	balanceEventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BalanceEvent",
+		Fields: graphql.Fields{
+			"height":        &graphql.Field{Type: graphql.Int},
+			"address":       &graphql.Field{Type: graphql.String},
+			"escrowAddress": &graphql.Field{Type: graphql.String},
+			"amount":        &graphql.Field{Type: graphql.String},
+			"kind":          &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	validatorSequenceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ValidatorSequence",
+		Fields: graphql.Fields{
+			"height":              &graphql.Field{Type: graphql.Int},
+			"time":                &graphql.Field{Type: graphql.DateTime},
+			"entityUid":           &graphql.Field{Type: graphql.String},
+			"address":             &graphql.Field{Type: graphql.String},
+			"proposed":            &graphql.Field{Type: graphql.Boolean},
+			"votingPower":         &graphql.Field{Type: graphql.Int},
+			"totalShares":         &graphql.Field{Type: graphql.String},
+			"activeEscrowBalance": &graphql.Field{Type: graphql.String},
+			"commission":          &graphql.Field{Type: graphql.String},
+			"rewards":             &graphql.Field{Type: graphql.String},
+			"precommitValidated":  &graphql.Field{Type: graphql.Boolean},
+		},
+	})
+
+	validatorSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ValidatorSummary",
+		Fields: graphql.Fields{
+			"timeInterval":           &graphql.Field{Type: graphql.String},
+			"timeBucket":             &graphql.Field{Type: graphql.DateTime},
+			"address":                &graphql.Field{Type: graphql.String},
+			"votingPowerAvg":         &graphql.Field{Type: graphql.Float},
+			"votingPowerMax":         &graphql.Field{Type: graphql.Float},
+			"votingPowerMin":         &graphql.Field{Type: graphql.Float},
+			"totalSharesAvg":         &graphql.Field{Type: graphql.String},
+			"totalSharesMax":         &graphql.Field{Type: graphql.String},
+			"totalSharesMin":         &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceAvg": &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceMax": &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceMin": &graphql.Field{Type: graphql.String},
+			"commissionAvg":          &graphql.Field{Type: graphql.String},
+			"commissionMax":          &graphql.Field{Type: graphql.String},
+			"commissionMin":          &graphql.Field{Type: graphql.String},
+			"validatedSum":           &graphql.Field{Type: graphql.Int},
+			"notValidatedSum":        &graphql.Field{Type: graphql.Int},
+			"proposedSum":            &graphql.Field{Type: graphql.Int},
+			"uptimeAvg":              &graphql.Field{Type: graphql.Float},
+		},
+	})
+
+	validatorsSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ValidatorsSummary",
+		Fields: graphql.Fields{
+			"timeBucket":             &graphql.Field{Type: graphql.String},
+			"votingPowerAvg":         &graphql.Field{Type: graphql.Float},
+			"votingPowerMax":         &graphql.Field{Type: graphql.Float},
+			"votingPowerMin":         &graphql.Field{Type: graphql.Float},
+			"totalSharesAvg":         &graphql.Field{Type: graphql.String},
+			"totalSharesMax":         &graphql.Field{Type: graphql.String},
+			"totalSharesMin":         &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceAvg": &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceMax": &graphql.Field{Type: graphql.String},
+			"activeEscrowBalanceMin": &graphql.Field{Type: graphql.String},
+			"commissionAvg":          &graphql.Field{Type: graphql.String},
+			"commissionMax":          &graphql.Field{Type: graphql.String},
+			"commissionMin":          &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"height":            &graphql.Field{Type: graphql.Int},
+			"time":              &graphql.Field{Type: graphql.DateTime},
+			"transactionsCount": &graphql.Field{Type: graphql.Int},
+		},
+	})
+
+	blockSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BlockSummary",
+		Fields: graphql.Fields{
+			"timeInterval": &graphql.Field{Type: graphql.String},
+			"timeBucket":   &graphql.Field{Type: graphql.DateTime},
+			"count":        &graphql.Field{Type: graphql.Int},
+			"blockTimeAvg": &graphql.Field{Type: graphql.Float},
+		},
+	})
+
+	balanceSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "BalanceSummary",
+		Fields: graphql.Fields{
+			"timeInterval":    &graphql.Field{Type: graphql.String},
+			"timeBucket":      &graphql.Field{Type: graphql.DateTime},
+			"startHeight":     &graphql.Field{Type: graphql.Int},
+			"address":         &graphql.Field{Type: graphql.String},
+			"escrowAddress":   &graphql.Field{Type: graphql.String},
+			"totalRewards":    &graphql.Field{Type: graphql.String},
+			"totalCommission": &graphql.Field{Type: graphql.String},
+			"totalSlashed":    &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	validatorType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Validator",
+		Fields: graphql.Fields{
+			"address":                   &graphql.Field{Type: graphql.String},
+			"entityUid":                 &graphql.Field{Type: graphql.String},
+			"entityName":                &graphql.Field{Type: graphql.String},
+			"logoUrl":                   &graphql.Field{Type: graphql.String},
+			"startedAtHeight":           &graphql.Field{Type: graphql.Int},
+			"startedAt":                 &graphql.Field{Type: graphql.DateTime},
+			"recentAtHeight":            &graphql.Field{Type: graphql.Int},
+			"recentAt":                  &graphql.Field{Type: graphql.DateTime},
+			"recentTendermintAddress":   &graphql.Field{Type: graphql.String},
+			"recentVotingPower":         &graphql.Field{Type: graphql.Int},
+			"recentTotalShares":         &graphql.Field{Type: graphql.String},
+			"recentActiveEscrowBalance": &graphql.Field{Type: graphql.String},
+			"recentCommission":          &graphql.Field{Type: graphql.String},
+			"recentRewards":             &graphql.Field{Type: graphql.String},
+			"recentAsValidatorHeight":   &graphql.Field{Type: graphql.Int},
+			"recentProposedHeight":      &graphql.Field{Type: graphql.Int},
+			"accumulatedProposedCount":  &graphql.Field{Type: graphql.Int},
+			"uptime":                    &graphql.Field{Type: graphql.Float},
+			"sequences": &graphql.Field{
+				Type:    graphql.NewList(validatorSequenceType),
+				Args:    graphql.FieldConfigArgument{"limit": limitArg},
+				Resolve: r.validatorSequences,
+			},
+			"systemEvents": &graphql.Field{
+				Type: graphql.NewList(systemEventType),
+				Args: graphql.FieldConfigArgument{
+					"kind":  &graphql.ArgumentConfig{Type: graphql.String},
+					"after": &graphql.ArgumentConfig{Type: graphql.Int},
+					"limit": limitArg,
+				},
+				Resolve: r.validatorSystemEvents,
+			},
+			"balanceEvents": &graphql.Field{
+				Type: graphql.NewList(balanceEventType),
+				Args: graphql.FieldConfigArgument{
+					"kind":  &graphql.ArgumentConfig{Type: graphql.String},
+					"limit": limitArg,
+				},
+				Resolve: r.validatorBalanceEvents,
+			},
+			"summary": &graphql.Field{
+				Type: graphql.NewList(validatorSummaryType),
+				Args: graphql.FieldConfigArgument{
+					"interval": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"period":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.validatorSummary,
+			},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"validator": &graphql.Field{
+				Type: validatorType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.validator,
+			},
+			"validators": &graphql.Field{
+				Type: graphql.NewList(validatorType),
+				Args: graphql.FieldConfigArgument{
+					"minHeight": &graphql.ArgumentConfig{Type: graphql.Int},
+					"limit":     limitArg,
+				},
+				Resolve: r.validators,
+			},
+			"validatorSequences": &graphql.Field{
+				Type: graphql.NewList(validatorSequenceType),
+				Args: graphql.FieldConfigArgument{
+					"height": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.validatorSequencesByHeight,
+			},
+			"validatorsSummary": &graphql.Field{
+				Type: graphql.NewList(validatorsSummaryType),
+				Args: graphql.FieldConfigArgument{
+					"interval": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"period":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.validatorsSummary,
+			},
+			"block": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"height": &graphql.ArgumentConfig{Type: graphql.Int},
+				},
+				Resolve: r.block,
+			},
+			"blockSummary": &graphql.Field{
+				Type: graphql.NewList(blockSummaryType),
+				Args: graphql.FieldConfigArgument{
+					"interval": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"period":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: r.blockSummary,
+			},
+			"systemEvents": &graphql.Field{
+				Type: graphql.NewList(systemEventType),
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"kind":    &graphql.ArgumentConfig{Type: graphql.String},
+					"after":   &graphql.ArgumentConfig{Type: graphql.Int},
+					"limit":   limitArg,
+				},
+				Resolve: r.systemEvents,
+			},
+			"balanceEvents": &graphql.Field{
+				Type: graphql.NewList(balanceEventType),
+				Args: graphql.FieldConfigArgument{
+					"address":       &graphql.ArgumentConfig{Type: graphql.String},
+					"escrowAddress": &graphql.ArgumentConfig{Type: graphql.String},
+					"kind":          &graphql.ArgumentConfig{Type: graphql.String},
+					"limit":         limitArg,
+				},
+				Resolve: r.balanceEvents,
+			},
+			"balanceSummary": &graphql.Field{
+				Type: graphql.NewList(balanceSummaryType),
+				Args: graphql.FieldConfigArgument{
+					"address":  &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"interval": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"start":    &graphql.ArgumentConfig{Type: graphql.DateTime},
+					"end":      &graphql.ArgumentConfig{Type: graphql.DateTime},
+				},
+				Resolve: r.balanceSummary,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// resolver resolves schema fields using store
+type resolver struct {
+	db *store.Store
+}
+
+func (r *resolver) validator(p graphql.ResolveParams) (interface{}, error) {
+	m, err := r.db.ValidatorAgg.FindByAddress(p.Args["address"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return ToValidator(*m), nil
+}
+
+func (r *resolver) validators(p graphql.ResolveParams) (interface{}, error) {
+	height, ok := p.Args["minHeight"].(int)
+	if !ok {
+		mostRecentSynced, err := r.db.Syncables.FindMostRecent()
+		if err != nil {
+			return nil, err
+		}
+		height = int(mostRecentSynced.Height)
+	}
+
+	ms, err := r.db.ValidatorAgg.GetAllForHeightGreaterThan(int64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	limit := getLimit(p.Args)
+	if int64(len(ms)) > limit {
+		ms = ms[:limit]
+	}
+	return ToValidators(ms), nil
+}
+
+func (r *resolver) validatorSequencesByHeight(p graphql.ResolveParams) (interface{}, error) {
+	ms, err := r.db.ValidatorSeq.FindByHeight(int64(p.Args["height"].(int)))
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorSequences(ms), nil
+}
+
+func (r *resolver) validatorSequences(p graphql.ResolveParams) (interface{}, error) {
+	v := p.Source.(*Validator)
+
+	ms, err := r.db.ValidatorSeq.FindLastByAddress(v.Address, getLimit(p.Args))
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorSequences(ms), nil
+}
+
+func (r *resolver) validatorSystemEvents(p graphql.ResolveParams) (interface{}, error) {
+	v := p.Source.(*Validator)
+	return r.findSystemEvents(v.Address, p.Args)
+}
+
+func (r *resolver) systemEvents(p graphql.ResolveParams) (interface{}, error) {
+	return r.findSystemEvents(p.Args["address"].(string), p.Args)
+}
+
+func (r *resolver) findSystemEvents(address string, args map[string]interface{}) (interface{}, error) {
+	query := store.FindSystemEventByActorQuery{
+		Order: "height DESC",
+		Limit: getLimit(args),
+	}
+	if kind, ok := args["kind"].(string); ok {
+		k := model.SystemEventKind(kind)
+		query.Kind = &k
+	}
+	if after, ok := args["after"].(int); ok {
+		h := int64(after)
+		query.MinHeight = &h
+	}
+
+	ms, _, err := r.db.SystemEvents.FindByActor(address, query)
+	if err != nil {
+		return nil, err
+	}
+	return ToSystemEvents(ms), nil
+}
+
+func (r *resolver) validatorBalanceEvents(p graphql.ResolveParams) (interface{}, error) {
+	v := p.Source.(*Validator)
+
+	query := store.FindRecentBalanceEventsQuery{
+		EscrowAddress: &v.Address,
+		Limit:         getLimit(p.Args),
+	}
+	if kind, ok := p.Args["kind"].(string); ok {
+		k := model.BalanceEventKind(kind)
+		query.Kind = &k
+	}
+	return r.findBalanceEvents(query)
+}
+
+func (r *resolver) balanceEvents(p graphql.ResolveParams) (interface{}, error) {
+	query := store.FindRecentBalanceEventsQuery{
+		Limit: getLimit(p.Args),
+	}
+	if address, ok := p.Args["address"].(string); ok {
+		query.Address = &address
+	}
+	if escrowAddress, ok := p.Args["escrowAddress"].(string); ok {
+		query.EscrowAddress = &escrowAddress
+	}
+	if query.Address == nil && query.EscrowAddress == nil {
+		return nil, errors.New("address or escrowAddress is required")
+	}
+	if kind, ok := p.Args["kind"].(string); ok {
+		k := model.BalanceEventKind(kind)
+		query.Kind = &k
+	}
+	return r.findBalanceEvents(query)
+}
+
+func (r *resolver) findBalanceEvents(query store.FindRecentBalanceEventsQuery) (interface{}, error) {
+	ms, err := r.db.BalanceEvents.FindRecent(query)
+	if err != nil {
+		return nil, err
+	}
+	return ToBalanceEvents(ms), nil
+}
+
+func (r *resolver) validatorSummary(p graphql.ResolveParams) (interface{}, error) {
+	v := p.Source.(*Validator)
+
+	interval, err := getInterval(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := r.db.ValidatorSummary.FindSummaryByAddress(v.Address, interval, p.Args["period"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorSummaries(ms), nil
+}
+
+func (r *resolver) validatorsSummary(p graphql.ResolveParams) (interface{}, error) {
+	interval, err := getInterval(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.ValidatorSummary.FindSummary(interval, p.Args["period"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return ToValidatorsSummaries(rows), nil
+}
+
+func (r *resolver) block(p graphql.ResolveParams) (interface{}, error) {
+	var m *model.BlockSeq
+	var err error
+	if height, ok := p.Args["height"].(int); ok {
+		m, err = r.db.BlockSeq.FindByHeight(int64(height))
+	} else {
+		m, err = r.db.BlockSeq.FindMostRecent()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ToBlock(*m), nil
+}
+
+func (r *resolver) blockSummary(p graphql.ResolveParams) (interface{}, error) {
+	interval, err := getInterval(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := r.db.BlockSummary.FindSummary(interval, p.Args["period"].(string))
+	if err != nil {
+		return nil, err
+	}
+	return ToBlockSummaries(ms), nil
+}
+
+func (r *resolver) balanceSummary(p graphql.ResolveParams) (interface{}, error) {
+	interval, err := getInterval(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	var start, end types.Time
+	if t, ok := p.Args["start"].(time.Time); ok {
+		start = *types.NewTimeFromTime(t)
+	}
+	if t, ok := p.Args["end"].(time.Time); ok {
+		end = *types.NewTimeFromTime(t)
+	}
+
+	ms, err := r.db.BalanceSummary.GetSummariesByInterval(interval, p.Args["address"].(string), &start, &end)
+	if err != nil {
+		return nil, err
+	}
+	return ToBalanceSummaries(ms), nil
+}
+
+// getLimit returns limit argument capped at max page limit
+func getLimit(args map[string]interface{}) int64 {
+	limit, ok := args["limit"].(int)
+	if !ok || limit <= 0 {
+		return defaultListLimit
+	}
+	if limit > http.MaxPageLimit {
+		return http.MaxPageLimit
+	}
+	return int64(limit)
+}
+
+func getInterval(args map[string]interface{}) (types.SummaryInterval, error) {
+	interval := types.SummaryInterval(args["interval"].(string))
+	if !interval.Valid() {
+		return "", ErrInvalidInterval
+	}
+	return interval, nil
+}
@@ -0,0 +1,305 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type Validator struct {
+	Address                   string    `graphql:"address"`
+	EntityUID                 string    `graphql:"entityUid"`
+	EntityName                string    `graphql:"entityName"`
+	LogoURL                   string    `graphql:"logoUrl"`
+	StartedAtHeight           int64     `graphql:"startedAtHeight"`
+	StartedAt                 time.Time `graphql:"startedAt"`
+	RecentAtHeight            int64     `graphql:"recentAtHeight"`
+	RecentAt                  time.Time `graphql:"recentAt"`
+	RecentTendermintAddress   string    `graphql:"recentTendermintAddress"`
+	RecentVotingPower         int64     `graphql:"recentVotingPower"`
+	RecentTotalShares         string    `graphql:"recentTotalShares"`
+	RecentActiveEscrowBalance string    `graphql:"recentActiveEscrowBalance"`
+	RecentCommission          string    `graphql:"recentCommission"`
+	RecentRewards             string    `graphql:"recentRewards"`
+	RecentAsValidatorHeight   int64     `graphql:"recentAsValidatorHeight"`
+	RecentProposedHeight      int64     `graphql:"recentProposedHeight"`
+	AccumulatedProposedCount  int64     `graphql:"accumulatedProposedCount"`
+	Uptime                    float64   `graphql:"uptime"`
+}
+
+func ToValidator(m model.ValidatorAgg) *Validator {
+	v := &Validator{
+		Address:                   m.Address,
+		EntityUID:                 m.EntityUID,
+		EntityName:                m.EntityName,
+		LogoURL:                   m.LogoURL,
+		RecentTendermintAddress:   m.RecentTendermintAddress,
+		RecentVotingPower:         m.RecentVotingPower,
+		RecentTotalShares:         m.RecentTotalShares.String(),
+		RecentActiveEscrowBalance: m.RecentActiveEscrowBalance.String(),
+		RecentCommission:          m.RecentCommission.String(),
+		RecentRewards:             m.RecentRewards.String(),
+		RecentAsValidatorHeight:   m.RecentAsValidatorHeight,
+		RecentProposedHeight:      m.RecentProposedHeight,
+		AccumulatedProposedCount:  m.AccumulatedProposedCount,
+	}
+
+	if m.Aggregate != nil {
+		v.StartedAtHeight = m.StartedAtHeight
+		v.StartedAt = m.StartedAt.Time
+		v.RecentAtHeight = m.RecentAtHeight
+		v.RecentAt = m.RecentAt.Time
+	}
+
+	if m.AccumulatedUptimeCount > 0 {
+		v.Uptime = float64(m.AccumulatedUptime) / float64(m.AccumulatedUptimeCount)
+	}
+
+	return v
+}
+
+func ToValidators(ms []model.ValidatorAgg) []*Validator {
+	var items []*Validator
+	for _, m := range ms {
+		items = append(items, ToValidator(m))
+	}
+	return items
+}
+
+type ValidatorSequence struct {
+	Height              int64     `graphql:"height"`
+	Time                time.Time `graphql:"time"`
+	EntityUID           string    `graphql:"entityUid"`
+	Address             string    `graphql:"address"`
+	Proposed            bool      `graphql:"proposed"`
+	VotingPower         int64     `graphql:"votingPower"`
+	TotalShares         string    `graphql:"totalShares"`
+	ActiveEscrowBalance string    `graphql:"activeEscrowBalance"`
+	Commission          string    `graphql:"commission"`
+	Rewards             string    `graphql:"rewards"`
+	PrecommitValidated  *bool     `graphql:"precommitValidated"`
+}
+
+func ToValidatorSequences(ms []model.ValidatorSeq) []*ValidatorSequence {
+	var items []*ValidatorSequence
+	for _, m := range ms {
+		items = append(items, &ValidatorSequence{
+			Height:              m.Height,
+			Time:                m.Time.Time,
+			EntityUID:           m.EntityUID,
+			Address:             m.Address,
+			Proposed:            m.Proposed,
+			VotingPower:         m.VotingPower,
+			TotalShares:         m.TotalShares.String(),
+			ActiveEscrowBalance: m.ActiveEscrowBalance.String(),
+			Commission:          m.Commission.String(),
+			Rewards:             m.Rewards.String(),
+			PrecommitValidated:  m.PrecommitValidated,
+		})
+	}
+	return items
+}
+
+type Block struct {
+	Height            int64     `graphql:"height"`
+	Time              time.Time `graphql:"time"`
+	TransactionsCount int64     `graphql:"transactionsCount"`
+}
+
+func ToBlock(m model.BlockSeq) *Block {
+	return &Block{
+		Height:            m.Height,
+		Time:              m.Time.Time,
+		TransactionsCount: m.TransactionsCount,
+	}
+}
+
+type SystemEvent struct {
+	Height int64     `graphql:"height"`
+	Time   time.Time `graphql:"time"`
+	Actor  string    `graphql:"actor"`
+	Kind   string    `graphql:"kind"`
+	Data   string    `graphql:"data"`
+}
+
+func ToSystemEvents(ms []model.SystemEvent) []*SystemEvent {
+	var items []*SystemEvent
+	for _, m := range ms {
+		items = append(items, &SystemEvent{
+			Height: m.Height,
+			Time:   m.Time.Time,
+			Actor:  m.Actor,
+			Kind:   m.Kind.String(),
+			Data:   string(m.Data.RawMessage),
+		})
+	}
+	return items
+}
+
+type BalanceEvent struct {
+	Height        int64  `graphql:"height"`
+	Address       string `graphql:"address"`
+	EscrowAddress string `graphql:"escrowAddress"`
+	Amount        string `graphql:"amount"`
+	Kind          string `graphql:"kind"`
+}
+
+func ToBalanceEvents(ms []model.BalanceEvent) []*BalanceEvent {
+	var items []*BalanceEvent
+	for _, m := range ms {
+		items = append(items, &BalanceEvent{
+			Height:        m.Height,
+			Address:       m.Address,
+			EscrowAddress: m.EscrowAddress,
+			Amount:        m.Amount.String(),
+			Kind:          m.Kind.String(),
+		})
+	}
+	return items
+}
+
+type BlockSummary struct {
+	TimeInterval string    `graphql:"timeInterval"`
+	TimeBucket   time.Time `graphql:"timeBucket"`
+	Count        int64     `graphql:"count"`
+	BlockTimeAvg float64   `graphql:"blockTimeAvg"`
+}
+
+func ToBlockSummaries(ms []model.BlockSummary) []*BlockSummary {
+	var items []*BlockSummary
+	for _, m := range ms {
+		item := &BlockSummary{
+			Count:        m.Count,
+			BlockTimeAvg: m.BlockTimeAvg,
+		}
+		if m.Summary != nil {
+			item.TimeInterval = string(m.TimeInterval)
+			item.TimeBucket = m.TimeBucket.Time
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+type ValidatorSummary struct {
+	TimeInterval           string    `graphql:"timeInterval"`
+	TimeBucket             time.Time `graphql:"timeBucket"`
+	Address                string    `graphql:"address"`
+	VotingPowerAvg         float64   `graphql:"votingPowerAvg"`
+	VotingPowerMax         float64   `graphql:"votingPowerMax"`
+	VotingPowerMin         float64   `graphql:"votingPowerMin"`
+	TotalSharesAvg         string    `graphql:"totalSharesAvg"`
+	TotalSharesMax         string    `graphql:"totalSharesMax"`
+	TotalSharesMin         string    `graphql:"totalSharesMin"`
+	ActiveEscrowBalanceAvg string    `graphql:"activeEscrowBalanceAvg"`
+	ActiveEscrowBalanceMax string    `graphql:"activeEscrowBalanceMax"`
+	ActiveEscrowBalanceMin string    `graphql:"activeEscrowBalanceMin"`
+	CommissionAvg          string    `graphql:"commissionAvg"`
+	CommissionMax          string    `graphql:"commissionMax"`
+	CommissionMin          string    `graphql:"commissionMin"`
+	ValidatedSum           int64     `graphql:"validatedSum"`
+	NotValidatedSum        int64     `graphql:"notValidatedSum"`
+	ProposedSum            int64     `graphql:"proposedSum"`
+	UptimeAvg              float64   `graphql:"uptimeAvg"`
+}
+
+func ToValidatorSummaries(ms []model.ValidatorSummary) []*ValidatorSummary {
+	var items []*ValidatorSummary
+	for _, m := range ms {
+		item := &ValidatorSummary{
+			Address:                m.Address,
+			VotingPowerAvg:         m.VotingPowerAvg,
+			VotingPowerMax:         m.VotingPowerMax,
+			VotingPowerMin:         m.VotingPowerMin,
+			TotalSharesAvg:         m.TotalSharesAvg.String(),
+			TotalSharesMax:         m.TotalSharesMax.String(),
+			TotalSharesMin:         m.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: m.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: m.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: m.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          m.CommissionAvg.String(),
+			CommissionMax:          m.CommissionMax.String(),
+			CommissionMin:          m.CommissionMin.String(),
+			ValidatedSum:           m.ValidatedSum,
+			NotValidatedSum:        m.NotValidatedSum,
+			ProposedSum:            m.ProposedSum,
+			UptimeAvg:              m.UptimeAvg,
+		}
+		if m.Summary != nil {
+			item.TimeInterval = string(m.TimeInterval)
+			item.TimeBucket = m.TimeBucket.Time
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+type ValidatorsSummary struct {
+	TimeBucket             string  `graphql:"timeBucket"`
+	VotingPowerAvg         float64 `graphql:"votingPowerAvg"`
+	VotingPowerMax         float64 `graphql:"votingPowerMax"`
+	VotingPowerMin         float64 `graphql:"votingPowerMin"`
+	TotalSharesAvg         string  `graphql:"totalSharesAvg"`
+	TotalSharesMax         string  `graphql:"totalSharesMax"`
+	TotalSharesMin         string  `graphql:"totalSharesMin"`
+	ActiveEscrowBalanceAvg string  `graphql:"activeEscrowBalanceAvg"`
+	ActiveEscrowBalanceMax string  `graphql:"activeEscrowBalanceMax"`
+	ActiveEscrowBalanceMin string  `graphql:"activeEscrowBalanceMin"`
+	CommissionAvg          string  `graphql:"commissionAvg"`
+	CommissionMax          string  `graphql:"commissionMax"`
+	CommissionMin          string  `graphql:"commissionMin"`
+}
+
+func ToValidatorsSummaries(rows []store.ValidatorSummaryRow) []*ValidatorsSummary {
+	var items []*ValidatorsSummary
+	for _, r := range rows {
+		items = append(items, &ValidatorsSummary{
+			TimeBucket:             r.TimeBucket,
+			VotingPowerAvg:         r.VotingPowerAvg,
+			VotingPowerMax:         r.VotingPowerMax,
+			VotingPowerMin:         r.VotingPowerMin,
+			TotalSharesAvg:         r.TotalSharesAvg.String(),
+			TotalSharesMax:         r.TotalSharesMax.String(),
+			TotalSharesMin:         r.TotalSharesMin.String(),
+			ActiveEscrowBalanceAvg: r.ActiveEscrowBalanceAvg.String(),
+			ActiveEscrowBalanceMax: r.ActiveEscrowBalanceMax.String(),
+			ActiveEscrowBalanceMin: r.ActiveEscrowBalanceMin.String(),
+			CommissionAvg:          r.CommissionAvg.String(),
+			CommissionMax:          r.CommissionMax.String(),
+			CommissionMin:          r.CommissionMin.String(),
+		})
+	}
+	return items
+}
+
+type BalanceSummary struct {
+	TimeInterval    string    `graphql:"timeInterval"`
+	TimeBucket      time.Time `graphql:"timeBucket"`
+	StartHeight     int64     `graphql:"startHeight"`
+	Address         string    `graphql:"address"`
+	EscrowAddress   string    `graphql:"escrowAddress"`
+	TotalRewards    string    `graphql:"totalRewards"`
+	TotalCommission string    `graphql:"totalCommission"`
+	TotalSlashed    string    `graphql:"totalSlashed"`
+}
+
+func ToBalanceSummaries(ms []model.BalanceSummary) []*BalanceSummary {
+	var items []*BalanceSummary
+	for _, m := range ms {
+		item := &BalanceSummary{
+			StartHeight:     m.StartHeight,
+			Address:         m.Address,
+			EscrowAddress:   m.EscrowAddress,
+			TotalRewards:    m.TotalRewards.String(),
+			TotalCommission: m.TotalCommission.String(),
+			TotalSlashed:    m.TotalSlashed.String(),
+		}
+		if m.Summary != nil {
+			item.TimeInterval = string(m.TimeInterval)
+			item.TimeBucket = m.TimeBucket.Time
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,395 @@
+package http
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const (
+	OpenAPIVersion = "3.0.3"
+
+	addressPattern = "^oasis1[qpzry9x8gf2tvdw0s3jn54khce6mua7l]{40}$"
+
+	adminSecurityScheme = "adminToken"
+)
+
+var (
+	// paramSchemas holds constraints of params shared by many endpoints
+	paramSchemas = map[string]Schema{
+		"address":      {Type: "string", Pattern: addressPattern},
+		"height":       {Type: "integer", Format: "int64", Minimum: int64Ptr(1)},
+		"start_height": {Type: "integer", Format: "int64", Minimum: int64Ptr(1)},
+		"end_height":   {Type: "integer", Format: "int64", Minimum: int64Ptr(1)},
+		"after":        {Type: "integer", Format: "int64", Minimum: int64Ptr(0)},
+		"id":           {Type: "integer", Format: "int64", Minimum: int64Ptr(1)},
+		"interval":     {Type: "string", Enum: []string{string(types.IntervalHourly), string(types.IntervalDaily)}},
+	}
+
+	// queryParamSchemas holds constraints of pagination params, path params of the same name are not constrained by them
+	queryParamSchemas = map[string]Schema{
+		"page":  {Type: "integer", Format: "int64", Minimum: int64Ptr(1)},
+		"limit": {Type: "integer", Format: "int64", Minimum: int64Ptr(1), Maximum: int64Ptr(MaxPageLimit)},
+		"order": {Type: "string", Enum: []string{OrderAsc, OrderDesc}},
+	}
+
+	timeType     = reflect.TypeOf(time.Time{})
+	typesTime    = reflect.TypeOf(types.Time{})
+	bigIntType   = reflect.TypeOf(big.Int{})
+	quantityType = reflect.TypeOf(types.Quantity{})
+	jsonbType    = reflect.TypeOf(types.Jsonb{})
+	rawJSONType  = reflect.TypeOf(json.RawMessage{})
+
+	digitRegexp = regexp.MustCompile(`[0-9]`)
+)
+
+// Route describes API route, its params and response used to generate OpenAPI document
+type Route struct {
+	Name     string
+	Method   string
+	Path     string
+	Summary  string
+	Handler  types.HttpHandler
+	Params   []interface{}
+	Body     interface{}
+	Response interface{}
+
+	// Admin routes require admin token passed in Authorization header
+	Admin bool
+}
+
+// OpenAPI is OpenAPI 3 document describing the API
+type OpenAPI struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem holds operations of path by lowercase HTTP method
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *int64             `json:"minimum,omitempty"`
+	Maximum              *int64             `json:"maximum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+}
+
+// NewOpenAPI generates OpenAPI document for given routes
+func NewOpenAPI(title string, version string, routes []Route) *OpenAPI {
+	doc := &OpenAPI{
+		OpenAPI:    OpenAPIVersion,
+		Info:       Info{Title: title, Version: version},
+		Paths:      map[string]PathItem{},
+		Components: Components{Schemas: map[string]*Schema{}},
+	}
+
+	for _, route := range routes {
+		path := ToOpenAPIPath(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = doc.operation(route)
+	}
+	return doc
+}
+
+// ToOpenAPIPath converts gin path params from :param to {param} format
+func ToOpenAPIPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, ":") {
+			parts[i] = "{" + part[1:] + "}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func (doc *OpenAPI) operation(route Route) *Operation {
+	op := &Operation{
+		OperationID: route.Name,
+		Summary:     route.Summary,
+		Parameters:  Parameters(route.Params...),
+		Responses: map[string]Response{
+			"400": doc.errorResponse("Invalid request"),
+			"404": doc.errorResponse("Record not found"),
+			"500": doc.errorResponse("Server error"),
+		},
+	}
+
+	if route.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: doc.schema(reflect.TypeOf(route.Body))}},
+		}
+	}
+
+	ok := Response{Description: "OK"}
+	if route.Response != nil {
+		ok.Content = map[string]MediaType{"application/json": {Schema: doc.schema(reflect.TypeOf(route.Response))}}
+	}
+	op.Responses["200"] = ok
+
+	if route.Admin {
+		if doc.Components.SecuritySchemes == nil {
+			doc.Components.SecuritySchemes = map[string]SecurityScheme{}
+		}
+		doc.Components.SecuritySchemes[adminSecurityScheme] = SecurityScheme{Type: "http", Scheme: "bearer"}
+
+		op.Security = []map[string][]string{{adminSecurityScheme: {}}}
+		op.Responses["401"] = doc.errorResponse("Invalid admin token")
+		op.Responses["403"] = doc.errorResponse("Admin API disabled")
+	}
+
+	return op
+}
+
+func (doc *OpenAPI) errorResponse(description string) Response {
+	return Response{
+		Description: description,
+		Content: map[string]MediaType{"application/json": {Schema: &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"status": {Type: "integer"},
+				"error":  {Type: "string"},
+			},
+		}}},
+	}
+}
+
+// Parameters returns path and query params of given request structs, based on their uri and form tags
+func Parameters(reqs ...interface{}) []Parameter {
+	var params []Parameter
+	for _, req := range reqs {
+		params = append(params, structParameters(reflect.TypeOf(req))...)
+	}
+	return params
+}
+
+func structParameters(t reflect.Type) []Parameter {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var params []Parameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			params = append(params, structParameters(field.Type)...)
+			continue
+		}
+
+		var param Parameter
+		if name := field.Tag.Get("uri"); name != "" {
+			param = Parameter{Name: name, In: "path", Required: true}
+		} else if name := field.Tag.Get("form"); name != "" && field.Tag.Get("json") == "" {
+			param = Parameter{Name: name, In: "query", Required: strings.Contains(field.Tag.Get("binding"), "required")}
+		} else {
+			continue
+		}
+
+		schema := paramSchema(field)
+		param.Schema = &schema
+		params = append(params, param)
+	}
+	return params
+}
+
+func paramSchema(field reflect.StructField) Schema {
+	name := field.Tag.Get("uri")
+	if name == "" {
+		name = field.Tag.Get("form")
+		if schema, ok := queryParamSchemas[name]; ok {
+			return schema
+		}
+	}
+	if schema, ok := paramSchemas[name]; ok {
+		return schema
+	}
+
+	t := field.Type
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		layout := field.Tag.Get("time_format")
+		if layout == "" {
+			return Schema{Type: "string", Format: "date-time"}
+		}
+		return Schema{Type: "string", Pattern: layoutPattern(layout)}
+	}
+	return *primitiveSchema(t)
+}
+
+// layoutPattern returns pattern matching time in given layout
+func layoutPattern(layout string) string {
+	return "^" + digitRegexp.ReplaceAllString(regexp.QuoteMeta(layout), `\d`) + "$"
+}
+
+// schema returns schema for given type, structs are stored as reusable components
+func (doc *OpenAPI) schema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType, typesTime:
+		return &Schema{Type: "string", Format: "date-time"}
+	case bigIntType, quantityType:
+		return &Schema{Type: "integer"}
+	case jsonbType, rawJSONType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: doc.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: doc.schema(t.Elem())}
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return doc.structSchema(t)
+		}
+
+		name := componentName(t)
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			// Reserve name first to handle recursive types
+			doc.Components.Schemas[name] = &Schema{}
+			*doc.Components.Schemas[name] = *doc.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+	return primitiveSchema(t)
+}
+
+// structSchema returns object schema with properties following encoding/json rules
+func (doc *OpenAPI) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.TrimSpace(strings.Split(tag, ",")[0])
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range doc.structSchema(ft).Properties {
+					schema.Properties[k] = v
+				}
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = doc.schema(field.Type)
+	}
+	return schema
+}
+
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	if pkg == "" {
+		return t.Name()
+	}
+	return pkg + "." + t.Name()
+}
+
+func primitiveSchema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	}
+	return &Schema{}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
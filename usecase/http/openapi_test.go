@@ -0,0 +1,190 @@
+package http
+
+import (
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type testRequest struct {
+	Address string    `uri:"address" binding:"required"`
+	Kind    *string   `form:"kind" binding:"-"`
+	Start   time.Time `form:"start" binding:"required" time_format:"2006-01-02"`
+
+	PageRequest
+}
+
+type testItem struct {
+	Height int64 `json:"height"`
+	Hidden int64 `json:"-"`
+}
+
+type testView struct {
+	Items []testItem `json:"items"`
+
+	Pagination
+}
+
+func TestParameters(t *testing.T) {
+	params := Parameters(testRequest{})
+
+	expected := []Parameter{
+		{Name: "address", In: "path", Required: true},
+		{Name: "kind", In: "query"},
+		{Name: "start", In: "query", Required: true},
+		{Name: "page", In: "query"},
+		{Name: "limit", In: "query"},
+		{Name: "sort", In: "query"},
+		{Name: "order", In: "query"},
+	}
+
+	if len(params) != len(expected) {
+		t.Fatalf("unexpected params count, want %v; got %v", len(expected), len(params))
+	}
+	for i, param := range params {
+		if param.Name != expected[i].Name || param.In != expected[i].In || param.Required != expected[i].Required {
+			t.Errorf("unexpected param, want %+v; got %+v", expected[i], param)
+		}
+	}
+
+	if params[0].Schema.Pattern != addressPattern {
+		t.Errorf("unexpected address pattern, want %v; got %v", addressPattern, params[0].Schema.Pattern)
+	}
+	if params[2].Schema.Pattern != `^\d\d\d\d-\d\d-\d\d$` {
+		t.Errorf("unexpected start pattern, want %v; got %v", `^\d\d\d\d-\d\d-\d\d$`, params[2].Schema.Pattern)
+	}
+}
+
+func TestParameters_PathLimit(t *testing.T) {
+	params := Parameters(struct {
+		Limit int64 `uri:"limit" binding:"required"`
+	}{})
+
+	if len(params) != 1 || params[0].In != "path" {
+		t.Fatalf("unexpected params: %+v", params)
+	}
+	if params[0].Schema.Maximum != nil {
+		t.Errorf("path limit should not be constrained by page limit, got maximum: %v", *params[0].Schema.Maximum)
+	}
+}
+
+func TestNewOpenAPI(t *testing.T) {
+	doc := NewOpenAPI("test", "1.0.0", []Route{
+		{Name: "GetItems", Method: "GET", Path: "/items/:address", Params: []interface{}{testRequest{}}, Response: testView{}},
+	})
+
+	op, ok := doc.Paths["/items/{address}"]["get"]
+	if !ok {
+		t.Fatalf("operation not found in paths: %v", doc.Paths)
+	}
+	if op.Responses["200"].Content["application/json"].Schema.Ref != "#/components/schemas/http.testView" {
+		t.Errorf("unexpected response schema: %+v", op.Responses["200"].Content["application/json"].Schema)
+	}
+
+	view := doc.Components.Schemas["http.testView"]
+	for _, property := range []string{"items", "page", "limit", "total", "sort", "order"} {
+		if _, ok := view.Properties[property]; !ok {
+			t.Errorf("property %s not found in view schema", property)
+		}
+	}
+
+	item := doc.Components.Schemas["http.testItem"]
+	if len(item.Properties) != 1 || item.Properties["height"].Type != "integer" {
+		t.Errorf("unexpected item schema: %+v", item)
+	}
+}
+
+func TestSchema_Validate(t *testing.T) {
+	address := "oasis1qzzd6khm3acqskpxlk9vd5044cmmcce78y5l6000"
+
+	tests := []struct {
+		description string
+		name        string
+		value       string
+		expectErr   bool
+	}{
+		{"accepts valid height", "height", "100", false},
+		{"rejects non integer height", "height", "abc", true},
+		{"rejects height below minimum", "height", "0", true},
+		{"rejects limit above maximum", "limit", "1001", true},
+		{"accepts valid order", "order", OrderAsc, false},
+		{"rejects unknown order", "order", "up", true},
+		{"accepts valid address", "address", address, false},
+		{"rejects invalid address", "address", "oasis1abc", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			schema, ok := paramSchemas[tt.name]
+			if !ok {
+				schema = queryParamSchemas[tt.name]
+			}
+
+			var pattern *regexp.Regexp
+			if schema.Pattern != "" {
+				pattern = regexp.MustCompile(schema.Pattern)
+			}
+
+			err := schema.Validate(tt.value, pattern)
+			if tt.expectErr && err == nil {
+				t.Errorf("expected error, got nil")
+			}
+			if !tt.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.GET("/items/:address", ValidateParams(Parameters(testRequest{})), func(c *gin.Context) {
+		c.Status(nethttp.StatusOK)
+	})
+
+	tests := []struct {
+		description    string
+		url            string
+		expectedStatus int
+		expectedError  string
+	}{
+		{"passes valid request", "/items/oasis1qzzd6khm3acqskpxlk9vd5044cmmcce78y5l6000?start=2020-01-02", nethttp.StatusOK, ""},
+		{"rejects invalid address", "/items/abc?start=2020-01-02", nethttp.StatusBadRequest, "invalid address: must match " + addressPattern},
+		{"rejects missing required param", "/items/oasis1qzzd6khm3acqskpxlk9vd5044cmmcce78y5l6000", nethttp.StatusBadRequest, "missing start"},
+		{"rejects invalid page", "/items/oasis1qzzd6khm3acqskpxlk9vd5044cmmcce78y5l6000?start=2020-01-02&page=-1", nethttp.StatusBadRequest, "invalid page: must be greater than or equal to 1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(nethttp.MethodGet, tt.url, nil))
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("unexpected status, want %v; got %v", tt.expectedStatus, w.Code)
+			}
+			if tt.expectedError == "" {
+				return
+			}
+
+			var resp struct {
+				Status int    `json:"status"`
+				Error  string `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Error != tt.expectedError {
+				t.Errorf("unexpected error message, want %q; got %q", tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// PageRequest holds pagination and sorting params shared by list endpoints
+type PageRequest struct {
+	Page  int64  `form:"page" binding:"-"`
+	Limit int64  `form:"limit" binding:"-"`
+	Sort  string `form:"sort" binding:"-"`
+	Order string `form:"order" binding:"-"`
+
+	unpaginated bool
+}
+
+// ValidateOptional works like Validate but keeps all items on a single page when neither page nor limit is given.
+// It is used by endpoints which were returning full lists before pagination was introduced.
+func (r *PageRequest) ValidateOptional(sortFields []string, defaultSort string, defaultOrder string) error {
+	r.unpaginated = r.Page == 0 && r.Limit == 0
+	return r.Validate(sortFields, defaultSort, defaultOrder)
+}
+
+// Validate sets defaults for missing params and checks if request is valid for given sortable fields
+func (r *PageRequest) Validate(sortFields []string, defaultSort string, defaultOrder string) error {
+	if r.Page == 0 {
+		r.Page = 1
+	}
+	if r.Limit == 0 {
+		r.Limit = DefaultPageLimit
+	}
+	if r.Sort == "" {
+		r.Sort = defaultSort
+	}
+	if r.Order == "" {
+		r.Order = defaultOrder
+	}
+
+	if r.Page < 0 {
+		return errors.New("invalid page: page must be greater than 0")
+	}
+	if r.Limit < 0 || r.Limit > MaxPageLimit {
+		return errors.Errorf("invalid limit: limit must be between 1 and %d", MaxPageLimit)
+	}
+	if r.Order != OrderAsc && r.Order != OrderDesc {
+		return errors.Errorf("invalid order: order must be %s or %s", OrderAsc, OrderDesc)
+	}
+
+	for _, field := range sortFields {
+		if r.Sort == field {
+			return nil
+		}
+	}
+	return errors.Errorf("invalid sort: sort must be one of %v", sortFields)
+}
+
+// Offset returns number of items to skip
+func (r PageRequest) Offset() int64 {
+	return (r.Page - 1) * r.Limit
+}
+
+// QueryLimit returns limit of database query, -1 disables limit when all items are kept on a single page
+func (r PageRequest) QueryLimit() int64 {
+	if r.unpaginated {
+		return -1
+	}
+	return r.Limit
+}
+
+// Desc returns true if items should be sorted in descending order
+func (r PageRequest) Desc() bool {
+	return r.Order == OrderDesc
+}
+
+// OrderBy returns SQL order clause for given column
+func (r PageRequest) OrderBy(column string) string {
+	return fmt.Sprintf("%s %s", column, r.Order)
+}
+
+// SortSlice sorts slice in place using less function for ascending order
+func (r PageRequest) SortSlice(slice interface{}, less func(i, j int) bool) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		if r.Desc() {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+}
+
+// Bounds returns start and end indexes of current page in list of given length
+func (r PageRequest) Bounds(length int) (int, int) {
+	if r.unpaginated {
+		return 0, length
+	}
+
+	start := r.Offset()
+	if start > int64(length) {
+		start = int64(length)
+	}
+
+	end := start + r.Limit
+	if end > int64(length) {
+		end = int64(length)
+	}
+	return int(start), int(end)
+}
+
+// Pagination is included in paginated list views
+type Pagination struct {
+	Page  int64  `json:"page"`
+	Limit int64  `json:"limit"`
+	Total int64  `json:"total"`
+	Sort  string `json:"sort,omitempty"`
+	Order string `json:"order,omitempty"`
+}
+
+func NewPagination(r PageRequest, total int64) Pagination {
+	limit := r.Limit
+	if r.unpaginated {
+		limit = total
+	}
+
+	return Pagination{
+		Page:  r.Page,
+		Limit: limit,
+		Total: total,
+		Sort:  r.Sort,
+		Order: r.Order,
+	}
+}
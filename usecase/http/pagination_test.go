@@ -0,0 +1,132 @@
+package http
+
+import (
+	"testing"
+)
+
+func TestPageRequest_Validate(t *testing.T) {
+	sortFields := []string{"height", "amount"}
+
+	tests := []struct {
+		description string
+		req         PageRequest
+		expected    PageRequest
+		expectErr   bool
+	}{
+		{
+			description: "sets defaults",
+			req:         PageRequest{},
+			expected:    PageRequest{Page: 1, Limit: DefaultPageLimit, Sort: "height", Order: OrderDesc},
+		},
+		{
+			description: "keeps provided values",
+			req:         PageRequest{Page: 3, Limit: 10, Sort: "amount", Order: OrderAsc},
+			expected:    PageRequest{Page: 3, Limit: 10, Sort: "amount", Order: OrderAsc},
+		},
+		{"returns error for negative page", PageRequest{Page: -1}, PageRequest{}, true},
+		{"returns error for negative limit", PageRequest{Limit: -1}, PageRequest{}, true},
+		{"returns error for limit above max", PageRequest{Limit: MaxPageLimit + 1}, PageRequest{}, true},
+		{"returns error for unknown sort", PageRequest{Sort: "kind"}, PageRequest{}, true},
+		{"returns error for unknown order", PageRequest{Order: "up"}, PageRequest{}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			err := tt.req.Validate(sortFields, "height", OrderDesc)
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if tt.req != tt.expected {
+				t.Errorf("unexpected request, want %+v; got %+v", tt.expected, tt.req)
+			}
+		})
+	}
+}
+
+func TestPageRequest_ValidateOptional(t *testing.T) {
+	sortFields := []string{"height", "amount"}
+
+	tests := []struct {
+		description   string
+		req           PageRequest
+		length        int
+		expectedStart int
+		expectedEnd   int
+		expectedLimit int64
+		expectedQuery int64
+	}{
+		{"returns all items when page and limit are missing", PageRequest{}, 250, 0, 250, 250, -1},
+		{"paginates when page is given", PageRequest{Page: 2}, 250, 100, 200, DefaultPageLimit, DefaultPageLimit},
+		{"paginates when limit is given", PageRequest{Limit: 10}, 250, 0, 10, 10, 10},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if err := tt.req.ValidateOptional(sortFields, "height", OrderDesc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			start, end := tt.req.Bounds(tt.length)
+			if start != tt.expectedStart || end != tt.expectedEnd {
+				t.Errorf("unexpected bounds, want [%d:%d]; got [%d:%d]", tt.expectedStart, tt.expectedEnd, start, end)
+			}
+
+			pagination := NewPagination(tt.req, int64(tt.length))
+			if pagination.Limit != tt.expectedLimit {
+				t.Errorf("unexpected limit, want %v; got %v", tt.expectedLimit, pagination.Limit)
+			}
+
+			if limit := tt.req.QueryLimit(); limit != tt.expectedQuery {
+				t.Errorf("unexpected query limit, want %v; got %v", tt.expectedQuery, limit)
+			}
+		})
+	}
+}
+
+func TestPageRequest_Bounds(t *testing.T) {
+	tests := []struct {
+		description   string
+		req           PageRequest
+		length        int
+		expectedStart int
+		expectedEnd   int
+	}{
+		{"returns first page", PageRequest{Page: 1, Limit: 10}, 25, 0, 10},
+		{"returns last partial page", PageRequest{Page: 3, Limit: 10}, 25, 20, 25},
+		{"returns empty page past the end", PageRequest{Page: 4, Limit: 10}, 25, 25, 25},
+		{"returns empty page for empty list", PageRequest{Page: 1, Limit: 10}, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			start, end := tt.req.Bounds(tt.length)
+			if start != tt.expectedStart || end != tt.expectedEnd {
+				t.Errorf("unexpected bounds, want [%d:%d]; got [%d:%d]", tt.expectedStart, tt.expectedEnd, start, end)
+			}
+		})
+	}
+}
+
+func TestPageRequest_SortSlice(t *testing.T) {
+	items := []int{3, 1, 2}
+
+	PageRequest{Order: OrderAsc}.SortSlice(items, func(i, j int) bool { return items[i] < items[j] })
+	if items[0] != 1 || items[1] != 2 || items[2] != 3 {
+		t.Errorf("unexpected ascending order: %v", items)
+	}
+
+	PageRequest{Order: OrderDesc}.SortSlice(items, func(i, j int) bool { return items[i] < items[j] })
+	if items[0] != 3 || items[1] != 2 || items[2] != 1 {
+		t.Errorf("unexpected descending order: %v", items)
+	}
+}
@@ -15,6 +15,16 @@ func BadRequest(c *gin.Context, err error) {
 	jsonError(c, http.StatusBadRequest, err)
 }
 
+// Unauthorized renders a HTTP 401 unauthorized response
+func Unauthorized(c *gin.Context, err error) {
+	jsonError(c, http.StatusUnauthorized, err)
+}
+
+// Forbidden renders a HTTP 403 forbidden response
+func Forbidden(c *gin.Context, err error) {
+	jsonError(c, http.StatusForbidden, err)
+}
+
 // NotFound renders a HTTP 404 not found response
 func NotFound(c *gin.Context, err error) {
 	jsonError(c, http.StatusNotFound, err)
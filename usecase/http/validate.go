@@ -0,0 +1,83 @@
+package http
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// ValidateParams returns middleware which rejects requests with path or query params not matching given definitions
+func ValidateParams(params []Parameter) gin.HandlerFunc {
+	patterns := map[string]*regexp.Regexp{}
+	for _, param := range params {
+		if param.Schema.Pattern != "" {
+			patterns[param.Name] = regexp.MustCompile(param.Schema.Pattern)
+		}
+	}
+
+	return func(c *gin.Context) {
+		for _, param := range params {
+			var value string
+			if param.In == "path" {
+				value = c.Param(param.Name)
+			} else {
+				value = c.Query(param.Name)
+			}
+
+			if value == "" {
+				if param.Required {
+					BadRequest(c, errors.Errorf("missing %s", param.Name))
+					return
+				}
+				continue
+			}
+
+			if err := param.Schema.Validate(value, patterns[param.Name]); err != nil {
+				BadRequest(c, errors.Wrapf(err, "invalid %s", param.Name))
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// Validate checks if string value of param matches schema
+func (s Schema) Validate(value string, pattern *regexp.Regexp) error {
+	switch s.Type {
+	case "integer":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return errors.New("must be an integer")
+		}
+		if s.Minimum != nil && n < *s.Minimum {
+			return errors.Errorf("must be greater than or equal to %d", *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			return errors.Errorf("must be less than or equal to %d", *s.Maximum)
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("must be a number")
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("must be a boolean")
+		}
+	}
+
+	if len(s.Enum) > 0 {
+		for _, v := range s.Enum {
+			if value == v {
+				return nil
+			}
+		}
+		return errors.Errorf("must be one of %v", s.Enum)
+	}
+
+	if pattern != nil && !pattern.MatchString(value) {
+		return errors.Errorf("must match %s", s.Pattern)
+	}
+	return nil
+}
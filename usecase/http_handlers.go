@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/store"
@@ -12,14 +14,20 @@ import (
 	"github.com/figment-networks/oasishub-indexer/usecase/chain"
 	"github.com/figment-networks/oasishub-indexer/usecase/debondingdelegation"
 	"github.com/figment-networks/oasishub-indexer/usecase/delegation"
+	"github.com/figment-networks/oasishub-indexer/usecase/escrowevent"
+	"github.com/figment-networks/oasishub-indexer/usecase/graph"
 	"github.com/figment-networks/oasishub-indexer/usecase/health"
+	"github.com/figment-networks/oasishub-indexer/usecase/network"
 	"github.com/figment-networks/oasishub-indexer/usecase/staking"
+	"github.com/figment-networks/oasishub-indexer/usecase/stream"
 	"github.com/figment-networks/oasishub-indexer/usecase/systemevent"
 	"github.com/figment-networks/oasishub-indexer/usecase/transaction"
+	"github.com/figment-networks/oasishub-indexer/usecase/transfer"
 	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
 )
 
-func NewHttpHandlers(cfg *config.Config, db *store.Store, c *client.Client) *HttpHandlers {
+func NewHttpHandlers(ctx context.Context, cfg *config.Config, db *store.Store, c *client.Client) *HttpHandlers {
 	return &HttpHandlers{
 		Health:                           health.NewHealthHttpHandler(),
 		GetStatus:                        chain.NewGetStatusHttpHandler(db, c),
@@ -28,20 +36,34 @@ func NewHttpHandlers(cfg *config.Config, db *store.Store, c *client.Client) *Htt
 		GetBlockSummary:                  block.NewGetBlockSummaryHttpHandler(db, c),
 		GetAccountByAddress:              account.NewGetByAddressHttpHandler(db, c),
 		GetAccountSummaries:              account.NewGetSummariesHttpHandler(db, c),
+		GetTopAccounts:                   account.NewGetTopHttpHandler(db, c),
 		GetDebondingDelegationsByHeight:  debondingdelegation.NewGetByHeightHttpHandler(db, c),
 		GetDebondingDelegationsByAddress: debondingdelegation.NewGetByAddressHttpHandler(db, c),
 		GetDelegationsByHeight:           delegation.NewGetByHeightHttpHandler(db, c),
 		GetDelegationsByAddress:          delegation.NewGetByAddressHttpHandler(db, c),
 		GetStakingDetailsByHeight:        staking.NewGetByHeightHttpHandler(db, c),
+		GetStakingHistory:                staking.NewGetHistoryHttpHandler(db, c),
+		GetNetworkSummary:                network.NewGetSummaryHttpHandler(db, c),
 		GetTransactionsByHeight:          transaction.NewGetByHeightHttpHandler(db, c),
+		GetTransactionsByPublicKey:       transaction.NewGetByPublicKeyHttpHandler(db, c),
 		BroadcastTransaction:             transaction.NewBroadcastHttpHandler(db, c),
 		GetValidatorsByHeight:            validator.NewGetByHeightHttpHandler(cfg, db, c),
 		GetValidatorByAddress:            validator.NewGetByAddressHttpHandler(db, c),
 		GetValidatorSummary:              validator.NewGetSummaryHttpHandler(db, c),
+		GetValidatorUptime:               validator.NewGetUptimeHttpHandler(db, c),
 		GetValidatorsForMinHeight:        validator.NewGetForMinHeightHttpHandler(db, c),
 		GetSystemEventsForAddress:        systemevent.NewGetForAddressHttpHandler(db, c),
 		GetBalanceForAddress:             balance.NewGetForAddressHttpHandler(db, c),
 		GetAPRByAddress:                  apr.NewGetAprByAddressHttpHandler(db, c),
+		GetTransfersForAddress:           transfer.NewGetForAddressHttpHandler(db, c),
+		GetEscrowEventsForAddress:        escrowevent.NewGetForAddressHttpHandler(db, c),
+		CreateWebhookSubscription:        webhook.NewCreateHttpHandler(cfg, db, c),
+		GetWebhookSubscriptions:          webhook.NewGetAllHttpHandler(db, c),
+		GetWebhookSubscriptionByID:       webhook.NewGetByIDHttpHandler(db, c),
+		UpdateWebhookSubscription:        webhook.NewUpdateHttpHandler(cfg, db, c),
+		DeleteWebhookSubscription:        webhook.NewDeleteHttpHandler(db, c),
+		GetStream:                        stream.NewGetHttpHandler(ctx, cfg, db, c),
+		GraphQL:                          graph.NewQueryHttpHandler(cfg, db, c),
 	}
 }
 
@@ -53,18 +75,32 @@ type HttpHandlers struct {
 	GetBlockByHeight                 types.HttpHandler
 	GetAccountByAddress              types.HttpHandler
 	GetAccountSummaries              types.HttpHandler
+	GetTopAccounts                   types.HttpHandler
 	GetDebondingDelegationsByHeight  types.HttpHandler
 	GetDebondingDelegationsByAddress types.HttpHandler
 	GetDelegationsByHeight           types.HttpHandler
 	GetStakingDetailsByHeight        types.HttpHandler
+	GetStakingHistory                types.HttpHandler
+	GetNetworkSummary                types.HttpHandler
 	GetTransactionsByHeight          types.HttpHandler
+	GetTransactionsByPublicKey       types.HttpHandler
 	BroadcastTransaction             types.HttpHandler
 	GetValidatorsByHeight            types.HttpHandler
 	GetValidatorByAddress            types.HttpHandler
 	GetValidatorSummary              types.HttpHandler
+	GetValidatorUptime               types.HttpHandler
 	GetValidatorsForMinHeight        types.HttpHandler
 	GetSystemEventsForAddress        types.HttpHandler
 	GetBalanceForAddress             types.HttpHandler
 	GetDelegationsByAddress          types.HttpHandler
 	GetAPRByAddress                  types.HttpHandler
+	GetTransfersForAddress           types.HttpHandler
+	GetEscrowEventsForAddress        types.HttpHandler
+	CreateWebhookSubscription        types.HttpHandler
+	GetWebhookSubscriptions          types.HttpHandler
+	GetWebhookSubscriptionByID       types.HttpHandler
+	UpdateWebhookSubscription        types.HttpHandler
+	DeleteWebhookSubscription        types.HttpHandler
+	GetStream                        types.HttpHandler
+	GraphQL                          types.HttpHandler
 }
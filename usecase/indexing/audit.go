@@ -0,0 +1,304 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+// errAuditNotIndexed is returned by audits of tables which have no indexed data at audited height
+var errAuditNotIndexed = errors.New("not indexed")
+
+type auditUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewAuditUseCase(cfg *config.Config, db *store.Store, c *client.Client) *auditUseCase {
+	return &auditUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type AuditUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	Sample      int64
+}
+
+// AuditDiscrepancy is a field which indexed value does not match the chain
+type AuditDiscrepancy struct {
+	Height int64  `json:"height"`
+	Table  string `json:"table"`
+	Key    string `json:"key"`
+	Field  string `json:"field"`
+	Stored string `json:"stored"`
+	Chain  string `json:"chain"`
+}
+
+// AuditNotIndexed is a table without indexed data at audited height, ie. because its sequences were purged
+type AuditNotIndexed struct {
+	Height int64  `json:"height"`
+	Table  string `json:"table"`
+}
+
+// AuditReport contains discrepancies found in audited heights
+type AuditReport struct {
+	StartHeight   int64              `json:"start_height"`
+	EndHeight     int64              `json:"end_height"`
+	Heights       []int64            `json:"heights"`
+	Discrepancies []AuditDiscrepancy `json:"discrepancies"`
+	NotIndexed    []AuditNotIndexed  `json:"not_indexed"`
+}
+
+// Execute re-fetches staking state and validators of audited heights and compares them field by field with indexed
+// validator sequences, staking sequences and account aggregates.
+// When sample is set only given number of random heights between start and end height is audited.
+func (uc *auditUseCase) Execute(ctx context.Context, useCaseConfig AuditUseCaseConfig) (*AuditReport, error) {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("audit"))
+	defer t.ObserveDuration()
+
+	startHeight := useCaseConfig.StartHeight
+	if startHeight <= 0 {
+		startHeight = uc.cfg.FirstBlockHeight
+	}
+
+	endHeight := useCaseConfig.EndHeight
+	if endHeight <= 0 {
+		mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
+		if err != nil {
+			return nil, err
+		}
+		endHeight = mostRecentSynced.Height
+	}
+
+	if startHeight > endHeight {
+		return nil, fmt.Errorf("start height %d is greater than end height %d", startHeight, endHeight)
+	}
+
+	report := &AuditReport{
+		StartHeight:   startHeight,
+		EndHeight:     endHeight,
+		Heights:       auditHeights(startHeight, endHeight, useCaseConfig.Sample),
+		Discrepancies: []AuditDiscrepancy{},
+		NotIndexed:    []AuditNotIndexed{},
+	}
+
+	logger.Info(fmt.Sprintf("auditing indexed heights [start_height=%d] [end_height=%d] [heights=%d]", startHeight, endHeight, len(report.Heights)))
+
+	for _, height := range report.Heights {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+
+		discrepancies, notIndexed, err := uc.auditHeight(ctx, height)
+		if err != nil {
+			return report, err
+		}
+
+		for _, n := range notIndexed {
+			logger.Info(fmt.Sprintf("skipping table not indexed at height [height=%d] [table=%s]", n.Height, n.Table))
+		}
+		report.NotIndexed = append(report.NotIndexed, notIndexed...)
+
+		for _, d := range discrepancies {
+			logger.Warn(fmt.Sprintf("found discrepancy [height=%d] [table=%s] [key=%s] [field=%s] [stored=%s] [chain=%s]", d.Height, d.Table, d.Key, d.Field, d.Stored, d.Chain))
+		}
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+	}
+
+	return report, nil
+}
+
+// auditChainState is chain state of audited height
+type auditChainState struct {
+	staking    *statepb.Staking
+	validators []*validatorpb.Validator
+}
+
+// auditHeight returns discrepancies found at given height and tables which have no indexed data at given height
+func (uc *auditUseCase) auditHeight(ctx context.Context, height int64) ([]AuditDiscrepancy, []AuditNotIndexed, error) {
+	stakingRes, err := uc.client.State.GetStakingByHeight(ctx, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validatorsRes, err := uc.client.Validator.GetByHeight(ctx, height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chain := &auditChainState{
+		staking:    stakingRes.GetStaking(),
+		validators: validatorsRes.GetValidators(),
+	}
+
+	var discrepancies []AuditDiscrepancy
+	var notIndexed []AuditNotIndexed
+	for _, audit := range []struct {
+		table string
+		run   func(int64, *auditChainState) ([]AuditDiscrepancy, error)
+	}{
+		{"validator_sequences", uc.auditValidatorSeqs},
+		{"staking_sequences", uc.auditStakingSeq},
+		{"account_aggregates", uc.auditAccountAggs},
+	} {
+		found, err := audit.run(height, chain)
+		if err != nil {
+			if err == errAuditNotIndexed {
+				notIndexed = append(notIndexed, AuditNotIndexed{Height: height, Table: audit.table})
+				continue
+			}
+			return nil, nil, err
+		}
+		discrepancies = append(discrepancies, found...)
+	}
+	return discrepancies, notIndexed, nil
+}
+
+// auditValidatorSeqs compares validator sequences and reports validators which are on chain but not indexed.
+// Heights without any validator sequences, ie. purged ones, are not indexed rather than missing all validators.
+func (uc *auditUseCase) auditValidatorSeqs(height int64, chain *auditChainState) ([]AuditDiscrepancy, error) {
+	validatorSeqs, err := uc.db.ValidatorSeq.FindByHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+	if len(validatorSeqs) == 0 {
+		return nil, errAuditNotIndexed
+	}
+
+	c := auditComparer{height: height, table: "validator_sequences"}
+	stored := map[string]bool{}
+	for _, seq := range validatorSeqs {
+		stored[seq.Address] = true
+
+		totalShares := big.NewInt(0)
+		if delegations, ok := chain.staking.GetDelegations()[seq.Address]; ok {
+			for _, d := range delegations.Entries {
+				shares := types.NewQuantityFromBytes(d.Shares)
+				totalShares = totalShares.Add(totalShares, &shares.Int)
+			}
+		}
+		c.quantity(seq.Address, "total_shares", seq.TotalShares, types.NewQuantity(totalShares))
+
+		var activeEscrowBalance types.Quantity
+		if account, ok := chain.staking.GetLedger()[seq.Address]; ok {
+			activeEscrowBalance = types.NewQuantityFromBytes(account.GetEscrow().GetActive().GetBalance())
+		}
+		c.quantity(seq.Address, "active_escrow_balance", seq.ActiveEscrowBalance, activeEscrowBalance)
+	}
+
+	for _, validator := range chain.validators {
+		if address := validator.GetAddress(); !stored[address] {
+			c.add(address, "address", "", address)
+		}
+	}
+	return c.discrepancies, nil
+}
+
+func (uc *auditUseCase) auditStakingSeq(height int64, chain *auditChainState) ([]AuditDiscrepancy, error) {
+	stakingSeq, err := uc.db.StakingSeq.FindByHeight(height)
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil, errAuditNotIndexed
+		}
+		return nil, err
+	}
+
+	c := auditComparer{height: height, table: "staking_sequences"}
+	c.quantity("", "total_supply", stakingSeq.TotalSupply, types.NewQuantityFromBytes(chain.staking.GetTotalSupply()))
+	c.quantity("", "common_pool", stakingSeq.CommonPool, types.NewQuantityFromBytes(chain.staking.GetCommonPool()))
+	return c.discrepancies, nil
+}
+
+// auditAccountAggs compares account aggregates which were last updated at given height
+func (uc *auditUseCase) auditAccountAggs(height int64, chain *auditChainState) ([]AuditDiscrepancy, error) {
+	accountAggs, err := uc.db.AccountAgg.FindAllByRecentAtHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+
+	c := auditComparer{height: height, table: "account_aggregates"}
+	for _, agg := range accountAggs {
+		account, ok := chain.staking.GetLedger()[agg.PublicKey]
+		if !ok {
+			c.add(agg.PublicKey, "public_key", agg.PublicKey, "")
+			continue
+		}
+
+		c.quantity(agg.PublicKey, "recent_general_balance", agg.RecentGeneralBalance, types.NewQuantityFromBytes(account.GetGeneral().GetBalance()))
+		if nonce := account.GetGeneral().GetNonce(); agg.RecentGeneralNonce != nonce {
+			c.add(agg.PublicKey, "recent_general_nonce", fmt.Sprint(agg.RecentGeneralNonce), fmt.Sprint(nonce))
+		}
+		c.quantity(agg.PublicKey, "recent_escrow_active_balance", agg.RecentEscrowActiveBalance, types.NewQuantityFromBytes(account.GetEscrow().GetActive().GetBalance()))
+		c.quantity(agg.PublicKey, "recent_escrow_active_total_shares", agg.RecentEscrowActiveTotalShares, types.NewQuantityFromBytes(account.GetEscrow().GetActive().GetTotalShares()))
+		c.quantity(agg.PublicKey, "recent_escrow_debonding_balance", agg.RecentEscrowDebondingBalance, types.NewQuantityFromBytes(account.GetEscrow().GetDebonding().GetBalance()))
+		c.quantity(agg.PublicKey, "recent_escrow_debonding_total_shares", agg.RecentEscrowDebondingTotalShares, types.NewQuantityFromBytes(account.GetEscrow().GetDebonding().GetTotalShares()))
+	}
+	return c.discrepancies, nil
+}
+
+// auditComparer collects discrepancies found in single table at given height
+type auditComparer struct {
+	height        int64
+	table         string
+	discrepancies []AuditDiscrepancy
+}
+
+func (c *auditComparer) quantity(key string, field string, stored types.Quantity, chain types.Quantity) {
+	if stored.Cmp(chain) != 0 {
+		c.add(key, field, stored.String(), chain.String())
+	}
+}
+
+func (c *auditComparer) add(key string, field string, stored string, chain string) {
+	c.discrepancies = append(c.discrepancies, AuditDiscrepancy{
+		Height: c.height,
+		Table:  c.table,
+		Key:    key,
+		Field:  field,
+		Stored: stored,
+		Chain:  chain,
+	})
+}
+
+// auditHeights returns all heights between start and end height or given number of random ones, sorted
+func auditHeights(startHeight int64, endHeight int64, sample int64) []int64 {
+	total := endHeight - startHeight + 1
+	if sample <= 0 || sample >= total {
+		heights := make([]int64, total)
+		for i := range heights {
+			heights[i] = startHeight + int64(i)
+		}
+		return heights
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	picked := map[int64]bool{}
+	for int64(len(picked)) < sample {
+		picked[startHeight+r.Int63n(total)] = true
+	}
+
+	heights := make([]int64, 0, sample)
+	for height := range picked {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
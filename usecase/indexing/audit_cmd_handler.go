@@ -0,0 +1,74 @@
+package indexing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAuditMismatch = errors.New("indexed data does not match the chain")
+)
+
+type AuditCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *auditUseCase
+}
+
+func NewAuditCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *AuditCmdHandler {
+	return &AuditCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+// Handle runs audit and writes JSON report to file, or to stdout when file is not set.
+// It returns ErrAuditMismatch when any discrepancy was found.
+func (h *AuditCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, sample int64, file string) error {
+	logger.Info(fmt.Sprintf("running audit use case [handler=cmd] [start_height=%d] [end_height=%d] [sample=%d] [file=%s]", startHeight, endHeight, sample, file))
+
+	report, err := h.getUseCase().Execute(ctx, AuditUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Sample:      sample,
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if file == "" {
+		fmt.Println(string(data))
+	} else if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		return err
+	}
+
+	if len(report.Discrepancies) > 0 {
+		return errors.Wrapf(ErrAuditMismatch, "found %d discrepancies", len(report.Discrepancies))
+	}
+
+	logger.Info(fmt.Sprintf("audit found no discrepancies [heights=%d] [not_indexed=%d]", len(report.Heights), len(report.NotIndexed)))
+	return nil
+}
+
+func (h *AuditCmdHandler) getUseCase() *auditUseCase {
+	if h.useCase == nil {
+		h.useCase = NewAuditUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
@@ -0,0 +1,264 @@
+package indexing
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	mock_client "github.com/figment-networks/oasishub-indexer/mock/client"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestAuditHeights(t *testing.T) {
+	tests := []struct {
+		description string
+		startHeight int64
+		endHeight   int64
+		sample      int64
+		expect      []int64
+	}{
+		{description: "returns all heights when sample is not set", startHeight: 5, endHeight: 8, sample: 0, expect: []int64{5, 6, 7, 8}},
+		{description: "returns all heights when sample equals range", startHeight: 5, endHeight: 8, sample: 4, expect: []int64{5, 6, 7, 8}},
+		{description: "returns all heights when sample is greater than range", startHeight: 5, endHeight: 8, sample: 100, expect: []int64{5, 6, 7, 8}},
+		{description: "returns single height", startHeight: 5, endHeight: 5, sample: 1, expect: []int64{5}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if got := auditHeights(tt.startHeight, tt.endHeight, tt.sample); !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected heights, want: %v; got: %v", tt.expect, got)
+			}
+		})
+	}
+
+	t.Run("returns unique sorted sampled heights within range", func(t *testing.T) {
+		const startHeight, endHeight, sample int64 = 100, 119, 15
+
+		for i := 0; i < 50; i++ {
+			got := auditHeights(startHeight, endHeight, sample)
+
+			if int64(len(got)) != sample {
+				t.Fatalf("unexpected number of heights, want: %d; got: %d", sample, len(got))
+			}
+			for j, height := range got {
+				if height < startHeight || height > endHeight {
+					t.Fatalf("height %d out of range [%d, %d]", height, startHeight, endHeight)
+				}
+				if j > 0 && height <= got[j-1] {
+					t.Fatalf("heights should be unique and sorted, got: %v", got)
+				}
+			}
+		}
+	})
+}
+
+func TestAuditUseCase_auditHeight(t *testing.T) {
+	const height int64 = 100
+
+	bytes := func(v int64) []byte { return big.NewInt(v).Bytes() }
+
+	staking := &statepb.Staking{
+		TotalSupply: bytes(10000),
+		CommonPool:  bytes(500),
+		Ledger: map[string]*accountpb.Account{
+			"validator1": {
+				General: &accountpb.GeneralAccount{Balance: bytes(100), Nonce: 3},
+				Escrow: &accountpb.EscrowAccount{
+					Active:    &accountpb.SharePool{Balance: bytes(1000), TotalShares: bytes(900)},
+					Debonding: &accountpb.SharePool{Balance: bytes(50), TotalShares: bytes(40)},
+				},
+			},
+		},
+		Delegations: map[string]*delegationpb.DelegationEntry{
+			"validator1": {Entries: map[string]*delegationpb.Delegation{
+				"delegator1": {Shares: bytes(600)},
+				"delegator2": {Shares: bytes(300)},
+			}},
+		},
+	}
+
+	validators := []*validatorpb.Validator{{Address: "validator1"}, {Address: "validator2"}}
+
+	matchingValidatorSeqs := func() []model.ValidatorSeq {
+		return []model.ValidatorSeq{
+			{Address: "validator1", TotalShares: types.NewQuantityFromInt64(900), ActiveEscrowBalance: types.NewQuantityFromInt64(1000)},
+			{Address: "validator2", TotalShares: types.NewQuantityFromInt64(0), ActiveEscrowBalance: types.NewQuantityFromInt64(0)},
+		}
+	}
+
+	matchingStakingSeq := func() *model.StakingSeq {
+		return &model.StakingSeq{TotalSupply: types.NewQuantityFromInt64(10000), CommonPool: types.NewQuantityFromInt64(500)}
+	}
+
+	matchingAccountAggs := func() []model.AccountAgg {
+		return []model.AccountAgg{{
+			PublicKey:                        "validator1",
+			RecentGeneralBalance:             types.NewQuantityFromInt64(100),
+			RecentGeneralNonce:               3,
+			RecentEscrowActiveBalance:        types.NewQuantityFromInt64(1000),
+			RecentEscrowActiveTotalShares:    types.NewQuantityFromInt64(900),
+			RecentEscrowDebondingBalance:     types.NewQuantityFromInt64(50),
+			RecentEscrowDebondingTotalShares: types.NewQuantityFromInt64(40),
+		}}
+	}
+
+	tests := []struct {
+		description   string
+		validatorSeqs func() []model.ValidatorSeq
+		stakingSeq    func() *model.StakingSeq
+		accountAggs   func() []model.AccountAgg
+		expect        []AuditDiscrepancy
+		expectNot     []AuditNotIndexed
+	}{
+		{
+			description:   "returns no discrepancies when stored values match chain",
+			validatorSeqs: matchingValidatorSeqs,
+			stakingSeq:    matchingStakingSeq,
+			accountAggs:   matchingAccountAggs,
+		},
+		{
+			description: "returns one discrepancy per mismatched validator field",
+			validatorSeqs: func() []model.ValidatorSeq {
+				seqs := matchingValidatorSeqs()
+				seqs[0].TotalShares = types.NewQuantityFromInt64(800)
+				seqs[0].ActiveEscrowBalance = types.NewQuantityFromInt64(999)
+				return seqs
+			},
+			stakingSeq:  matchingStakingSeq,
+			accountAggs: matchingAccountAggs,
+			expect: []AuditDiscrepancy{
+				{Height: height, Table: "validator_sequences", Key: "validator1", Field: "total_shares", Stored: "800", Chain: "900"},
+				{Height: height, Table: "validator_sequences", Key: "validator1", Field: "active_escrow_balance", Stored: "999", Chain: "1000"},
+			},
+		},
+		{
+			description: "returns discrepancy for validator on chain without validator sequence",
+			validatorSeqs: func() []model.ValidatorSeq {
+				return matchingValidatorSeqs()[:1]
+			},
+			stakingSeq:  matchingStakingSeq,
+			accountAggs: matchingAccountAggs,
+			expect: []AuditDiscrepancy{
+				{Height: height, Table: "validator_sequences", Key: "validator2", Field: "address", Stored: "", Chain: "validator2"},
+			},
+		},
+		{
+			description: "returns validator sequences not indexed for purged height",
+			validatorSeqs: func() []model.ValidatorSeq {
+				return nil
+			},
+			stakingSeq:  matchingStakingSeq,
+			accountAggs: matchingAccountAggs,
+			expectNot: []AuditNotIndexed{
+				{Height: height, Table: "validator_sequences"},
+			},
+		},
+		{
+			description:   "returns staking sequence not indexed for missing height",
+			validatorSeqs: matchingValidatorSeqs,
+			stakingSeq: func() *model.StakingSeq {
+				return nil
+			},
+			accountAggs: matchingAccountAggs,
+			expectNot: []AuditNotIndexed{
+				{Height: height, Table: "staking_sequences"},
+			},
+		},
+		{
+			description:   "returns one discrepancy per mismatched staking field",
+			validatorSeqs: matchingValidatorSeqs,
+			stakingSeq: func() *model.StakingSeq {
+				return &model.StakingSeq{TotalSupply: types.NewQuantityFromInt64(1), CommonPool: types.NewQuantityFromInt64(2)}
+			},
+			accountAggs: matchingAccountAggs,
+			expect: []AuditDiscrepancy{
+				{Height: height, Table: "staking_sequences", Field: "total_supply", Stored: "1", Chain: "10000"},
+				{Height: height, Table: "staking_sequences", Field: "common_pool", Stored: "2", Chain: "500"},
+			},
+		},
+		{
+			description:   "returns one discrepancy per mismatched account field",
+			validatorSeqs: matchingValidatorSeqs,
+			stakingSeq:    matchingStakingSeq,
+			accountAggs: func() []model.AccountAgg {
+				aggs := matchingAccountAggs()
+				aggs[0].RecentGeneralNonce = 2
+				aggs[0].RecentEscrowDebondingBalance = types.NewQuantityFromInt64(0)
+				return aggs
+			},
+			expect: []AuditDiscrepancy{
+				{Height: height, Table: "account_aggregates", Key: "validator1", Field: "recent_general_nonce", Stored: "2", Chain: "3"},
+				{Height: height, Table: "account_aggregates", Key: "validator1", Field: "recent_escrow_debonding_balance", Stored: "0", Chain: "50"},
+			},
+		},
+		{
+			description:   "returns discrepancy for account missing on chain",
+			validatorSeqs: matchingValidatorSeqs,
+			stakingSeq:    matchingStakingSeq,
+			accountAggs: func() []model.AccountAgg {
+				return append(matchingAccountAggs(), model.AccountAgg{PublicKey: "unknown"})
+			},
+			expect: []AuditDiscrepancy{
+				{Height: height, Table: "account_aggregates", Key: "unknown", Field: "public_key", Stored: "unknown", Chain: ""},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stateClientMock := mock_client.NewMockStateClient(ctrl)
+			stateClientMock.EXPECT().GetStakingByHeight(gomock.Any(), height).Return(&statepb.GetStakingByHeightResponse{Staking: staking}, nil).Times(1)
+
+			validatorClientMock := mock_client.NewMockValidatorClient(ctrl)
+			validatorClientMock.EXPECT().GetByHeight(gomock.Any(), height).Return(&validatorpb.GetByHeightResponse{Validators: validators}, nil).Times(1)
+
+			validatorSeqStoreMock := mock.NewMockValidatorSeqStore(ctrl)
+			validatorSeqStoreMock.EXPECT().FindByHeight(height).Return(tt.validatorSeqs(), nil).Times(1)
+
+			stakingSeqStoreMock := mock.NewMockStakingSeqStore(ctrl)
+			if stakingSeq := tt.stakingSeq(); stakingSeq == nil {
+				stakingSeqStoreMock.EXPECT().FindByHeight(height).Return(nil, store.ErrNotFound).Times(1)
+			} else {
+				stakingSeqStoreMock.EXPECT().FindByHeight(height).Return(stakingSeq, nil).Times(1)
+			}
+
+			accountAggStoreMock := mock.NewMockAccountAggStore(ctrl)
+			accountAggStoreMock.EXPECT().FindAllByRecentAtHeight(height).Return(tt.accountAggs(), nil).Times(1)
+
+			uc := NewAuditUseCase(
+				nil,
+				&store.Store{ValidatorSeq: validatorSeqStoreMock, StakingSeq: stakingSeqStoreMock, AccountAgg: accountAggStoreMock},
+				&client.Client{State: stateClientMock, Validator: validatorClientMock},
+			)
+
+			got, gotNot, err := uc.auditHeight(context.Background(), height)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected discrepancies, want: %+v; got: %+v", tt.expect, got)
+			}
+
+			if !reflect.DeepEqual(gotNot, tt.expectNot) {
+				t.Errorf("unexpected not indexed tables, want: %+v; got: %+v", tt.expectNot, gotNot)
+			}
+		})
+	}
+}
@@ -33,7 +33,12 @@ type BackfillUseCaseConfig struct {
 }
 
 func (uc *backfillUseCase) Execute(ctx context.Context, useCaseConfig BackfillUseCaseConfig) error {
-	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.db, uc.client)
+	cachedClient, err := withCache(uc.cfg, uc.client)
+	if err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, cachedClient)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,163 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+const (
+	cacheWarmLogInterval = 100
+)
+
+var (
+	ErrProxyCacheDirRequired = errors.New("proxy cache dir is required")
+)
+
+type cacheWarmUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+}
+
+func NewCacheWarmUseCase(cfg *config.Config, c *client.Client) *cacheWarmUseCase {
+	return &cacheWarmUseCase{
+		cfg:    cfg,
+		client: c,
+	}
+}
+
+type CacheWarmUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+}
+
+// Execute fetches responses for all heights in range into disk cache, heights already cached are skipped
+func (uc *cacheWarmUseCase) Execute(ctx context.Context, useCaseConfig CacheWarmUseCaseConfig) error {
+	if uc.cfg.ProxyCacheDir == "" {
+		return ErrProxyCacheDirRequired
+	}
+
+	cachedClient, err := withCache(uc.cfg, uc.client)
+	if err != nil {
+		return err
+	}
+
+	startHeight := useCaseConfig.StartHeight
+	if startHeight == 0 {
+		startHeight = uc.cfg.FirstBlockHeight
+	}
+
+	endHeight := useCaseConfig.EndHeight
+	if endHeight == 0 {
+		head, err := uc.client.Chain.GetHead(ctx)
+		if err != nil {
+			return err
+		}
+		endHeight = head.GetHeight()
+	}
+
+	logger.Info(fmt.Sprintf("warming proxy cache [start=%d] [end=%d]", startHeight, endHeight))
+
+	workers := uc.cfg.PrefetchWindow
+	if workers < 1 {
+		workers = 1
+	}
+
+	heights := make(chan int64)
+	errs := make(chan error, workers)
+
+	var wg sync.WaitGroup
+	for i := int64(0); i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				if err := fetchHeight(ctx, cachedClient, height); err != nil {
+					errs <- errors.Wrapf(err, "could not warm height %d", height)
+					return
+				}
+			}
+		}()
+	}
+
+	err = uc.sendHeights(ctx, heights, errs, startHeight, endHeight)
+	close(heights)
+	wg.Wait()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+	}
+
+	logger.Info(fmt.Sprintf("proxy cache warmed [start=%d] [end=%d]", startHeight, endHeight))
+	return nil
+}
+
+// sendHeights passes heights to workers until all are sent, context is done or any worker fails
+func (uc *cacheWarmUseCase) sendHeights(ctx context.Context, heights chan<- int64, errs <-chan error, startHeight int64, endHeight int64) error {
+	for height := startHeight; height <= endHeight; height++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errs:
+			return err
+		case heights <- height:
+		}
+
+		if (height-startHeight)%cacheWarmLogInterval == 0 {
+			logger.Info(fmt.Sprintf("warming proxy cache [height=%d] [end=%d]", height, endHeight))
+		}
+	}
+	return nil
+}
+
+// fetchHeight requests all responses of height used by indexing pipeline
+func fetchHeight(ctx context.Context, c *client.Client, height int64) error {
+	if _, err := c.Chain.GetMetaByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.Block.GetByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.State.GetByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.State.GetStakingByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.Validator.GetByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.Transaction.GetByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.Event.GetEscrowEventsByHeight(ctx, height); err != nil {
+		return err
+	}
+	if _, err := c.Event.GetTransferEventsByHeight(ctx, height); err != nil {
+		return err
+	}
+	return nil
+}
+
+// withCache returns client reading height responses from disk cache first when proxy cache dir is set
+func withCache(cfg *config.Config, c *client.Client) (*client.Client, error) {
+	if cfg.ProxyCacheDir == "" {
+		return c, nil
+	}
+
+	cache, err := client.NewDiskCache(cfg.ProxyCacheDir, cfg.ProxyCacheMaxSizeMB*1024*1024)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewCachedClient(c, cache), nil
+}
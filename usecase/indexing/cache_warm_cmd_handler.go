@@ -0,0 +1,47 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type CacheWarmCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *cacheWarmUseCase
+}
+
+func NewCacheWarmCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *CacheWarmCmdHandler {
+	return &CacheWarmCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *CacheWarmCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64) {
+	logger.Info(fmt.Sprintf("running cache warm use case [handler=cmd] [start_height=%d] [end_height=%d]", startHeight, endHeight))
+
+	err := h.getUseCase().Execute(ctx, CacheWarmUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *CacheWarmCmdHandler) getUseCase() *cacheWarmUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCacheWarmUseCase(h.cfg, h.client)
+	}
+	return h.useCase
+}
@@ -0,0 +1,129 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/indexer"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrWorkerLeaderRunning = errors.New("follow skipped because worker leader holds the leader lock")
+	ErrFollowLockLost      = errors.New("follow stopped because worker leader lock was lost")
+)
+
+type followUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewFollowUseCase(cfg *config.Config, db *store.Store, c *client.Client) *followUseCase {
+	return &followUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (uc *followUseCase) Execute(ctx context.Context) error {
+	if err := uc.canExecute(); err != nil {
+		return err
+	}
+
+	pollInterval, err := time.ParseDuration(uc.cfg.FollowPollInterval)
+	if err != nil {
+		return err
+	}
+
+	maxBackoff, err := time.ParseDuration(uc.cfg.FollowMaxBackoff)
+	if err != nil {
+		return err
+	}
+
+	lockCheckInterval, err := time.ParseDuration(uc.cfg.WorkerLeaderCheckInterval)
+	if err != nil {
+		return err
+	}
+
+	// Follow indexes the same heights as worker index job, so it holds worker leader lock while running
+	lock := uc.db.NewAdvisoryLock(uc.cfg.WorkerLeaderLockKey)
+	acquired, err := lock.TryAcquire(ctx)
+	if err != nil {
+		return err
+	}
+	if !acquired {
+		return ErrWorkerLeaderRunning
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	lost := make(chan struct{})
+	done := make(chan struct{})
+	go uc.checkLock(ctx, cancel, lock, lockCheckInterval, lost, done)
+
+	defer func() {
+		cancel()
+		<-done
+		if err := lock.Release(context.Background()); err != nil {
+			logger.Error(err)
+		}
+	}()
+
+	indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, uc.client)
+	if err != nil {
+		return err
+	}
+
+	err = indexingPipeline.Follow(ctx, indexer.FollowConfig{
+		PollInterval: pollInterval,
+		MaxBackoff:   maxBackoff,
+	})
+
+	select {
+	case <-lost:
+		return ErrFollowLockLost
+	default:
+	}
+	return err
+}
+
+// checkLock checks that leader lock is still held until context is done. When lock is lost following is cancelled
+// and lost channel closed, so that worker replica which takes the lock over does not index the same heights.
+func (uc *followUseCase) checkLock(ctx context.Context, cancel context.CancelFunc, lock *store.AdvisoryLock, interval time.Duration, lost chan struct{}, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := lock.Check(ctx); err != nil {
+				logger.Warn(fmt.Sprintf("lost worker leader lock [err=%s]", err))
+				close(lost)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// canExecute checks if sequential reindex is already running
+func (uc *followUseCase) canExecute() error {
+	if _, err := uc.db.Reports.FindNotCompletedByKind(model.ReportKindSequentialReindex); err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	return ErrRunningSequentialReindex
+}
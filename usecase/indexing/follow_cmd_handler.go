@@ -0,0 +1,64 @@
+package indexing
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type FollowCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *followUseCase
+}
+
+func NewFollowCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *FollowCmdHandler {
+	return &FollowCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *FollowCmdHandler) Handle(ctx context.Context) error {
+	logger.Info("running follow use case [handler=cmd]")
+
+	// Stop following on interrupt so that current report gets completed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	go func() {
+		select {
+		case <-signals:
+			logger.Info("stopping follow use case")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (h *FollowCmdHandler) getUseCase() *followUseCase {
+	if h.useCase == nil {
+		h.useCase = NewFollowUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
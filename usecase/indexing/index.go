@@ -33,7 +33,7 @@ func (uc *indexUseCase) Execute(ctx context.Context, batchSize int64) error {
 		return err
 	}
 
-	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.db, uc.client)
+	indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, uc.client)
 	if err != nil {
 		return err
 	}
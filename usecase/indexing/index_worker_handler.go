@@ -31,9 +31,8 @@ func NewIndexWorkerHandler(cfg *config.Config, db *store.Store, c *client.Client
 	}
 }
 
-func (h *indexWorkerHandler) Handle() {
+func (h *indexWorkerHandler) Handle(ctx context.Context) {
 	batchSize := h.cfg.DefaultBatchSize
-	ctx := context.Background()
 
 	logger.Info(fmt.Sprintf("running indexer use case [handler=worker] [batchSize=%d]", batchSize))
 
@@ -0,0 +1,13 @@
+package indexing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitTest()
+	os.Exit(m.Run())
+}
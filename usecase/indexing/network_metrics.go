@@ -0,0 +1,98 @@
+package indexing
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+// computeNetworkSummary calculates network metrics from validator sequences at single height
+func computeNetworkSummary(validatorSeqs []model.ValidatorSeq, totalSupply types.Quantity) (*model.NetworkSummary, error) {
+	totalStaked := types.NewQuantityFromInt64(0)
+	commissionSum := new(big.Float)
+	var votingPowers []int64
+
+	for _, validatorSeq := range validatorSeqs {
+		if err := totalStaked.Add(validatorSeq.ActiveEscrowBalance); err != nil {
+			return nil, err
+		}
+
+		if validatorSeq.VotingPower <= 0 {
+			continue
+		}
+		votingPowers = append(votingPowers, validatorSeq.VotingPower)
+		commissionSum.Add(commissionSum, new(big.Float).SetInt(validatorSeq.Commission.GetBigInt()))
+	}
+
+	var commissionAvg float64
+	if len(votingPowers) > 0 {
+		commissionAvg, _ = commissionSum.Quo(commissionSum, big.NewFloat(float64(len(votingPowers)))).Float64()
+	}
+
+	return &model.NetworkSummary{
+		TotalStaked:         totalStaked,
+		StakingRatio:        stakingRatio(totalStaked, totalSupply),
+		ActiveValidators:    int64(len(votingPowers)),
+		NakamotoCoefficient: nakamotoCoefficient(votingPowers),
+		GiniCoefficient:     giniCoefficient(votingPowers),
+		CommissionAvg:       commissionAvg,
+	}, nil
+}
+
+// stakingRatio returns share of total supply which is staked
+func stakingRatio(totalStaked, totalSupply types.Quantity) float64 {
+	if totalSupply.IsZero() {
+		return 0
+	}
+	ratio, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(totalStaked.GetBigInt()),
+		new(big.Float).SetInt(totalSupply.GetBigInt()),
+	).Float64()
+	return ratio
+}
+
+// nakamotoCoefficient returns minimum number of validators which together control more than 1/3 of voting power
+func nakamotoCoefficient(votingPowers []int64) int64 {
+	sorted := make([]int64, len(votingPowers))
+	copy(sorted, votingPowers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+
+	var total int64
+	for _, vp := range sorted {
+		total += vp
+	}
+
+	var acc int64
+	for i, vp := range sorted {
+		acc += vp
+		if acc*3 > total {
+			return int64(i + 1)
+		}
+	}
+	return 0
+}
+
+// giniCoefficient returns Gini coefficient of voting power distribution (0 - equal, 1 - concentrated)
+func giniCoefficient(votingPowers []int64) float64 {
+	n := len(votingPowers)
+	if n == 0 {
+		return 0
+	}
+
+	sorted := make([]int64, n)
+	copy(sorted, votingPowers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total, weighted float64
+	for i, vp := range sorted {
+		total += float64(vp)
+		weighted += float64(i+1) * float64(vp)
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return 2*weighted/(float64(n)*total) - float64(n+1)/float64(n)
+}
@@ -0,0 +1,153 @@
+package indexing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+const floatTolerance = 1e-9
+
+func TestNakamotoCoefficient(t *testing.T) {
+	tests := []struct {
+		description  string
+		votingPowers []int64
+		expect       int64
+	}{
+		{description: "returns 0 for no validators", votingPowers: nil, expect: 0},
+		{description: "returns 1 for single validator", votingPowers: []int64{10}, expect: 1},
+		{description: "returns 1 when top validator has more than 1/3", votingPowers: []int64{33, 34, 33}, expect: 1},
+		{description: "does not count validator with exactly 1/3", votingPowers: []int64{5, 5, 5}, expect: 2},
+		{description: "does not count validators just below 1/3", votingPowers: []int64{1, 33, 33, 33}, expect: 2},
+		{description: "returns number of top validators over 1/3 for equal powers", votingPowers: []int64{10, 10, 10, 10, 10, 10, 10, 10, 10, 10}, expect: 4},
+		{description: "sorts validators by voting power", votingPowers: []int64{1, 1, 1, 1, 1, 1, 50}, expect: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if got := nakamotoCoefficient(tt.votingPowers); got != tt.expect {
+				t.Errorf("unexpected nakamoto coefficient, want: %d; got: %d", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestGiniCoefficient(t *testing.T) {
+	tests := []struct {
+		description  string
+		votingPowers []int64
+		expect       float64
+	}{
+		{description: "returns 0 for no validators", votingPowers: nil, expect: 0},
+		{description: "returns 0 for single validator", votingPowers: []int64{10}, expect: 0},
+		{description: "returns 0 for equal powers", votingPowers: []int64{7, 7, 7, 7}, expect: 0},
+		{description: "returns 0 for zero powers", votingPowers: []int64{0, 0}, expect: 0},
+		{description: "returns coefficient for unequal powers", votingPowers: []int64{3, 1}, expect: 0.25},
+		{description: "returns coefficient for concentrated powers", votingPowers: []int64{0, 10, 0, 0}, expect: 0.75},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if got := giniCoefficient(tt.votingPowers); math.Abs(got-tt.expect) > floatTolerance {
+				t.Errorf("unexpected gini coefficient, want: %v; got: %v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestComputeNetworkSummary(t *testing.T) {
+	validatorSeq := func(votingPower, commission, activeEscrowBalance int64) model.ValidatorSeq {
+		return model.ValidatorSeq{
+			VotingPower:         votingPower,
+			Commission:          types.NewQuantityFromInt64(commission),
+			ActiveEscrowBalance: types.NewQuantityFromInt64(activeEscrowBalance),
+		}
+	}
+
+	tests := []struct {
+		description   string
+		validatorSeqs []model.ValidatorSeq
+		totalSupply   types.Quantity
+		expect        model.NetworkSummary
+	}{
+		{
+			description: "returns empty summary for no validators",
+			totalSupply: types.NewQuantityFromInt64(1000),
+			expect:      model.NetworkSummary{TotalStaked: types.NewQuantityFromInt64(0)},
+		},
+		{
+			description:   "returns summary for single validator",
+			validatorSeqs: []model.ValidatorSeq{validatorSeq(10, 2000, 250)},
+			totalSupply:   types.NewQuantityFromInt64(1000),
+			expect: model.NetworkSummary{
+				TotalStaked:         types.NewQuantityFromInt64(250),
+				StakingRatio:        0.25,
+				ActiveValidators:    1,
+				NakamotoCoefficient: 1,
+				CommissionAvg:       2000,
+			},
+		},
+		{
+			description:   "returns zero staking ratio for zero total supply",
+			validatorSeqs: []model.ValidatorSeq{validatorSeq(10, 1000, 250), validatorSeq(10, 3000, 250)},
+			totalSupply:   types.NewQuantityFromInt64(0),
+			expect: model.NetworkSummary{
+				TotalStaked:         types.NewQuantityFromInt64(500),
+				ActiveValidators:    2,
+				NakamotoCoefficient: 1,
+				CommissionAvg:       2000,
+			},
+		},
+		{
+			description: "excludes zero voting power validators from active validators and commission average",
+			validatorSeqs: []model.ValidatorSeq{
+				validatorSeq(30, 1000, 300),
+				validatorSeq(10, 3000, 100),
+				validatorSeq(0, 9000, 100),
+			},
+			totalSupply: types.NewQuantityFromInt64(1000),
+			expect: model.NetworkSummary{
+				TotalStaked:         types.NewQuantityFromInt64(500),
+				StakingRatio:        0.5,
+				ActiveValidators:    2,
+				NakamotoCoefficient: 1,
+				GiniCoefficient:     0.25,
+				CommissionAvg:       2000,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := computeNetworkSummary(tt.validatorSeqs, tt.totalSupply)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if got.TotalStaked.String() != tt.expect.TotalStaked.String() {
+				t.Errorf("unexpected total staked, want: %s; got: %s", tt.expect.TotalStaked.String(), got.TotalStaked.String())
+			}
+			if math.Abs(got.StakingRatio-tt.expect.StakingRatio) > floatTolerance {
+				t.Errorf("unexpected staking ratio, want: %v; got: %v", tt.expect.StakingRatio, got.StakingRatio)
+			}
+			if got.ActiveValidators != tt.expect.ActiveValidators {
+				t.Errorf("unexpected active validators, want: %d; got: %d", tt.expect.ActiveValidators, got.ActiveValidators)
+			}
+			if got.NakamotoCoefficient != tt.expect.NakamotoCoefficient {
+				t.Errorf("unexpected nakamoto coefficient, want: %d; got: %d", tt.expect.NakamotoCoefficient, got.NakamotoCoefficient)
+			}
+			if math.Abs(got.GiniCoefficient-tt.expect.GiniCoefficient) > floatTolerance {
+				t.Errorf("unexpected gini coefficient, want: %v; got: %v", tt.expect.GiniCoefficient, got.GiniCoefficient)
+			}
+			if math.Abs(got.CommissionAvg-tt.expect.CommissionAvg) > floatTolerance {
+				t.Errorf("unexpected commission average, want: %v; got: %v", tt.expect.CommissionAvg, got.CommissionAvg)
+			}
+		})
+	}
+}
@@ -29,8 +29,7 @@ func NewPurgeWorkerHandler(cfg *config.Config, db *store.Store, c *client.Client
 	}
 }
 
-func (h *purgeWorkerHandler) Handle() {
-	ctx := context.Background()
+func (h *purgeWorkerHandler) Handle(ctx context.Context) {
 
 	logger.Info("running purge use case [handler=worker]")
 
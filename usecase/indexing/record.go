@@ -0,0 +1,80 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrRecordFileRequired = errors.New("file to record responses to is required")
+)
+
+type recordUseCase struct {
+	cfg    *config.Config
+	client *client.Client
+}
+
+func NewRecordUseCase(cfg *config.Config, c *client.Client) *recordUseCase {
+	return &recordUseCase{
+		cfg:    cfg,
+		client: c,
+	}
+}
+
+type RecordUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	File        string
+}
+
+// Execute records all proxy responses used by indexing pipeline for heights in range into bundle file
+func (uc *recordUseCase) Execute(ctx context.Context, useCaseConfig RecordUseCaseConfig) (*client.Bundle, error) {
+	if useCaseConfig.File == "" {
+		return nil, ErrRecordFileRequired
+	}
+
+	startHeight := useCaseConfig.StartHeight
+	if startHeight == 0 {
+		startHeight = uc.cfg.FirstBlockHeight
+	}
+
+	endHeight := useCaseConfig.EndHeight
+	if endHeight == 0 {
+		head, err := uc.client.Chain.GetHead(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endHeight = head.GetHeight()
+	}
+
+	bundle := client.NewBundle(startHeight, endHeight)
+	recordingClient := client.NewRecordingClient(uc.client, bundle)
+
+	if _, err := recordingClient.Chain.GetConstants(ctx); err != nil {
+		return nil, err
+	}
+
+	for height := startHeight; height <= endHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := fetchHeight(ctx, recordingClient, height); err != nil {
+			return nil, errors.Wrapf(err, "could not record height %d", height)
+		}
+
+		if (height-startHeight)%cacheWarmLogInterval == 0 {
+			logger.Info(fmt.Sprintf("recording responses [height=%d] [end=%d]", height, endHeight))
+		}
+	}
+
+	if err := bundle.Save(useCaseConfig.File); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
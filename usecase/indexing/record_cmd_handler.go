@@ -0,0 +1,50 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type RecordCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *recordUseCase
+}
+
+func NewRecordCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *RecordCmdHandler {
+	return &RecordCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *RecordCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, file string) {
+	logger.Info(fmt.Sprintf("running record use case [handler=cmd] [start_height=%d] [end_height=%d] [file=%s]", startHeight, endHeight, file))
+
+	bundle, err := h.getUseCase().Execute(ctx, RecordUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		File:        file,
+	})
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	logger.Info(fmt.Sprintf("recorded %d responses [start=%d] [end=%d] [file=%s]", bundle.Len(), bundle.StartHeight, bundle.EndHeight, file))
+}
+
+func (h *RecordCmdHandler) getUseCase() *recordUseCase {
+	if h.useCase == nil {
+		h.useCase = NewRecordUseCase(h.cfg, h.client)
+	}
+	return h.useCase
+}
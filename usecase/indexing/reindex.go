@@ -27,14 +27,30 @@ type ReindexUseCaseConfig struct {
 	StartHeight int64
 	EndHeight   int64
 	TargetIds   []int64
+	Distributed bool
 }
 
 func (uc *reindexUseCase) Execute(ctx context.Context, useCaseConfig ReindexUseCaseConfig) error {
-	indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.db, uc.client)
+	cachedClient, err := withCache(uc.cfg, uc.client)
 	if err != nil {
 		return err
 	}
 
+	indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, cachedClient)
+	if err != nil {
+		return err
+	}
+
+	if useCaseConfig.Distributed {
+		_, err := indexingPipeline.EnqueueReindex(ctx, indexer.EnqueueReindexConfig{
+			StartHeight: useCaseConfig.StartHeight,
+			EndHeight:   useCaseConfig.EndHeight,
+			TargetIds:   useCaseConfig.TargetIds,
+			ChunkSize:   uc.cfg.ReindexChunkSize,
+		})
+		return err
+	}
+
 	return indexingPipeline.Reindex(ctx, indexer.ReindexConfig{
 		Parallel:    useCaseConfig.Parallel,
 		StartHeight: useCaseConfig.StartHeight,
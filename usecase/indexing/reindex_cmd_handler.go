@@ -2,6 +2,7 @@ package indexing
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/config"
@@ -25,14 +26,15 @@ func NewReindexCmdHandler(cfg *config.Config, db *store.Store, c *client.Client)
 	}
 }
 
-func (h *ReindexCmdHandler) Handle(ctx context.Context, parallel bool, startHeight, endHeight int64, targetIds []int64) {
-	logger.Info("running reindex use case [handler=cmd]")
+func (h *ReindexCmdHandler) Handle(ctx context.Context, parallel bool, startHeight, endHeight int64, targetIds []int64, distributed bool) {
+	logger.Info(fmt.Sprintf("running reindex use case [handler=cmd] [distributed=%t]", distributed))
 
 	useCaseConfig := ReindexUseCaseConfig{
 		Parallel:    parallel,
 		StartHeight: startHeight,
 		EndHeight:   endHeight,
 		TargetIds:   targetIds,
+		Distributed: distributed,
 	}
 	err := h.getUseCase().Execute(ctx, useCaseConfig)
 	if err != nil {
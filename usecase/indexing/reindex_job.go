@@ -0,0 +1,253 @@
+package indexing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/indexer"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrReindexJobLost = errors.New("reindex job was claimed by other worker")
+)
+
+type reindexJobUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	workerID string
+}
+
+func NewReindexJobUseCase(cfg *config.Config, db *store.Store, c *client.Client) *reindexJobUseCase {
+	hostname, _ := os.Hostname()
+
+	return &reindexJobUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+
+		workerID: fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+// Execute claims and processes distributed reindex jobs until there is none left to claim
+func (uc *reindexJobUseCase) Execute(ctx context.Context) error {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("reindex_job"))
+	defer t.ObserveDuration()
+
+	heartbeatInterval, err := time.ParseDuration(uc.cfg.ReindexJobHeartbeat)
+	if err != nil {
+		return err
+	}
+	heartbeatTimeout, err := time.ParseDuration(uc.cfg.ReindexJobTimeout)
+	if err != nil {
+		return err
+	}
+
+	var indexingPipeline reindexChunkRunner
+	for {
+		staleBefore := time.Now().Add(-heartbeatTimeout)
+		if err := uc.failStale(staleBefore); err != nil {
+			return err
+		}
+
+		job, err := uc.db.ReindexJobs.Claim(uc.workerID, staleBefore, uc.cfg.ReindexJobMaxAttempts)
+		if err != nil {
+			if err == store.ErrNotFound {
+				return nil
+			}
+			return err
+		}
+
+		logger.Info(fmt.Sprintf("claimed reindex job [job=%d] [report=%d] [start=%d] [end=%d] [attempt=%d] [worker=%s]", job.ID, job.ReportID, job.StartHeight, job.EndHeight, job.Attempts, uc.workerID))
+
+		// Pipeline is created only after first job is claimed so idle workers do not call proxy
+		if indexingPipeline == nil {
+			if indexingPipeline, err = uc.newPipeline(ctx); err != nil {
+				return uc.release(job, err)
+			}
+		}
+
+		if err := uc.process(ctx, indexingPipeline, job, heartbeatInterval); err != nil {
+			return err
+		}
+	}
+}
+
+type reindexChunkRunner interface {
+	ReindexChunk(context.Context, indexer.ReindexConfig, *model.Report) (int64, error)
+}
+
+func (uc *reindexJobUseCase) newPipeline(ctx context.Context) (reindexChunkRunner, error) {
+	cachedClient, err := withCache(uc.cfg, uc.client)
+	if err != nil {
+		return nil, err
+	}
+	return indexer.NewPipeline(ctx, uc.cfg, uc.db, cachedClient)
+}
+
+// failStale fails jobs abandoned by crashed workers which have no attempts left and records them in their reports
+func (uc *reindexJobUseCase) failStale(staleBefore time.Time) error {
+	jobs, err := uc.db.ReindexJobs.FailStale(staleBefore, uc.cfg.ReindexJobMaxAttempts)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		logger.Warn(fmt.Sprintf("failed abandoned reindex job with no attempts left [job=%d] [report=%d] [attempts=%d]", job.ID, job.ReportID, job.Attempts))
+
+		if err := uc.db.Reports.IncrementCounts(job.ReportID, 0, job.Len()); err != nil {
+			return err
+		}
+		if err := uc.completeReport(job.ReportID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// release gives claimed job back to the queue when it could not be processed
+func (uc *reindexJobUseCase) release(job *model.ReindexJob, err error) error {
+	// Job was not run so claim does not count as attempt
+	job.Attempts--
+	job.Failed(err, uc.cfg.ReindexJobMaxAttempts)
+	if finishErr := uc.finish(job); finishErr != nil {
+		logger.Error(finishErr)
+	}
+	return err
+}
+
+// finish saves job result only when job is still owned by worker
+func (uc *reindexJobUseCase) finish(job *model.ReindexJob) error {
+	err := uc.db.ReindexJobs.Finish(job, uc.workerID)
+	if err == store.ErrNotFound {
+		logger.Warn(fmt.Sprintf("reindex job was claimed by other worker before result was saved [job=%d] [worker=%s]", job.ID, uc.workerID))
+		return ErrReindexJobLost
+	}
+	return err
+}
+
+// process runs job while refreshing its heartbeat and records the result in job and its report
+func (uc *reindexJobUseCase) process(ctx context.Context, indexingPipeline reindexChunkRunner, job *model.ReindexJob, heartbeatInterval time.Duration) error {
+	report, err := uc.db.Reports.FindByID(job.ReportID)
+	if err != nil {
+		return err
+	}
+
+	var targetIds []int64
+	if len(job.TargetIds.RawMessage) > 0 {
+		if err := json.Unmarshal(job.TargetIds.RawMessage, &targetIds); err != nil {
+			return err
+		}
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	lost := make(chan struct{})
+	go uc.heartbeat(jobCtx, cancel, job, heartbeatInterval, lost)
+
+	successCount, err := indexingPipeline.ReindexChunk(jobCtx, indexer.ReindexConfig{
+		StartHeight: job.StartHeight,
+		EndHeight:   job.EndHeight,
+		TargetIds:   targetIds,
+	}, report)
+	cancel()
+
+	select {
+	case <-lost:
+		logger.Warn(fmt.Sprintf("stopped processing reindex job [job=%d] [worker=%s]", job.ID, uc.workerID))
+		return ErrReindexJobLost
+	default:
+	}
+
+	if err != nil {
+		logger.Error(errors.Wrapf(err, "reindex job failed [job=%d] [attempt=%d]", job.ID, job.Attempts))
+		job.Failed(err, uc.cfg.ReindexJobMaxAttempts)
+	} else {
+		job.Completed()
+	}
+
+	if err := uc.finish(job); err != nil {
+		return err
+	}
+
+	if !job.Finished() {
+		return nil
+	}
+
+	if err := uc.db.Reports.IncrementCounts(report.ID, successCount, job.Len()-successCount); err != nil {
+		return err
+	}
+
+	return uc.completeReport(report.ID)
+}
+
+// heartbeat refreshes job heartbeat until context is done. When job was reclaimed by other worker
+// processing is cancelled and lost channel closed.
+func (uc *reindexJobUseCase) heartbeat(ctx context.Context, cancel context.CancelFunc, job *model.ReindexJob, interval time.Duration, lost chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := uc.db.ReindexJobs.Heartbeat(job.ID, uc.workerID)
+			if err == store.ErrNotFound {
+				close(lost)
+				cancel()
+				return
+			}
+			if err != nil {
+				logger.Error(errors.Wrapf(err, "reindex job heartbeat failed [job=%d]", job.ID))
+			}
+		}
+	}
+}
+
+// completeReport completes distributed reindex report once all its jobs are finished
+func (uc *reindexJobUseCase) completeReport(reportID types.ID) error {
+	counts, err := uc.db.ReindexJobs.CountByStatus(reportID)
+	if err != nil {
+		return err
+	}
+	if counts[model.ReindexJobStatusPending] > 0 || counts[model.ReindexJobStatusRunning] > 0 {
+		return nil
+	}
+
+	report, err := uc.db.Reports.FindByID(reportID)
+	if err != nil {
+		return err
+	}
+
+	var successCount, errorCount int64
+	if report.SuccessCount != nil {
+		successCount = *report.SuccessCount
+	}
+	if report.ErrorCount != nil {
+		errorCount = *report.ErrorCount
+	}
+
+	var jobsErr error
+	if failed := counts[model.ReindexJobStatusFailed]; failed > 0 {
+		jobsErr = errors.New(fmt.Sprintf("%d reindex jobs failed", failed))
+	}
+
+	report.Complete(successCount, errorCount, jobsErr)
+
+	logger.Info(fmt.Sprintf("distributed reindex completed [report=%d] [success=%d] [error=%d]", report.ID, successCount, errorCount))
+
+	return uc.db.Reports.Save(report)
+}
@@ -0,0 +1,122 @@
+package indexing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/indexer"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+type reindexChunkRunnerFunc func(context.Context, indexer.ReindexConfig, *model.Report) (int64, error)
+
+func (f reindexChunkRunnerFunc) ReindexChunk(ctx context.Context, cfg indexer.ReindexConfig, report *model.Report) (int64, error) {
+	return f(ctx, cfg, report)
+}
+
+func TestReindexJobUseCase_process(t *testing.T) {
+	const workerID = "worker-1"
+
+	tests := []struct {
+		description  string
+		finishErr    error
+		expectErr    error
+		expectStatus model.ReindexJobStatus
+	}{
+		{description: "saves result of job still owned by worker", expectStatus: model.ReindexJobStatusCompleted},
+		{description: "returns lost error when job was claimed by other worker", finishErr: store.ErrNotFound, expectErr: ErrReindexJobLost},
+		{description: "returns error when result cannot be saved", finishErr: errTestDb, expectErr: errTestDb},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			job := &model.ReindexJob{
+				Model:       &model.Model{ID: 1},
+				ReportID:    2,
+				StartHeight: 10,
+				EndHeight:   19,
+				Status:      model.ReindexJobStatusRunning,
+				Attempts:    1,
+			}
+			report := &model.Report{Model: &model.Model{ID: 2}}
+
+			reportsStoreMock := mock.NewMockReportsStore(ctrl)
+			reportsStoreMock.EXPECT().FindByID(job.ReportID).Return(report, nil).Times(1)
+
+			reindexJobsStoreMock := mock.NewMockReindexJobsStore(ctrl)
+			reindexJobsStoreMock.EXPECT().Finish(job, workerID).Return(tt.finishErr).Times(1)
+
+			if tt.finishErr == nil {
+				reportsStoreMock.EXPECT().IncrementCounts(report.ID, job.Len(), int64(0)).Return(nil).Times(1)
+				reindexJobsStoreMock.EXPECT().CountByStatus(report.ID).Return(map[model.ReindexJobStatus]int64{model.ReindexJobStatusRunning: 1}, nil).Times(1)
+			}
+
+			uc := NewReindexJobUseCase(&config.Config{ReindexJobMaxAttempts: 3}, &store.Store{Reports: reportsStoreMock, ReindexJobs: reindexJobsStoreMock}, nil)
+			uc.workerID = workerID
+
+			runner := reindexChunkRunnerFunc(func(context.Context, indexer.ReindexConfig, *model.Report) (int64, error) {
+				return job.Len(), nil
+			})
+
+			err := uc.process(context.Background(), runner, job, time.Hour)
+			if err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+			}
+			if tt.expectStatus != "" && job.Status != tt.expectStatus {
+				t.Errorf("unexpected job status, want: %s; got: %s", tt.expectStatus, job.Status)
+			}
+		})
+	}
+}
+
+func TestReindexJobUseCase_failStale(t *testing.T) {
+	staleBefore := time.Now()
+
+	tests := []struct {
+		description string
+		jobs        []model.ReindexJob
+		failErr     error
+		expectErr   error
+	}{
+		{description: "does nothing when there are no stale jobs"},
+		{
+			description: "records stale jobs as errors in their reports",
+			jobs: []model.ReindexJob{
+				{Model: &model.Model{ID: 1}, ReportID: 2, StartHeight: 10, EndHeight: 19, Status: model.ReindexJobStatusFailed, Attempts: 3},
+			},
+		},
+		{description: "returns error when stale jobs cannot be failed", failErr: errTestDb, expectErr: errTestDb},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reportsStoreMock := mock.NewMockReportsStore(ctrl)
+			reindexJobsStoreMock := mock.NewMockReindexJobsStore(ctrl)
+			reindexJobsStoreMock.EXPECT().FailStale(staleBefore, int64(3)).Return(tt.jobs, tt.failErr).Times(1)
+
+			for _, job := range tt.jobs {
+				reportsStoreMock.EXPECT().IncrementCounts(job.ReportID, int64(0), job.Len()).Return(nil).Times(1)
+				reindexJobsStoreMock.EXPECT().CountByStatus(job.ReportID).Return(map[model.ReindexJobStatus]int64{model.ReindexJobStatusRunning: 1}, nil).Times(1)
+			}
+
+			uc := NewReindexJobUseCase(&config.Config{ReindexJobMaxAttempts: 3}, &store.Store{Reports: reportsStoreMock, ReindexJobs: reindexJobsStoreMock}, nil)
+
+			if err := uc.failStale(staleBefore); err != tt.expectErr {
+				t.Errorf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
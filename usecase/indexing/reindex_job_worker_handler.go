@@ -0,0 +1,49 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*reindexJobWorkerHandler)(nil)
+)
+
+type reindexJobWorkerHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *reindexJobUseCase
+}
+
+func NewReindexJobWorkerHandler(cfg *config.Config, db *store.Store, c *client.Client) *reindexJobWorkerHandler {
+	return &reindexJobWorkerHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *reindexJobWorkerHandler) Handle(ctx context.Context) {
+
+	logger.Info("running reindex job use case [handler=worker]")
+
+	err := h.getUseCase().Execute(ctx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *reindexJobWorkerHandler) getUseCase() *reindexJobUseCase {
+	if h.useCase == nil {
+		h.useCase = NewReindexJobUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
@@ -0,0 +1,181 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrRollbackHeightRequired = errors.New("end height is required for rollback")
+)
+
+type rollbackUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewRollbackUseCase(cfg *config.Config, db *store.Store, c *client.Client) *rollbackUseCase {
+	return &rollbackUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type RollbackUseCaseConfig struct {
+	EndHeight int64
+	DryRun    bool
+}
+
+// Execute removes indexed data above end height. In dry run mode only number of affected rows is returned.
+func (uc *rollbackUseCase) Execute(ctx context.Context, useCaseConfig RollbackUseCaseConfig) ([]store.RollbackRow, error) {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("rollback"))
+	defer t.ObserveDuration()
+
+	if useCaseConfig.EndHeight <= 0 {
+		return nil, ErrRollbackHeightRequired
+	}
+
+	if useCaseConfig.DryRun {
+		return uc.db.Rollback.Count(useCaseConfig.EndHeight)
+	}
+
+	accountAggs, validatorAggs, err := uc.recomputeAggs(ctx, useCaseConfig.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("rolling back indexed data [end_height=%d] [recomputed_accounts=%d] [recomputed_validators=%d]", useCaseConfig.EndHeight, len(accountAggs), len(validatorAggs)))
+
+	return uc.db.Rollback.Rollback(useCaseConfig.EndHeight, accountAggs, validatorAggs)
+}
+
+// recomputeAggs returns account and validator aggregates updated above height with recent fields set to chain state at height.
+// Chain state is used as sequences at height may be already purged.
+func (uc *rollbackUseCase) recomputeAggs(ctx context.Context, height int64) ([]model.AccountAgg, []model.ValidatorAgg, error) {
+	accountAggs, err := uc.db.AccountAgg.FindAllRecentAfterHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, nil, err
+	}
+
+	validatorAggs, err := uc.db.ValidatorAgg.FindAllRecentAfterHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, nil, err
+	}
+
+	if len(accountAggs) == 0 && len(validatorAggs) == 0 {
+		return nil, nil, nil
+	}
+
+	syncable, err := uc.db.Syncables.FindByHeight(height)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not find syncable at height %d", height)
+	}
+
+	stakingRes, err := uc.client.State.GetStakingByHeight(ctx, height)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "could not fetch staking state at height %d", height)
+	}
+	staking := stakingRes.GetStaking()
+
+	if len(validatorAggs) > 0 {
+		validatorsRes, err := uc.client.Validator.GetByHeight(ctx, height)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not fetch validators at height %d", height)
+		}
+
+		// Rewards are not part of chain state, they are taken from validator sequences when those are not purged yet
+		validatorSeqs, err := uc.db.ValidatorSeq.FindByHeight(height)
+		if err != nil && err != store.ErrNotFound {
+			return nil, nil, err
+		}
+
+		recomputeValidatorAggs(validatorAggs, syncable, staking, validatorsRes.GetValidators(), validatorSeqs)
+	}
+
+	recomputeAccountAggs(accountAggs, syncable, staking)
+
+	return accountAggs, validatorAggs, nil
+}
+
+// recomputeAccountAggs sets recent fields of account aggregates to ledger state at syncable height
+func recomputeAccountAggs(accountAggs []model.AccountAgg, syncable *model.Syncable, staking *statepb.Staking) {
+	ledger := staking.GetLedger()
+
+	for i := range accountAggs {
+		// Account missing from ledger has no balances at height
+		rawAccount := ledger[accountAggs[i].PublicKey]
+
+		accountAggs[i].Update(&model.AccountAgg{
+			Aggregate: &model.Aggregate{
+				RecentAtHeight: syncable.Height,
+				RecentAt:       syncable.Time,
+			},
+
+			RecentGeneralBalance:             types.NewQuantityFromBytes(rawAccount.GetGeneral().GetBalance()),
+			RecentGeneralNonce:               rawAccount.GetGeneral().GetNonce(),
+			RecentEscrowActiveBalance:        types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetBalance()),
+			RecentEscrowActiveTotalShares:    types.NewQuantityFromBytes(rawAccount.GetEscrow().GetActive().GetTotalShares()),
+			RecentEscrowDebondingBalance:     types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetBalance()),
+			RecentEscrowDebondingTotalShares: types.NewQuantityFromBytes(rawAccount.GetEscrow().GetDebonding().GetTotalShares()),
+		})
+	}
+}
+
+// recomputeValidatorAggs sets recent fields of validator aggregates to chain state at syncable height.
+// Validators which are not in validator set at height keep their tendermint address and commission and get no voting power.
+func recomputeValidatorAggs(validatorAggs []model.ValidatorAgg, syncable *model.Syncable, staking *statepb.Staking, rawValidators []*validatorpb.Validator, validatorSeqs []model.ValidatorSeq) {
+	rawValidatorsByEntity := map[string]*validatorpb.Validator{}
+	for _, rawValidator := range rawValidators {
+		rawValidatorsByEntity[rawValidator.GetNode().GetEntityId()] = rawValidator
+	}
+
+	rewardsByAddress := map[string]types.Quantity{}
+	for _, seq := range validatorSeqs {
+		rewardsByAddress[seq.Address] = seq.Rewards
+	}
+
+	for i := range validatorAggs {
+		agg := &validatorAggs[i]
+
+		agg.RecentAtHeight = syncable.Height
+		agg.RecentAt = syncable.Time
+
+		if rawValidator, ok := rawValidatorsByEntity[agg.EntityUID]; ok {
+			agg.RecentTendermintAddress = rawValidator.GetTendermintAddress()
+			agg.RecentVotingPower = rawValidator.GetVotingPower()
+			agg.RecentCommission = types.NewQuantityFromBytes(rawValidator.GetCommission())
+			agg.RecentAsValidatorHeight = syncable.Height
+		} else {
+			agg.RecentVotingPower = 0
+			if agg.RecentAsValidatorHeight > syncable.Height {
+				// Last height as validator below rollback height is not known, first one is the closest known
+				agg.RecentAsValidatorHeight = agg.StartedAtHeight
+			}
+		}
+
+		totalShares := big.NewInt(0)
+		if delegations, ok := staking.GetDelegations()[agg.Address]; ok {
+			for _, d := range delegations.Entries {
+				shares := types.NewQuantityFromBytes(d.Shares)
+				totalShares = totalShares.Add(totalShares, &shares.Int)
+			}
+		}
+		agg.RecentTotalShares = types.NewQuantity(totalShares)
+		agg.RecentActiveEscrowBalance = types.NewQuantityFromBytes(staking.GetLedger()[agg.Address].GetEscrow().GetActive().GetBalance())
+		agg.RecentRewards = rewardsByAddress[agg.Address]
+	}
+}
@@ -0,0 +1,56 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type RollbackCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *rollbackUseCase
+}
+
+func NewRollbackCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *RollbackCmdHandler {
+	return &RollbackCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *RollbackCmdHandler) Handle(ctx context.Context, endHeight int64, dryRun bool) error {
+	logger.Info(fmt.Sprintf("running rollback use case [handler=cmd] [end_height=%d] [dry_run=%t]", endHeight, dryRun))
+
+	rows, err := h.getUseCase().Execute(ctx, RollbackUseCaseConfig{
+		EndHeight: endHeight,
+		DryRun:    dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Printf("=== Rollback above height %d (dry run) ===\n", endHeight)
+	} else {
+		fmt.Printf("=== Rollback above height %d ===\n", endHeight)
+	}
+	for _, row := range rows {
+		fmt.Printf("%-32s %-8s %d\n", row.Table, row.Action, row.Count)
+	}
+	return nil
+}
+
+func (h *RollbackCmdHandler) getUseCase() *rollbackUseCase {
+	if h.useCase == nil {
+		h.useCase = NewRollbackUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
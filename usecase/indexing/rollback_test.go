@@ -0,0 +1,244 @@
+package indexing
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/account/accountpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/delegation/delegationpb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasis-rpc-proxy/grpc/validator/validatorpb"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock_client "github.com/figment-networks/oasishub-indexer/mock/client"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+var (
+	errTestDb     = errors.New("errTestDb")
+	errTestClient = errors.New("errTestClient")
+)
+
+func TestRollbackUseCase_recomputeAggs(t *testing.T) {
+	const height int64 = 100
+	syncableTime := *types.NewTimeFromTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	accountAggs := func() []model.AccountAgg {
+		return []model.AccountAgg{
+			{
+				Model:                &model.Model{ID: 1},
+				Aggregate:            &model.Aggregate{StartedAtHeight: 10, RecentAtHeight: 120},
+				PublicKey:            "key1",
+				RecentGeneralBalance: types.NewQuantityFromInt64(500),
+				RecentGeneralNonce:   7,
+			},
+			{
+				Model:                &model.Model{ID: 2},
+				Aggregate:            &model.Aggregate{StartedAtHeight: 20, RecentAtHeight: 110},
+				PublicKey:            "key2",
+				RecentGeneralBalance: types.NewQuantityFromInt64(300),
+			},
+		}
+	}
+
+	validatorAggs := func() []model.ValidatorAgg {
+		return []model.ValidatorAgg{
+			{
+				Model:                   &model.Model{ID: 1},
+				Aggregate:               &model.Aggregate{StartedAtHeight: 10, RecentAtHeight: 120},
+				Address:                 "key1",
+				EntityUID:               "entity1",
+				RecentTendermintAddress: "tm-old",
+				RecentVotingPower:       50,
+				RecentTotalShares:       types.NewQuantityFromInt64(5000),
+				RecentAsValidatorHeight: 120,
+			},
+			{
+				Model:                   &model.Model{ID: 2},
+				Aggregate:               &model.Aggregate{StartedAtHeight: 30, RecentAtHeight: 115},
+				Address:                 "key2",
+				EntityUID:               "entity2",
+				RecentTendermintAddress: "tm2",
+				RecentVotingPower:       20,
+				RecentCommission:        types.NewQuantityFromInt64(3),
+				RecentAsValidatorHeight: 115,
+			},
+		}
+	}
+
+	staking := &statepb.Staking{
+		Ledger: map[string]*accountpb.Account{
+			"key1": {
+				General: &accountpb.GeneralAccount{Balance: big.NewInt(400).Bytes(), Nonce: 5},
+				Escrow: &accountpb.EscrowAccount{
+					Active:    &accountpb.SharePool{Balance: big.NewInt(1000).Bytes(), TotalShares: big.NewInt(900).Bytes()},
+					Debonding: &accountpb.SharePool{Balance: big.NewInt(50).Bytes(), TotalShares: big.NewInt(40).Bytes()},
+				},
+			},
+		},
+		Delegations: map[string]*delegationpb.DelegationEntry{
+			"key1": {Entries: map[string]*delegationpb.Delegation{
+				"delegator1": {Shares: big.NewInt(600).Bytes()},
+				"delegator2": {Shares: big.NewInt(300).Bytes()},
+			}},
+		},
+	}
+
+	// Only entity1 is in validator set at height
+	rawValidators := []*validatorpb.Validator{{
+		Address:           "key1",
+		TendermintAddress: "tm1",
+		VotingPower:       40,
+		Commission:        big.NewInt(2).Bytes(),
+		Node:              &validatorpb.Node{EntityId: "entity1"},
+	}}
+
+	tests := []struct {
+		description          string
+		accountAggs          []model.AccountAgg
+		accountAggsErr       error
+		validatorAggs        []model.ValidatorAgg
+		validatorAggsErr     error
+		syncableErr          error
+		clientErr            error
+		validatorSeqs        []model.ValidatorSeq
+		expectErr            bool
+		expectStakingCall    bool
+		expectValidatorsCall bool
+	}{
+		{description: "returns nothing when no aggregate was updated above height", accountAggsErr: store.ErrNotFound, validatorAggsErr: store.ErrNotFound},
+		{description: "returns error when accounts cannot be loaded", accountAggsErr: errTestDb, expectErr: true},
+		{description: "returns error when validators cannot be loaded", validatorAggsErr: errTestDb, expectErr: true},
+		{description: "returns error when syncable is missing", accountAggs: accountAggs(), syncableErr: store.ErrNotFound, expectErr: true},
+		{description: "returns error when staking state cannot be fetched", accountAggs: accountAggs(), clientErr: errTestClient, expectErr: true, expectStakingCall: true},
+		{description: "sets account recent fields to ledger state at height", accountAggs: accountAggs(), expectStakingCall: true},
+		{description: "sets validator recent fields to chain state at height", validatorAggs: validatorAggs(), expectStakingCall: true, expectValidatorsCall: true},
+		{
+			description:          "sets validator rewards from sequences which were not purged",
+			validatorAggs:        validatorAggs(),
+			validatorSeqs:        []model.ValidatorSeq{{Address: "key1", Rewards: types.NewQuantityFromInt64(7)}},
+			expectStakingCall:    true,
+			expectValidatorsCall: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			accountAggMock := mock.NewMockAccountAggStore(ctrl)
+			validatorAggMock := mock.NewMockValidatorAggStore(ctrl)
+			validatorSeqMock := mock.NewMockValidatorSeqStore(ctrl)
+			syncablesMock := mock.NewMockSyncablesStore(ctrl)
+			stateMock := mock_client.NewMockStateClient(ctrl)
+			validatorMock := mock_client.NewMockValidatorClient(ctrl)
+
+			accountAggMock.EXPECT().FindAllRecentAfterHeight(height).Return(tt.accountAggs, tt.accountAggsErr).Times(1)
+			if tt.accountAggsErr == nil || tt.accountAggsErr == store.ErrNotFound {
+				validatorAggMock.EXPECT().FindAllRecentAfterHeight(height).Return(tt.validatorAggs, tt.validatorAggsErr).Times(1)
+			}
+			if tt.accountAggs != nil || tt.validatorAggs != nil {
+				syncablesMock.EXPECT().FindByHeight(height).Return(&model.Syncable{Height: height, Time: syncableTime}, tt.syncableErr).Times(1)
+			}
+			if tt.expectStakingCall {
+				stateMock.EXPECT().GetStakingByHeight(gomock.Any(), height).Return(&statepb.GetStakingByHeightResponse{Staking: staking}, tt.clientErr).Times(1)
+			}
+			if tt.expectValidatorsCall {
+				validatorMock.EXPECT().GetByHeight(gomock.Any(), height).Return(&validatorpb.GetByHeightResponse{Validators: rawValidators}, nil).Times(1)
+				validatorSeqMock.EXPECT().FindByHeight(height).Return(tt.validatorSeqs, nil).Times(1)
+			}
+
+			uc := NewRollbackUseCase(
+				&config.Config{},
+				&store.Store{AccountAgg: accountAggMock, ValidatorAgg: validatorAggMock, ValidatorSeq: validatorSeqMock, Syncables: syncablesMock},
+				&client.Client{State: stateMock, Validator: validatorMock},
+			)
+			accountResult, validatorResult, err := uc.recomputeAggs(context.Background(), height)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(accountResult) != len(tt.accountAggs) {
+				t.Fatalf("unexpected account count, want %v; got %v", len(tt.accountAggs), len(accountResult))
+			}
+			if len(validatorResult) != len(tt.validatorAggs) {
+				t.Fatalf("unexpected validator count, want %v; got %v", len(tt.validatorAggs), len(validatorResult))
+			}
+
+			for _, agg := range accountResult {
+				if agg.RecentAtHeight != height || !agg.RecentAt.Equal(syncableTime) {
+					t.Errorf("unexpected recent at for %s: %v %v", agg.PublicKey, agg.RecentAtHeight, agg.RecentAt)
+				}
+			}
+			if len(accountResult) > 0 {
+				key1 := accountResult[0]
+				if key1.StartedAtHeight != 10 {
+					t.Errorf("unexpected started at height, want %v; got %v", 10, key1.StartedAtHeight)
+				}
+				if !key1.RecentGeneralBalance.Equals(types.NewQuantityFromInt64(400)) || key1.RecentGeneralNonce != 5 {
+					t.Errorf("unexpected general account: %v %v", key1.RecentGeneralBalance, key1.RecentGeneralNonce)
+				}
+				if !key1.RecentEscrowActiveBalance.Equals(types.NewQuantityFromInt64(1000)) || !key1.RecentEscrowDebondingTotalShares.Equals(types.NewQuantityFromInt64(40)) {
+					t.Errorf("unexpected escrow account: %v %v", key1.RecentEscrowActiveBalance, key1.RecentEscrowDebondingTotalShares)
+				}
+
+				// key2 is missing from ledger at height
+				key2 := accountResult[1]
+				if !key2.RecentGeneralBalance.IsZero() {
+					t.Errorf("unexpected general balance for account missing from ledger: %v", key2.RecentGeneralBalance)
+				}
+			}
+
+			for _, agg := range validatorResult {
+				if agg.RecentAtHeight != height || !agg.RecentAt.Equal(syncableTime) {
+					t.Errorf("unexpected recent at for %s: %v %v", agg.EntityUID, agg.RecentAtHeight, agg.RecentAt)
+				}
+			}
+			if len(validatorResult) > 0 {
+				entity1 := validatorResult[0]
+				if entity1.RecentTendermintAddress != "tm1" || entity1.RecentVotingPower != 40 || !entity1.RecentCommission.Equals(types.NewQuantityFromInt64(2)) {
+					t.Errorf("unexpected validator state: %v %v %v", entity1.RecentTendermintAddress, entity1.RecentVotingPower, entity1.RecentCommission)
+				}
+				if entity1.RecentAsValidatorHeight != height {
+					t.Errorf("unexpected recent as validator height, want %v; got %v", height, entity1.RecentAsValidatorHeight)
+				}
+				if !entity1.RecentTotalShares.Equals(types.NewQuantityFromInt64(900)) || !entity1.RecentActiveEscrowBalance.Equals(types.NewQuantityFromInt64(1000)) {
+					t.Errorf("unexpected validator staking: %v %v", entity1.RecentTotalShares, entity1.RecentActiveEscrowBalance)
+				}
+				expectRewards := types.NewQuantityFromInt64(0)
+				if len(tt.validatorSeqs) > 0 {
+					expectRewards = tt.validatorSeqs[0].Rewards
+				}
+				if !entity1.RecentRewards.Equals(expectRewards) {
+					t.Errorf("unexpected rewards, want %v; got %v", expectRewards, entity1.RecentRewards)
+				}
+
+				// entity2 is not in validator set at height
+				entity2 := validatorResult[1]
+				if entity2.RecentVotingPower != 0 || entity2.RecentTendermintAddress != "tm2" || !entity2.RecentCommission.Equals(types.NewQuantityFromInt64(3)) {
+					t.Errorf("unexpected state of validator out of set: %v %v %v", entity2.RecentVotingPower, entity2.RecentTendermintAddress, entity2.RecentCommission)
+				}
+				if entity2.RecentAsValidatorHeight != 30 {
+					t.Errorf("unexpected recent as validator height, want %v; got %v", 30, entity2.RecentAsValidatorHeight)
+				}
+				if !entity2.RecentTotalShares.IsZero() {
+					t.Errorf("unexpected total shares of validator without delegations: %v", entity2.RecentTotalShares)
+				}
+			}
+		})
+	}
+}
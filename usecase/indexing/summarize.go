@@ -55,6 +55,22 @@ func (uc *summarizeUseCase) Execute(ctx context.Context) error {
 		return err
 	}
 
+	if err := uc.summarizeStakingSeq(types.IntervalHourly, currentIndexVersion); err != nil {
+		return err
+	}
+
+	if err := uc.summarizeStakingSeq(types.IntervalDaily, currentIndexVersion); err != nil {
+		return err
+	}
+
+	if err := uc.summarizeNetwork(types.IntervalHourly, currentIndexVersion); err != nil {
+		return err
+	}
+
+	if err := uc.summarizeNetwork(types.IntervalDaily, currentIndexVersion); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -253,3 +269,149 @@ func (uc *summarizeUseCase) summarizeBalanceEvents(interval types.SummaryInterva
 	logger.Info(fmt.Sprintf("balance events summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
 	return nil
 }
+
+func (uc *summarizeUseCase) summarizeStakingSeq(interval types.SummaryInterval, currentIndexVersion int64) error {
+	logger.Info(fmt.Sprintf("summarizing staking sequences... [interval=%s]", interval))
+
+	activityPeriods, err := uc.db.StakingSummary.FindActivityPeriods(interval, currentIndexVersion)
+	if err != nil {
+		return err
+	}
+
+	rawSummaryItems, err := uc.db.StakingSeq.Summarize(interval, activityPeriods)
+	if err != nil {
+		return err
+	}
+
+	var newModels []model.StakingSummary
+	var existingModels []model.StakingSummary
+	for _, rawSummary := range rawSummaryItems {
+		summary := &model.Summary{
+			TimeInterval: interval,
+			TimeBucket:   rawSummary.TimeBucket,
+			IndexVersion: currentIndexVersion,
+		}
+		query := model.StakingSummary{
+			Summary: summary,
+		}
+
+		existingStakingSummary, err := uc.db.StakingSummary.Find(&query)
+		if err != nil {
+			if err == store.ErrNotFound {
+				stakingSummary := model.StakingSummary{
+					Summary: summary,
+
+					TotalSupplyAvg:         rawSummary.TotalSupplyAvg,
+					TotalSupplyMax:         rawSummary.TotalSupplyMax,
+					TotalSupplyMin:         rawSummary.TotalSupplyMin,
+					CommonPoolAvg:          rawSummary.CommonPoolAvg,
+					CommonPoolMax:          rawSummary.CommonPoolMax,
+					CommonPoolMin:          rawSummary.CommonPoolMin,
+					DebondingIntervalMax:   rawSummary.DebondingIntervalMax,
+					MinDelegationAmountMax: rawSummary.MinDelegationAmountMax,
+				}
+				if err := uc.db.StakingSummary.Create(&stakingSummary); err != nil {
+					return err
+				}
+				newModels = append(newModels, stakingSummary)
+			} else {
+				return err
+			}
+		} else {
+			existingStakingSummary.TotalSupplyAvg = rawSummary.TotalSupplyAvg
+			existingStakingSummary.TotalSupplyMax = rawSummary.TotalSupplyMax
+			existingStakingSummary.TotalSupplyMin = rawSummary.TotalSupplyMin
+			existingStakingSummary.CommonPoolAvg = rawSummary.CommonPoolAvg
+			existingStakingSummary.CommonPoolMax = rawSummary.CommonPoolMax
+			existingStakingSummary.CommonPoolMin = rawSummary.CommonPoolMin
+			existingStakingSummary.DebondingIntervalMax = rawSummary.DebondingIntervalMax
+			existingStakingSummary.MinDelegationAmountMax = rawSummary.MinDelegationAmountMax
+
+			if err := uc.db.StakingSummary.Save(existingStakingSummary); err != nil {
+				return err
+			}
+			existingModels = append(existingModels, *existingStakingSummary)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("staking sequences summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
+
+	return nil
+}
+
+func (uc *summarizeUseCase) summarizeNetwork(interval types.SummaryInterval, currentIndexVersion int64) error {
+	logger.Info(fmt.Sprintf("summarizing network... [interval=%s]", interval))
+
+	// Most recent time bucket is recalculated since it might have been incomplete
+	var since types.Time
+	mostRecentSummary, err := uc.db.NetworkSummary.FindMostRecentByInterval(interval)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return err
+		}
+	} else {
+		since = mostRecentSummary.TimeBucket
+	}
+
+	rows, err := uc.db.ValidatorSeq.FindLastHeightsByInterval(interval, &since)
+	if err != nil {
+		return err
+	}
+
+	var newModels []model.NetworkSummary
+	var existingModels []model.NetworkSummary
+	for _, row := range rows {
+		validatorSeqs, err := uc.db.ValidatorSeq.FindByHeight(row.Height)
+		if err != nil {
+			return err
+		}
+
+		totalSupply := types.NewQuantityFromInt64(0)
+		stakingSeq, err := uc.db.StakingSeq.FindByHeight(row.Height)
+		if err != nil {
+			if err != store.ErrNotFound {
+				return err
+			}
+		} else {
+			totalSupply = stakingSeq.TotalSupply
+		}
+
+		networkSummary, err := computeNetworkSummary(validatorSeqs, totalSupply)
+		if err != nil {
+			return err
+		}
+		networkSummary.Height = row.Height
+
+		summary := &model.Summary{
+			TimeInterval: interval,
+			TimeBucket:   row.TimeBucket,
+			IndexVersion: currentIndexVersion,
+		}
+		query := model.NetworkSummary{
+			Summary: summary,
+		}
+
+		existingNetworkSummary, err := uc.db.NetworkSummary.Find(&query)
+		if err != nil {
+			if err == store.ErrNotFound {
+				networkSummary.Summary = summary
+				if err := uc.db.NetworkSummary.Create(networkSummary); err != nil {
+					return err
+				}
+				newModels = append(newModels, *networkSummary)
+			} else {
+				return err
+			}
+		} else {
+			existingNetworkSummary.Update(*networkSummary)
+			if err := uc.db.NetworkSummary.Save(existingNetworkSummary); err != nil {
+				return err
+			}
+			existingModels = append(existingModels, *existingNetworkSummary)
+		}
+	}
+
+	logger.Info(fmt.Sprintf("network summarized [created=%d] [updated=%d]", len(newModels), len(existingModels)))
+
+	return nil
+}
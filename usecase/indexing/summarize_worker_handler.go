@@ -29,8 +29,7 @@ func NewSummarizeWorkerHandler(cfg *config.Config, db *store.Store, c *client.Cl
 	}
 }
 
-func (h *summarizeWorkerHandler) Handle() {
-	ctx := context.Background()
+func (h *summarizeWorkerHandler) Handle(ctx context.Context) {
 
 	logger.Info("running summarize use case [handler=worker]")
 
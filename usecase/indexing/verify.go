@@ -0,0 +1,181 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/figment-networks/indexing-engine/metrics"
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/indexer"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidVerifyRunningGracePeriod = errors.New("invalid verify running grace period")
+)
+
+type verifyUseCase struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+}
+
+func NewVerifyUseCase(cfg *config.Config, db *store.Store, c *client.Client) *verifyUseCase {
+	return &verifyUseCase{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type VerifyUseCaseConfig struct {
+	StartHeight int64
+	EndHeight   int64
+	Repair      bool
+}
+
+// HeightRange is a range of consecutive heights, both ends included
+type HeightRange struct {
+	StartHeight int64
+	EndHeight   int64
+}
+
+func (r HeightRange) String() string {
+	if r.StartHeight == r.EndHeight {
+		return fmt.Sprint(r.StartHeight)
+	}
+	return fmt.Sprintf("%d-%d", r.StartHeight, r.EndHeight)
+}
+
+// Execute finds heights missing in syncables and sequences or left running between start and end height.
+// In repair mode those heights are reindexed.
+// Heights which may be processed by running index or reindex are skipped: heights above last processed height
+// and syncables started within grace period. Sequences older than purge threshold are not checked as they may be purged.
+func (uc *verifyUseCase) Execute(ctx context.Context, useCaseConfig VerifyUseCaseConfig) ([]store.VerifyRow, error) {
+	t := metrics.NewTimer(indexerUseCaseDuration.WithLabels("verify"))
+	defer t.ObserveDuration()
+
+	gracePeriod, err := time.ParseDuration(uc.cfg.VerifyRunningGracePeriod)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidVerifyRunningGracePeriod, err.Error())
+	}
+
+	startHeight := useCaseConfig.StartHeight
+	if startHeight <= 0 {
+		startHeight = uc.cfg.FirstBlockHeight
+	}
+
+	lastProcessedHeight, err := uc.db.Verify.FindLastProcessedHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	endHeight := useCaseConfig.EndHeight
+	if endHeight <= 0 || endHeight > lastProcessedHeight {
+		endHeight = lastProcessedHeight
+	}
+	if endHeight < startHeight {
+		logger.Info(fmt.Sprintf("nothing to verify [start_height=%d] [last_processed_height=%d]", startHeight, lastProcessedHeight))
+		return nil, nil
+	}
+
+	sequencesStartHeight, err := uc.sequencesStartHeight(lastProcessedHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(fmt.Sprintf("verifying indexed heights [start_height=%d] [end_height=%d] [sequences_start_height=%d]", startHeight, endHeight, sequencesStartHeight))
+
+	rows, err := uc.db.Verify.Verify(startHeight, endHeight, sequencesStartHeight, time.Now().Add(-gracePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	var heights []int64
+	for _, row := range rows {
+		if len(row.Heights) == 0 {
+			continue
+		}
+		logger.Warn(fmt.Sprintf("found %s heights [table=%s] [count=%d] [ranges=%v]", row.Issue, row.Table, len(row.Heights), heightRanges(row.Heights)))
+		heights = append(heights, row.Heights...)
+	}
+
+	if !useCaseConfig.Repair || len(heights) == 0 {
+		return rows, nil
+	}
+
+	return rows, uc.repair(ctx, heightRanges(heights))
+}
+
+// sequencesStartHeight returns first height which sequences are not purged, purge threshold is computed
+// the same way as in purge use case but from last processed height, so it is never earlier than the one used by purge
+func (uc *verifyUseCase) sequencesStartHeight(lastProcessedHeight int64) (int64, error) {
+	purgeInterval, err := time.ParseDuration(uc.cfg.PurgeSequencesInterval)
+	if err != nil {
+		return 0, err
+	}
+	if purgeInterval == 0 {
+		return 0, nil
+	}
+
+	lastProcessed, err := uc.db.Syncables.FindByHeight(lastProcessedHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	height, err := uc.db.Verify.FindFirstHeightSince(lastProcessed.Time.Add(-purgeInterval))
+	if err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// repair reindexes given height ranges with all targets of current index version
+func (uc *verifyUseCase) repair(ctx context.Context, ranges []HeightRange) error {
+	cachedClient, err := withCache(uc.cfg, uc.client)
+	if err != nil {
+		return err
+	}
+
+	indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, cachedClient)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range ranges {
+		logger.Info(fmt.Sprintf("repairing heights [start_height=%d] [end_height=%d]", r.StartHeight, r.EndHeight))
+
+		err := indexingPipeline.Reindex(ctx, indexer.ReindexConfig{
+			StartHeight: r.StartHeight,
+			EndHeight:   r.EndHeight,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// heightRanges groups heights into ranges of consecutive heights, duplicates are ignored
+func heightRanges(heights []int64) []HeightRange {
+	sorted := make([]int64, len(heights))
+	copy(sorted, heights)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var ranges []HeightRange
+	for _, height := range sorted {
+		if n := len(ranges); n > 0 && height <= ranges[n-1].EndHeight+1 {
+			if height > ranges[n-1].EndHeight {
+				ranges[n-1].EndHeight = height
+			}
+			continue
+		}
+		ranges = append(ranges, HeightRange{StartHeight: height, EndHeight: height})
+	}
+	return ranges
+}
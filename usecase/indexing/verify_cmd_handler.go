@@ -0,0 +1,54 @@
+package indexing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type VerifyCmdHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *verifyUseCase
+}
+
+func NewVerifyCmdHandler(cfg *config.Config, db *store.Store, c *client.Client) *VerifyCmdHandler {
+	return &VerifyCmdHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *VerifyCmdHandler) Handle(ctx context.Context, startHeight int64, endHeight int64, repair bool) {
+	logger.Info(fmt.Sprintf("running verify use case [handler=cmd] [start_height=%d] [end_height=%d] [repair=%t]", startHeight, endHeight, repair))
+
+	rows, err := h.getUseCase().Execute(ctx, VerifyUseCaseConfig{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Repair:      repair,
+	})
+
+	fmt.Println("=== Verify ===")
+	for _, row := range rows {
+		fmt.Printf("%-32s %-8s %-8d %v\n", row.Table, row.Issue, len(row.Heights), heightRanges(row.Heights))
+	}
+
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *VerifyCmdHandler) getUseCase() *verifyUseCase {
+	if h.useCase == nil {
+		h.useCase = NewVerifyUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
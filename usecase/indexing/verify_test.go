@@ -0,0 +1,213 @@
+package indexing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+func TestHeightRanges(t *testing.T) {
+	tests := []struct {
+		description string
+		heights     []int64
+		expect      []HeightRange
+	}{
+		{description: "returns nothing for no heights", heights: nil, expect: nil},
+		{description: "returns single height range", heights: []int64{5}, expect: []HeightRange{{5, 5}}},
+		{description: "groups consecutive heights", heights: []int64{1, 2, 3}, expect: []HeightRange{{1, 3}}},
+		{description: "splits heights with gaps", heights: []int64{1, 2, 4, 7, 8}, expect: []HeightRange{{1, 2}, {4, 4}, {7, 8}}},
+		{description: "ignores duplicates", heights: []int64{3, 3, 4, 4, 4, 9, 9}, expect: []HeightRange{{3, 4}, {9, 9}}},
+		{description: "merges adjacent ranges from different tables", heights: []int64{10, 11, 12, 13, 14, 12, 13}, expect: []HeightRange{{10, 14}}},
+		{description: "sorts unsorted heights", heights: []int64{8, 1, 7, 2}, expect: []HeightRange{{1, 2}, {7, 8}}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			heights := append([]int64(nil), tt.heights...)
+
+			got := heightRanges(tt.heights)
+
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("unexpected ranges, want: %v; got: %v", tt.expect, got)
+			}
+			if !reflect.DeepEqual(tt.heights, heights) {
+				t.Errorf("input heights should not be modified, want: %v; got: %v", heights, tt.heights)
+			}
+		})
+	}
+}
+
+func TestVerifyUseCase_Execute(t *testing.T) {
+	const gracePeriod = time.Hour
+
+	rows := []store.VerifyRow{
+		{Table: "syncables", Issue: store.VerifyIssueMissing, Heights: []int64{12, 13}},
+		{Table: "block_sequences", Issue: store.VerifyIssueMissing, Heights: []int64{13, 14}},
+		{Table: "syncables", Issue: store.VerifyIssueRunning, Heights: nil},
+	}
+
+	tests := []struct {
+		description         string
+		gracePeriod         string
+		startHeight         int64
+		endHeight           int64
+		lastProcessedHeight int64
+		lastProcessedErr    error
+		verifyErr           error
+		expectStartHeight   int64
+		expectEndHeight     int64
+		expectVerify        bool
+		expectErr           bool
+	}{
+		{description: "returns error when grace period is invalid", gracePeriod: "invalid", expectErr: true},
+		{description: "returns error when last processed height cannot be loaded", gracePeriod: "1h", lastProcessedErr: errTestDb, expectErr: true},
+		{description: "defaults heights to first block height and last processed height", gracePeriod: "1h", lastProcessedHeight: 20, expectStartHeight: 1, expectEndHeight: 20, expectVerify: true},
+		{description: "verifies given heights", gracePeriod: "1h", startHeight: 5, endHeight: 15, lastProcessedHeight: 20, expectStartHeight: 5, expectEndHeight: 15, expectVerify: true},
+		{description: "skips heights above last processed height", gracePeriod: "1h", startHeight: 5, endHeight: 30, lastProcessedHeight: 20, expectStartHeight: 5, expectEndHeight: 20, expectVerify: true},
+		{description: "skips verify when start height is above last processed height", gracePeriod: "1h", startHeight: 25, endHeight: 30, lastProcessedHeight: 20},
+		{description: "skips verify when nothing is processed yet", gracePeriod: "1h"},
+		{description: "returns error when verify fails", gracePeriod: "1h", lastProcessedHeight: 20, verifyErr: errTestDb, expectStartHeight: 1, expectEndHeight: 20, expectVerify: true, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			verifyStoreMock := mock.NewMockVerifyStore(ctrl)
+			if tt.gracePeriod != "invalid" {
+				verifyStoreMock.EXPECT().FindLastProcessedHeight().Return(tt.lastProcessedHeight, tt.lastProcessedErr).Times(1)
+			}
+
+			var cutoff time.Time
+			if tt.expectVerify {
+				verifyStoreMock.EXPECT().
+					Verify(tt.expectStartHeight, tt.expectEndHeight, int64(0), gomock.Any()).
+					DoAndReturn(func(_, _, _ int64, runningStartedBefore time.Time) ([]store.VerifyRow, error) {
+						cutoff = runningStartedBefore
+						if tt.verifyErr != nil {
+							return nil, tt.verifyErr
+						}
+						return rows, nil
+					}).
+					Times(1)
+			}
+
+			uc := NewVerifyUseCase(
+				&config.Config{FirstBlockHeight: 1, VerifyRunningGracePeriod: tt.gracePeriod, PurgeSequencesInterval: "0"},
+				&store.Store{Verify: verifyStoreMock},
+				nil,
+			)
+
+			before := time.Now()
+			got, err := uc.Execute(context.Background(), VerifyUseCaseConfig{StartHeight: tt.startHeight, EndHeight: tt.endHeight})
+			after := time.Now()
+
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if !tt.expectVerify {
+				if got != nil {
+					t.Errorf("expected no rows, got: %v", got)
+				}
+				return
+			}
+
+			if !reflect.DeepEqual(got, rows) {
+				t.Errorf("unexpected rows, want: %v; got: %v", rows, got)
+			}
+			if cutoff.Before(before.Add(-gracePeriod)) || cutoff.After(after.Add(-gracePeriod)) {
+				t.Errorf("running syncables should be checked when started before grace period, got cutoff: %v", cutoff)
+			}
+		})
+	}
+}
+
+func TestVerifyUseCase_ExecutePurgedRange(t *testing.T) {
+	const lastProcessedHeight int64 = 100
+	lastProcessedTime := time.Date(2020, 10, 2, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description                string
+		firstUnpurgedHeight        int64
+		firstUnpurgedErr           error
+		syncableErr                error
+		expectSequencesStartHeight int64
+		expectErr                  bool
+	}{
+		{description: "checks sequences only above purge threshold", firstUnpurgedHeight: 80, expectSequencesStartHeight: 80},
+		{description: "returns error when last processed syncable cannot be loaded", syncableErr: store.ErrNotFound, expectErr: true},
+		{description: "returns error when purge threshold height cannot be loaded", firstUnpurgedErr: errTestDb, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncablesStoreMock := mock.NewMockSyncablesStore(ctrl)
+			syncablesStoreMock.EXPECT().
+				FindByHeight(lastProcessedHeight).
+				Return(&model.Syncable{Height: lastProcessedHeight, Time: *types.NewTimeFromTime(lastProcessedTime)}, tt.syncableErr).
+				Times(1)
+
+			verifyStoreMock := mock.NewMockVerifyStore(ctrl)
+			verifyStoreMock.EXPECT().FindLastProcessedHeight().Return(lastProcessedHeight, nil).Times(1)
+			if tt.syncableErr == nil {
+				verifyStoreMock.EXPECT().FindFirstHeightSince(lastProcessedTime.Add(-24*time.Hour)).Return(tt.firstUnpurgedHeight, tt.firstUnpurgedErr).Times(1)
+			}
+
+			// Heights below purge threshold have syncables but no sequences, store reports nothing for them
+			rows := []store.VerifyRow{
+				{Table: "syncables", Issue: store.VerifyIssueMissing, Heights: []int64{}},
+				{Table: "block_sequences", Issue: store.VerifyIssueMissing, Heights: []int64{}},
+				{Table: "validator_sequences", Issue: store.VerifyIssueMissing, Heights: []int64{}},
+				{Table: "syncables", Issue: store.VerifyIssueRunning, Heights: []int64{}},
+			}
+			if !tt.expectErr {
+				verifyStoreMock.EXPECT().Verify(int64(1), lastProcessedHeight, tt.expectSequencesStartHeight, gomock.Any()).Return(rows, nil).Times(1)
+			}
+
+			uc := NewVerifyUseCase(
+				&config.Config{FirstBlockHeight: 1, VerifyRunningGracePeriod: "1h", PurgeSequencesInterval: "24h"},
+				&store.Store{Syncables: syncablesStoreMock, Verify: verifyStoreMock},
+				nil,
+			)
+
+			// Repair is not run as purged heights are not reported, nil client would fail otherwise
+			got, err := uc.Execute(context.Background(), VerifyUseCaseConfig{Repair: true})
+			if tt.expectErr {
+				if err == nil {
+					t.Errorf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, rows) {
+				t.Errorf("unexpected rows, want: %v; got: %v", rows, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package indexing
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*verifyWorkerHandler)(nil)
+)
+
+type verifyWorkerHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *verifyUseCase
+}
+
+func NewVerifyWorkerHandler(cfg *config.Config, db *store.Store, c *client.Client) *verifyWorkerHandler {
+	return &verifyWorkerHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *verifyWorkerHandler) Handle(ctx context.Context) {
+
+	logger.Info("running verify use case [handler=worker]")
+
+	_, err := h.getUseCase().Execute(ctx, VerifyUseCaseConfig{
+		Repair: h.cfg.VerifyRepair,
+	})
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *verifyWorkerHandler) getUseCase() *verifyUseCase {
+	if h.useCase == nil {
+		h.useCase = NewVerifyUseCase(h.cfg, h.db, h.client)
+	}
+	return h.useCase
+}
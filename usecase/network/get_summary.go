@@ -0,0 +1,21 @@
+package network
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type getSummaryUseCase struct {
+	db *store.Store
+}
+
+func NewGetSummaryUseCase(db *store.Store) *getSummaryUseCase {
+	return &getSummaryUseCase{
+		db: db,
+	}
+}
+
+func (uc *getSummaryUseCase) Execute(interval types.SummaryInterval, start, end *types.Time) ([]model.NetworkSummary, error) {
+	return uc.db.NetworkSummary.FindSummariesByInterval(interval, start, end)
+}
@@ -0,0 +1,68 @@
+package network
+
+import (
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getSummaryHttpHandler)(nil)
+
+	ErrInvalidInterval = errors.New("invalid interval")
+)
+
+type getSummaryHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getSummaryUseCase
+}
+
+func NewGetSummaryHttpHandler(db *store.Store, c *client.Client) *getSummaryHttpHandler {
+	return &getSummaryHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetSummaryRequest struct {
+	Start    time.Time             `form:"start" binding:"-" time_format:"2006-01-02"`
+	End      time.Time             `form:"end" binding:"-" time_format:"2006-01-02"`
+	Interval types.SummaryInterval `form:"interval" binding:"-"`
+}
+
+func (h *getSummaryHttpHandler) Handle(c *gin.Context) {
+	var req GetSummaryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid start, end or/and interval"))
+		return
+	}
+
+	if req.Interval == "" {
+		req.Interval = types.IntervalDaily
+	}
+	if !req.Interval.Valid() {
+		http.BadRequest(c, ErrInvalidInterval)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Interval, types.NewTimeFromTime(req.Start), types.NewTimeFromTime(req.End))
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getSummaryHttpHandler) getUseCase() *getSummaryUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetSummaryUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/pkg/errors"
@@ -18,7 +20,7 @@ func NewGetByHeightUseCase(db *store.Store, c *client.Client) *getByHeightUseCas
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(height *int64) (*DetailsView, error) {
+func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64) (*DetailsView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -35,7 +37,16 @@ func (uc *getByHeightUseCase) Execute(height *int64) (*DetailsView, error) {
 		return nil, errors.New("height is not indexed yet")
 	}
 
-	res, err := uc.client.State.GetStakingByHeight(*height)
+	// Staking is served from database for indexed heights
+	stakingSeq, err := uc.db.StakingSeq.FindByHeight(*height)
+	if err == nil {
+		return ToDetailsViewFromSeq(stakingSeq), nil
+	}
+	if err != store.ErrNotFound {
+		return nil, err
+	}
+
+	res, err := uc.client.State.GetStakingByHeight(ctx, *height)
 	if err != nil {
 		return nil, err
 	}
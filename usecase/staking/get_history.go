@@ -0,0 +1,21 @@
+package staking
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type getHistoryUseCase struct {
+	db *store.Store
+}
+
+func NewGetHistoryUseCase(db *store.Store) *getHistoryUseCase {
+	return &getHistoryUseCase{
+		db: db,
+	}
+}
+
+func (uc *getHistoryUseCase) Execute(interval types.SummaryInterval, start, end *types.Time) ([]model.StakingSummary, error) {
+	return uc.db.StakingSummary.FindSummariesByInterval(interval, start, end)
+}
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/state/statepb"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
 )
 
@@ -20,3 +21,12 @@ func ToDetailsView(rawStaking *statepb.Staking) *DetailsView {
 		MinDelegationAmount: types.NewQuantityFromBytes(rawStaking.GetParameters().GetMinDelegationAmount()),
 	}
 }
+
+func ToDetailsViewFromSeq(stakingSeq *model.StakingSeq) *DetailsView {
+	return &DetailsView{
+		TotalSupply:         stakingSeq.TotalSupply,
+		CommonPool:          stakingSeq.CommonPool,
+		DebondingInterval:   stakingSeq.DebondingInterval,
+		MinDelegationAmount: stakingSeq.MinDelegationAmount,
+	}
+}
@@ -0,0 +1,244 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+const (
+	maxHeightsPerPoll = 100
+)
+
+// Subscriber receives messages matching its filter
+type Subscriber struct {
+	filter   Filter
+	messages chan Message
+}
+
+// Messages returns channel with messages pushed to subscriber
+func (s *Subscriber) Messages() <-chan Message {
+	return s.messages
+}
+
+// Broker polls database for newly processed heights and pushes messages to subscribers.
+// Heights are tracked by processing order rather than by height, so heights processed
+// out of order (e.g. by a parallel reindex) are streamed as well.
+type Broker struct {
+	cfg *config.Config
+	db  *store.Store
+
+	mu          sync.RWMutex
+	subscribers map[*Subscriber]struct{}
+
+	interval        time.Duration
+	lastProcessedAt types.Time
+	lastID          types.ID
+}
+
+func NewBroker(cfg *config.Config, db *store.Store) *Broker {
+	return &Broker{
+		cfg: cfg,
+		db:  db,
+
+		subscribers: make(map[*Subscriber]struct{}),
+	}
+}
+
+// Subscribe registers new subscriber
+func (b *Broker) Subscribe(filter Filter) *Subscriber {
+	s := &Subscriber{
+		filter:   filter,
+		messages: make(chan Message, b.cfg.StreamBufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	return s
+}
+
+// Unsubscribe removes subscriber
+func (b *Broker) Unsubscribe(s *Subscriber) {
+	b.mu.Lock()
+	delete(b.subscribers, s)
+	b.mu.Unlock()
+}
+
+// Init parses poll interval and positions broker after the most recently processed syncable
+func (b *Broker) Init() error {
+	interval, err := time.ParseDuration(b.cfg.StreamPollInterval)
+	if err != nil {
+		return fmt.Errorf("invalid stream poll interval: %w", err)
+	}
+	if interval <= 0 {
+		return fmt.Errorf("invalid stream poll interval: %s", b.cfg.StreamPollInterval)
+	}
+	b.interval = interval
+
+	syncable, err := b.db.Syncables.FindMostRecentProcessed()
+	if err != nil {
+		if err == store.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	b.advance(*syncable)
+
+	return nil
+}
+
+// Start polls for new heights until context is done. Init must be called first.
+func (b *Broker) Start(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := b.poll(); err != nil {
+				logger.Error(fmt.Errorf("stream poll failed: %w", err))
+			}
+		}
+	}
+}
+
+// poll pushes messages for heights processed since last poll
+func (b *Broker) poll() error {
+	syncables, err := b.db.Syncables.FindProcessedSince(b.lastProcessedAt, b.lastID, maxHeightsPerPoll)
+	if err != nil {
+		return err
+	}
+
+	for _, syncable := range syncables {
+		if b.subscribersCount() > 0 {
+			msgs, err := b.getMessages(syncable.Height)
+			if err != nil {
+				return err
+			}
+
+			for _, msg := range msgs {
+				b.publish(msg)
+			}
+		}
+
+		b.advance(syncable)
+	}
+
+	return nil
+}
+
+// advance moves poll cursor past given syncable
+func (b *Broker) advance(syncable model.Syncable) {
+	if syncable.ProcessedAt != nil {
+		b.lastProcessedAt = *syncable.ProcessedAt
+	}
+	if syncable.Model != nil {
+		b.lastID = syncable.ID
+	}
+}
+
+func (b *Broker) getMessages(height int64) ([]Message, error) {
+	var msgs []Message
+
+	blockSeq, err := b.db.BlockSeq.FindByHeight(height)
+	if err != nil {
+		if err != store.ErrNotFound {
+			return nil, err
+		}
+	} else {
+		msgs = append(msgs, NewBlockMessage(*blockSeq))
+	}
+
+	change, err := b.getValidatorSetChange(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(change.Joined) > 0 || len(change.Left) > 0 {
+		msgs = append(msgs, NewValidatorSetMessage(height, *change))
+	}
+
+	systemEvents, err := b.db.SystemEvents.FindByHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+	for _, systemEvent := range systemEvents {
+		msgs = append(msgs, NewSystemEventMessage(systemEvent))
+	}
+
+	return msgs, nil
+}
+
+// getValidatorSetChange compares validators at given height with validators at previous height
+func (b *Broker) getValidatorSetChange(height int64) (*ValidatorSetChange, error) {
+	change := &ValidatorSetChange{}
+	if height <= b.cfg.FirstBlockHeight {
+		return change, nil
+	}
+
+	currValidatorSeqs, err := b.db.ValidatorSeq.FindByHeight(height)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+	prevValidatorSeqs, err := b.db.ValidatorSeq.FindByHeight(height - 1)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+	if len(currValidatorSeqs) == 0 || len(prevValidatorSeqs) == 0 {
+		return change, nil
+	}
+
+	change.Joined = diffAddresses(currValidatorSeqs, prevValidatorSeqs)
+	change.Left = diffAddresses(prevValidatorSeqs, currValidatorSeqs)
+	return change, nil
+}
+
+// publish pushes message to all matching subscribers. Messages are dropped for subscribers which are not keeping up.
+func (b *Broker) publish(msg Message) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subscribers {
+		if !s.filter.Matches(msg) {
+			continue
+		}
+
+		select {
+		case s.messages <- msg:
+		default:
+			logger.Debug(fmt.Sprintf("stream subscriber buffer full, dropping message [topic=%s] [height=%d]", msg.Topic, msg.Height))
+		}
+	}
+}
+
+func (b *Broker) subscribersCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers)
+}
+
+// diffAddresses returns addresses present in a but not in b
+func diffAddresses(a []model.ValidatorSeq, b []model.ValidatorSeq) []string {
+	addresses := make(map[string]struct{}, len(b))
+	for _, seq := range b {
+		addresses[seq.Address] = struct{}{}
+	}
+
+	var diff []string
+	for _, seq := range a {
+		if _, ok := addresses[seq.Address]; !ok {
+			diff = append(diff, seq.Address)
+		}
+	}
+	return diff
+}
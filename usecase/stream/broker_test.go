@@ -0,0 +1,215 @@
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+var (
+	errTestDb = errors.New("errTestDb")
+)
+
+func testProcessedSyncable(id int64, height int64, processedAt time.Time) model.Syncable {
+	return model.Syncable{
+		Model:       &model.Model{ID: types.ID(id)},
+		Height:      height,
+		ProcessedAt: types.NewTimeFromTime(processedAt),
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	blockMsg := NewBlockMessage(model.BlockSeq{Sequence: &model.Sequence{Height: 10}})
+	validatorSetMsg := NewValidatorSetMessage(10, ValidatorSetChange{Joined: []string{"address1"}, Left: []string{"address2"}})
+	systemEventMsg := NewSystemEventMessage(model.SystemEvent{Height: 10, Actor: "address3"})
+
+	tests := []struct {
+		description string
+		filter      Filter
+		msg         Message
+		expected    bool
+	}{
+		{"matches any message when filter is empty", Filter{}, systemEventMsg, true},
+		{"matches message with subscribed topic", Filter{Topics: []Topic{TopicBlock, TopicSystemEvent}}, systemEventMsg, true},
+		{"does not match message with other topic", Filter{Topics: []Topic{TopicBlock}}, systemEventMsg, false},
+		{"matches system event for address", Filter{Address: "address3"}, systemEventMsg, true},
+		{"does not match system event for other address", Filter{Address: "address1"}, systemEventMsg, false},
+		{"matches validator set change for joined address", Filter{Address: "address1"}, validatorSetMsg, true},
+		{"matches validator set change for left address", Filter{Address: "address2"}, validatorSetMsg, true},
+		{"does not match validator set change for other address", Filter{Address: "address3"}, validatorSetMsg, false},
+		{"ignores address for block messages", Filter{Address: "address1"}, blockMsg, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.msg); got != tt.expected {
+				t.Errorf("unexpected result, want %v; got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBroker_Init(t *testing.T) {
+	processedAt := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		pollInterval    string
+		result          *model.Syncable
+		dbErr           error
+		expectErr       bool
+		expectCursorID  types.ID
+		expectCursorSet bool
+	}{
+		{description: "returns error for invalid poll interval", pollInterval: "every second", expectErr: true},
+		{description: "returns error for non positive poll interval", pollInterval: "0s", expectErr: true},
+		{description: "returns error when cursor cannot be loaded", pollInterval: "1s", dbErr: errTestDb, expectErr: true},
+		{description: "starts from beginning when nothing is processed", pollInterval: "1s", dbErr: store.ErrNotFound},
+		{description: "starts after most recently processed syncable", pollInterval: "1s", result: func() *model.Syncable {
+			s := testProcessedSyncable(7, 10, processedAt)
+			return &s
+		}(), expectCursorID: 7, expectCursorSet: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			syncablesMock := mock.NewMockSyncablesStore(ctrl)
+			if tt.result != nil || tt.dbErr != nil {
+				syncablesMock.EXPECT().FindMostRecentProcessed().Return(tt.result, tt.dbErr).Times(1)
+			}
+
+			b := NewBroker(&config.Config{StreamPollInterval: tt.pollInterval}, &store.Store{Syncables: syncablesMock})
+			err := b.Init()
+			if tt.expectErr != (err != nil) {
+				t.Fatalf("unexpected error, want error %v; got %v", tt.expectErr, err)
+			}
+			if b.lastID != tt.expectCursorID {
+				t.Errorf("unexpected cursor id, want %v; got %v", tt.expectCursorID, b.lastID)
+			}
+			if tt.expectCursorSet != !b.lastProcessedAt.IsZero() {
+				t.Errorf("unexpected cursor processed at: %v", b.lastProcessedAt)
+			}
+		})
+	}
+}
+
+func TestBroker_poll(t *testing.T) {
+	processedAt := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &config.Config{StreamBufferSize: 10, FirstBlockHeight: 1000}
+
+	t.Run("streams heights processed out of height order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncablesMock := mock.NewMockSyncablesStore(ctrl)
+		blockSeqMock := mock.NewMockBlockSeqStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		// height 5 was reindexed after height 20 was indexed
+		syncables := []model.Syncable{
+			testProcessedSyncable(20, 20, processedAt),
+			testProcessedSyncable(5, 5, processedAt.Add(time.Second)),
+		}
+		syncablesMock.EXPECT().FindProcessedSince(types.Time{}, types.ID(0), int64(maxHeightsPerPoll)).Return(syncables, nil).Times(1)
+		for _, height := range []int64{20, 5} {
+			blockSeqMock.EXPECT().FindByHeight(height).Return(&model.BlockSeq{Sequence: &model.Sequence{Height: height}}, nil).Times(1)
+			systemEventsMock.EXPECT().FindByHeight(height).Return(nil, nil).Times(1)
+		}
+		syncablesMock.EXPECT().FindProcessedSince(*types.NewTimeFromTime(processedAt.Add(time.Second)), types.ID(5), int64(maxHeightsPerPoll)).Return(nil, nil).Times(1)
+
+		b := NewBroker(cfg, &store.Store{Syncables: syncablesMock, BlockSeq: blockSeqMock, SystemEvents: systemEventsMock})
+		subscriber := b.Subscribe(Filter{Topics: []Topic{TopicBlock}})
+
+		if err := b.poll(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := b.poll(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, height := range []int64{20, 5} {
+			select {
+			case msg := <-subscriber.Messages():
+				if msg.Height != height {
+					t.Errorf("unexpected message height, want %v; got %v", height, msg.Height)
+				}
+			default:
+				t.Fatalf("missing message for height %v", height)
+			}
+		}
+	})
+
+	t.Run("does not advance cursor on error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		syncablesMock := mock.NewMockSyncablesStore(ctrl)
+		blockSeqMock := mock.NewMockBlockSeqStore(ctrl)
+
+		syncablesMock.EXPECT().FindProcessedSince(types.Time{}, types.ID(0), int64(maxHeightsPerPoll)).Return([]model.Syncable{testProcessedSyncable(20, 20, processedAt)}, nil).Times(1)
+		blockSeqMock.EXPECT().FindByHeight(int64(20)).Return(nil, errTestDb).Times(1)
+
+		b := NewBroker(cfg, &store.Store{Syncables: syncablesMock, BlockSeq: blockSeqMock})
+		b.Subscribe(Filter{})
+
+		if err := b.poll(); err != errTestDb {
+			t.Errorf("unexpected error, want %v; got %v", errTestDb, err)
+		}
+		if b.lastID != 0 {
+			t.Errorf("unexpected cursor id, want %v; got %v", 0, b.lastID)
+		}
+	})
+}
+
+func TestBroker_publish(t *testing.T) {
+	b := NewBroker(&config.Config{StreamBufferSize: 1}, nil)
+
+	all := b.Subscribe(Filter{})
+	blocks := b.Subscribe(Filter{Topics: []Topic{TopicBlock}})
+	removed := b.Subscribe(Filter{})
+	b.Unsubscribe(removed)
+
+	b.publish(NewSystemEventMessage(model.SystemEvent{Height: 10, Actor: "address1"}))
+	// buffer of subscriber is full, message should be dropped instead of blocking
+	b.publish(NewSystemEventMessage(model.SystemEvent{Height: 11, Actor: "address1"}))
+
+	if len(all.Messages()) != 1 {
+		t.Errorf("unexpected message count, want %v; got %v", 1, len(all.Messages()))
+	}
+	if msg := <-all.Messages(); msg.Height != 10 {
+		t.Errorf("unexpected message height, want %v; got %v", 10, msg.Height)
+	}
+	if len(blocks.Messages()) != 0 {
+		t.Errorf("unexpected message count, want %v; got %v", 0, len(blocks.Messages()))
+	}
+	if len(removed.Messages()) != 0 {
+		t.Errorf("unexpected message count, want %v; got %v", 0, len(removed.Messages()))
+	}
+}
+
+func TestDiffAddresses(t *testing.T) {
+	seqs := func(addresses ...string) []model.ValidatorSeq {
+		var result []model.ValidatorSeq
+		for _, address := range addresses {
+			result = append(result, model.ValidatorSeq{Address: address})
+		}
+		return result
+	}
+
+	diff := diffAddresses(seqs("address1", "address2", "address3"), seqs("address2"))
+	if len(diff) != 2 || diff[0] != "address1" || diff[1] != "address3" {
+		t.Errorf("unexpected diff, want %v; got %v", []string{"address1", "address3"}, diff)
+	}
+}
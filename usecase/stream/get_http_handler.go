@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	heartbeatInterval = 15 * time.Second
+)
+
+var (
+	_ types.HttpHandler = (*getHttpHandler)(nil)
+)
+
+type getHttpHandler struct {
+	// ctx is done when server shuts down, it stops the broker and open streams
+	ctx    context.Context
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	brokerMu sync.Mutex
+	broker   *Broker
+}
+
+func NewGetHttpHandler(ctx context.Context, cfg *config.Config, db *store.Store, c *client.Client) *getHttpHandler {
+	return &getHttpHandler{
+		ctx:    ctx,
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type GetRequest struct {
+	Topics  string `form:"topics" binding:"-"`
+	Address string `form:"address" binding:"-"`
+}
+
+func (h *getHttpHandler) Handle(c *gin.Context) {
+	var req GetRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid topics or/and address"))
+		return
+	}
+
+	filter := Filter{Address: req.Address}
+	if req.Topics != "" {
+		for _, t := range strings.Split(req.Topics, ",") {
+			topic := Topic(strings.TrimSpace(t))
+			if !topic.Valid() {
+				http.BadRequest(c, errors.New("invalid topic"))
+				return
+			}
+			filter.Topics = append(filter.Topics, topic)
+		}
+	}
+
+	broker, err := h.getBroker()
+	if err != nil {
+		logger.Error(err)
+		http.ServerError(c, errors.New("stream is unavailable"))
+		return
+	}
+	subscriber := broker.Subscribe(filter)
+	defer broker.Unsubscribe(subscriber)
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-h.ctx.Done():
+			return false
+		case msg := <-subscriber.Messages():
+			c.SSEvent(string(msg.Topic), msg)
+		case <-heartbeat.C:
+			c.SSEvent("heartbeat", time.Now().Unix())
+		}
+		return true
+	})
+}
+
+// getBroker creates broker and starts polling for new heights on first request.
+// Failed initialization is retried on subsequent requests.
+func (h *getHttpHandler) getBroker() (*Broker, error) {
+	h.brokerMu.Lock()
+	defer h.brokerMu.Unlock()
+
+	if h.broker != nil {
+		return h.broker, nil
+	}
+
+	broker := NewBroker(h.cfg, h.db)
+	if err := broker.Init(); err != nil {
+		return nil, errors.Wrap(err, "stream broker init failed")
+	}
+	go broker.Start(h.ctx)
+
+	h.broker = broker
+	return h.broker, nil
+}
@@ -0,0 +1,41 @@
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/golang/mock/gomock"
+)
+
+func TestGetHttpHandler_getBroker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncablesMock := mock.NewMockSyncablesStore(ctrl)
+	gomock.InOrder(
+		syncablesMock.EXPECT().FindMostRecentProcessed().Return(nil, errTestDb).Times(1),
+		syncablesMock.EXPECT().FindMostRecentProcessed().Return(nil, store.ErrNotFound).Times(1),
+	)
+
+	h := NewGetHttpHandler(context.Background(), &config.Config{StreamPollInterval: "1h"}, &store.Store{Syncables: syncablesMock}, nil)
+
+	if _, err := h.getBroker(); err == nil {
+		t.Fatal("expected error when broker init fails")
+	}
+
+	broker, err := h.getBroker()
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+
+	again, err := h.getBroker()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again != broker {
+		t.Error("expected broker to be reused once started")
+	}
+}
@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+)
+
+const (
+	TopicBlock        Topic = "block"
+	TopicValidatorSet Topic = "validator_set"
+	TopicSystemEvent  Topic = "system_event"
+)
+
+var (
+	allTopics = []Topic{TopicBlock, TopicValidatorSet, TopicSystemEvent}
+)
+
+type Topic string
+
+func (t Topic) Valid() bool {
+	for _, topic := range allTopics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Message is pushed to stream subscribers
+type Message struct {
+	Topic  Topic       `json:"topic"`
+	Height int64       `json:"height"`
+	Data   interface{} `json:"data"`
+
+	// addresses message relates to, used for filtering
+	addresses []string
+}
+
+type ValidatorSetChange struct {
+	Joined []string `json:"joined"`
+	Left   []string `json:"left"`
+}
+
+func NewBlockMessage(blockSeq model.BlockSeq) Message {
+	return Message{
+		Topic:  TopicBlock,
+		Height: blockSeq.Height,
+		Data:   blockSeq,
+	}
+}
+
+func NewValidatorSetMessage(height int64, change ValidatorSetChange) Message {
+	return Message{
+		Topic:     TopicValidatorSet,
+		Height:    height,
+		Data:      change,
+		addresses: append(append([]string{}, change.Joined...), change.Left...),
+	}
+}
+
+func NewSystemEventMessage(systemEvent model.SystemEvent) Message {
+	return Message{
+		Topic:     TopicSystemEvent,
+		Height:    systemEvent.Height,
+		Data:      systemEvent,
+		addresses: []string{systemEvent.Actor},
+	}
+}
+
+// Filter decides which messages are pushed to subscriber
+type Filter struct {
+	Topics  []Topic
+	Address string
+}
+
+// Matches returns true if message should be pushed to subscriber.
+// Address filter applies only to messages related to addresses.
+func (f Filter) Matches(msg Message) bool {
+	if len(f.Topics) > 0 {
+		found := false
+		for _, topic := range f.Topics {
+			if topic == msg.Topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if f.Address == "" || msg.Topic == TopicBlock {
+		return true
+	}
+
+	for _, address := range msg.addresses {
+		if address == f.Address {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package systemevent
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 )
 
 type getForAddressUseCase struct {
@@ -15,14 +16,17 @@ func NewGetForAddressUseCase(db *store.Store) *getForAddressUseCase {
 	}
 }
 
-func (uc *getForAddressUseCase) Execute(address string, minHeight *int64, kind *model.SystemEventKind) (*ListView, error) {
-	systemEvents, err := uc.db.SystemEvents.FindByActor(address, store.FindSystemEventByActorQuery{
+func (uc *getForAddressUseCase) Execute(address string, minHeight *int64, kind *model.SystemEventKind, pageReq http.PageRequest) (*ListView, error) {
+	systemEvents, total, err := uc.db.SystemEvents.FindByActor(address, store.FindSystemEventByActorQuery{
 		Kind:      kind,
 		MinHeight: minHeight,
+		Order:     pageReq.OrderBy(pageReq.Sort),
+		Limit:     pageReq.QueryLimit(),
+		Offset:    pageReq.Offset(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return ToListView(systemEvents), nil
+	return ToListView(systemEvents, pageReq, total), nil
 }
@@ -32,6 +32,8 @@ type GetForAddressRequest struct {
 	Address string                 `uri:"address" binding:"required"`
 	After   *int64                 `form:"after" binding:"-"`
 	Kind    *model.SystemEventKind `form:"kind" binding:"-"`
+
+	http.PageRequest
 }
 
 func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
@@ -41,11 +43,15 @@ func (h *getForAddressHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 	if err := c.ShouldBindQuery(&req); err != nil {
-		http.BadRequest(c, errors.New("invalid kind or/and after"))
+		http.BadRequest(c, errors.New("invalid kind, after, page, limit, sort or/and order"))
+		return
+	}
+	if err := req.PageRequest.ValidateOptional(ListSortFields, "height", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Address, req.After, req.Kind)
+	resp, err := h.getUseCase().Execute(req.Address, req.After, req.Kind, req.PageRequest)
 	if http.ShouldReturn(c, err) {
 		return
 	}
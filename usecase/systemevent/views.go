@@ -3,6 +3,12 @@ package systemevent
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	// ListSortFields are sortable columns of system events
+	ListSortFields = []string{"height", "kind"}
 )
 
 type ListItem struct {
@@ -17,9 +23,11 @@ type ListItem struct {
 
 type ListView struct {
 	Items []ListItem `json:"items"`
+
+	http.Pagination
 }
 
-func ToListView(validators []model.SystemEvent) *ListView {
+func ToListView(validators []model.SystemEvent, pageReq http.PageRequest, total int64) *ListView {
 	var items []ListItem
 	for _, m := range validators {
 		item := ListItem{
@@ -35,5 +43,7 @@ func ToListView(validators []model.SystemEvent) *ListView {
 
 	return &ListView{
 		Items: items,
+
+		Pagination: http.NewPagination(pageReq, total),
 	}
 }
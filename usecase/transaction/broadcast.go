@@ -1,6 +1,8 @@
 package transaction
 
 import (
+	"context"
+
 	"github.com/figment-networks/oasishub-indexer/client"
 	"github.com/figment-networks/oasishub-indexer/store"
 )
@@ -17,8 +19,8 @@ func NewBroadcastUseCase(db *store.Store, c *client.Client) *broadcastUseCase {
 	}
 }
 
-func (uc *broadcastUseCase) Execute(txRaw string) (*bool, error) {
-	res, err := uc.client.Transaction.Broadcast(txRaw)
+func (uc *broadcastUseCase) Execute(ctx context.Context, txRaw string) (*bool, error) {
+	res, err := uc.client.Transaction.Broadcast(ctx, txRaw)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (h *broadcastHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	submitted, err := h.getUseCase().Execute(req.TxRaw)
+	submitted, err := h.getUseCase().Execute(c.Request.Context(), req.TxRaw)
 	if http.ShouldReturn(c, err) {
 		return
 	}
@@ -38,7 +38,7 @@ func (h *getByHeightHttpHandler) Handle(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.getUseCase().Execute(req.Height)
+	resp, err := h.getUseCase().Execute(c.Request.Context(), req.Height)
 	if http.ShouldReturn(c, err) {
 		return
 	}
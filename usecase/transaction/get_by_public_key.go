@@ -0,0 +1,29 @@
+package transaction
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+type getByPublicKeyUseCase struct {
+	db *store.Store
+}
+
+func NewGetByPublicKeyUseCase(db *store.Store) *getByPublicKeyUseCase {
+	return &getByPublicKeyUseCase{
+		db: db,
+	}
+}
+
+func (uc *getByPublicKeyUseCase) Execute(publicKey string, pageReq http.PageRequest, query store.FindTransactionsByPublicKeyQuery) (*SeqListView, error) {
+	query.Order = pageReq.OrderBy(pageReq.Sort)
+	query.Limit = pageReq.Limit
+	query.Offset = pageReq.Offset()
+
+	transactions, total, err := uc.db.TransactionSeq.FindByPublicKey(publicKey, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToSeqListView(transactions, pageReq, total), nil
+}
@@ -0,0 +1,103 @@
+package transaction
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const publicKeySize = 32
+
+var (
+	_ types.HttpHandler = (*getByPublicKeyHttpHandler)(nil)
+
+	ErrInvalidPublicKey = errors.New("invalid public key: must be 32 bytes in base64, URL-safe base64 or hex encoding")
+)
+
+type getByPublicKeyHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getByPublicKeyUseCase
+}
+
+func NewGetByPublicKeyHttpHandler(db *store.Store, c *client.Client) *getByPublicKeyHttpHandler {
+	return &getByPublicKeyHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetByPublicKeyRequest struct {
+	PublicKey   string    `uri:"public_key" binding:"required"`
+	Method      *string   `form:"method" binding:"-"`
+	StartHeight *int64    `form:"start_height" binding:"-"`
+	EndHeight   *int64    `form:"end_height" binding:"-"`
+	StartTime   time.Time `form:"start_time" binding:"-" time_format:"2006-01-02 15:04:05"`
+	EndTime     time.Time `form:"end_time" binding:"-" time_format:"2006-01-02 15:04:05"`
+
+	http.PageRequest
+}
+
+func (h *getByPublicKeyHttpHandler) Handle(c *gin.Context) {
+	var req GetByPublicKeyRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid public key"))
+		return
+	}
+	publicKey, err := normalizePublicKey(req.PublicKey)
+	if err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid method, page, limit, sort, order, height or/and time range: time must be in format \"2006-01-02 15:04:05\""))
+		return
+	}
+	if err := req.PageRequest.Validate(SeqListSortFields, "height", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(publicKey, req.PageRequest, store.FindTransactionsByPublicKeyQuery{
+		Method:      req.Method,
+		StartHeight: req.StartHeight,
+		EndHeight:   req.EndHeight,
+		StartTime:   types.NewTimeFromTime(req.StartTime),
+		EndTime:     types.NewTimeFromTime(req.EndTime),
+	})
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getByPublicKeyHttpHandler) getUseCase() *getByPublicKeyUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetByPublicKeyUseCase(h.db)
+	}
+	return h.useCase
+}
+
+// normalizePublicKey converts public key to standard base64 encoding in which keys are stored.
+// Standard base64 keys may contain "/" which cannot be passed in path, so URL-safe base64 and hex encodings are accepted too.
+func normalizePublicKey(key string) (string, error) {
+	raw, err := hex.DecodeString(key)
+	if err != nil {
+		key = strings.NewReplacer("-", "+", "_", "/").Replace(strings.TrimRight(key, "="))
+		raw, err = base64.RawStdEncoding.DecodeString(key)
+	}
+	if err != nil || len(raw) != publicKeySize {
+		return "", ErrInvalidPublicKey
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
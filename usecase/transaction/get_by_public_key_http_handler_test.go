@@ -0,0 +1,129 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestNormalizePublicKey(t *testing.T) {
+	raw := bytes.Repeat([]byte{0xfb, 0xff}, publicKeySize/2)
+	stdKey := base64.StdEncoding.EncodeToString(raw)
+
+	tests := []struct {
+		description string
+		key         string
+		expectErr   error
+	}{
+		{description: "accepts standard base64", key: stdKey},
+		{description: "accepts URL-safe base64", key: base64.URLEncoding.EncodeToString(raw)},
+		{description: "accepts URL-safe base64 without padding", key: base64.RawURLEncoding.EncodeToString(raw)},
+		{description: "accepts hex", key: hex.EncodeToString(raw)},
+		{description: "rejects invalid encoding", key: "not a key", expectErr: ErrInvalidPublicKey},
+		{description: "rejects key of invalid size", key: base64.StdEncoding.EncodeToString(raw[:16]), expectErr: ErrInvalidPublicKey},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := normalizePublicKey(tt.key)
+			if err != tt.expectErr {
+				t.Fatalf("unexpected error, want: %v; got: %v", tt.expectErr, err)
+			}
+			if tt.expectErr == nil && got != stdKey {
+				t.Errorf("unexpected key, want: %s; got: %s", stdKey, got)
+			}
+		})
+	}
+}
+
+func TestGetByPublicKeyHttpHandler_Handle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	raw := bytes.Repeat([]byte{0xfb, 0xff}, publicKeySize/2)
+	stdKey := base64.StdEncoding.EncodeToString(raw)
+	urlKey := base64.RawURLEncoding.EncodeToString(raw)
+
+	tests := []struct {
+		description  string
+		key          string
+		query        string
+		expectStatus int
+		expectQuery  func(*testing.T, store.FindTransactionsByPublicKeyQuery)
+	}{
+		{
+			description:  "finds transactions of normalized key with default pagination",
+			key:          urlKey,
+			expectStatus: nethttp.StatusOK,
+			expectQuery: func(t *testing.T, q store.FindTransactionsByPublicKeyQuery) {
+				if q.Method != nil || q.StartHeight != nil || q.EndHeight != nil {
+					t.Errorf("unexpected filters: %+v", q)
+				}
+				if q.Order != "height desc" || q.Limit != 100 || q.Offset != 0 {
+					t.Errorf("unexpected pagination, got: %s %d %d", q.Order, q.Limit, q.Offset)
+				}
+			},
+		},
+		{
+			description:  "passes method, height and time range filters",
+			key:          hex.EncodeToString(raw),
+			query:        "method=staking.Transfer&start_height=10&end_height=20&start_time=2020-01-02+03:04:05&end_time=2020-02-03+04:05:06&page=2&limit=10&sort=nonce&order=asc",
+			expectStatus: nethttp.StatusOK,
+			expectQuery: func(t *testing.T, q store.FindTransactionsByPublicKeyQuery) {
+				if q.Method == nil || *q.Method != "staking.Transfer" {
+					t.Errorf("unexpected method: %v", q.Method)
+				}
+				if q.StartHeight == nil || *q.StartHeight != 10 || q.EndHeight == nil || *q.EndHeight != 20 {
+					t.Errorf("unexpected height range: %v - %v", q.StartHeight, q.EndHeight)
+				}
+				if q.StartTime.Format("2006-01-02 15:04:05") != "2020-01-02 03:04:05" || q.EndTime.Format("2006-01-02 15:04:05") != "2020-02-03 04:05:06" {
+					t.Errorf("unexpected time range: %v - %v", q.StartTime, q.EndTime)
+				}
+				if q.Order != "nonce asc" || q.Limit != 10 || q.Offset != 10 {
+					t.Errorf("unexpected pagination, got: %s %d %d", q.Order, q.Limit, q.Offset)
+				}
+			},
+		},
+		{description: "rejects invalid public key", key: "invalid", expectStatus: nethttp.StatusBadRequest},
+		{description: "rejects invalid height", key: urlKey, query: "start_height=abc", expectStatus: nethttp.StatusBadRequest},
+		{description: "rejects invalid time format", key: urlKey, query: "start_time=2020-01-02", expectStatus: nethttp.StatusBadRequest},
+		{description: "rejects invalid sort", key: urlKey, query: "sort=fee", expectStatus: nethttp.StatusBadRequest},
+		{description: "rejects limit above max", key: urlKey, query: "limit=1001", expectStatus: nethttp.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			dbMock := mock.NewMockTransactionSeqStore(ctrl)
+			if tt.expectQuery != nil {
+				dbMock.EXPECT().FindByPublicKey(stdKey, gomock.Any()).DoAndReturn(func(_ string, q store.FindTransactionsByPublicKeyQuery) ([]model.TransactionSeq, int64, error) {
+					tt.expectQuery(t, q)
+					return nil, 0, nil
+				}).Times(1)
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(nethttp.MethodGet, "/transactions/"+tt.key+"?"+tt.query, nil)
+			c.Params = gin.Params{{Key: "public_key", Value: tt.key}}
+
+			NewGetByPublicKeyHttpHandler(&store.Store{TransactionSeq: dbMock}, nil).Handle(c)
+
+			if w.Code != tt.expectStatus {
+				t.Errorf("unexpected status, want: %d; got: %d; body: %s", tt.expectStatus, w.Code, w.Body.String())
+			}
+		})
+	}
+}
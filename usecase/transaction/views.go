@@ -2,7 +2,14 @@ package transaction
 
 import (
 	"github.com/figment-networks/oasis-rpc-proxy/grpc/transaction/transactionpb"
+	"github.com/figment-networks/oasishub-indexer/model"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	// SeqListSortFields are sortable columns of transaction sequences
+	SeqListSortFields = []string{"height", "nonce"}
 )
 
 type ListItem struct {
@@ -41,3 +48,46 @@ func ToListView(rawTransactions []*transactionpb.Transaction) *ListView {
 		Items: items,
 	}
 }
+
+type SeqListItem struct {
+	Height    int64          `json:"height"`
+	Time      types.Time     `json:"time"`
+	PublicKey string         `json:"public_key"`
+	Hash      string         `json:"hash"`
+	Nonce     uint64         `json:"nonce"`
+	Fee       types.Quantity `json:"fee"`
+	GasLimit  uint64         `json:"gas_limit"`
+	GasPrice  types.Quantity `json:"gas_price"`
+	Method    string         `json:"method"`
+}
+
+type SeqListView struct {
+	Items []SeqListItem `json:"items"`
+
+	http.Pagination
+}
+
+func ToSeqListView(transactionSeqs []model.TransactionSeq, pageReq http.PageRequest, total int64) *SeqListView {
+	var items []SeqListItem
+	for _, m := range transactionSeqs {
+		item := SeqListItem{
+			Height:    m.Height,
+			Time:      m.Time,
+			PublicKey: m.PublicKey,
+			Hash:      m.Hash,
+			Nonce:     m.Nonce,
+			Fee:       m.Fee,
+			GasLimit:  m.GasLimit,
+			GasPrice:  m.GasPrice,
+			Method:    m.Method,
+		}
+
+		items = append(items, item)
+	}
+
+	return &SeqListView{
+		Items: items,
+
+		Pagination: http.NewPagination(pageReq, total),
+	}
+}
@@ -0,0 +1,29 @@
+package transfer
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+type getForAddressUseCase struct {
+	db *store.Store
+}
+
+func NewGetForAddressUseCase(db *store.Store) *getForAddressUseCase {
+	return &getForAddressUseCase{
+		db: db,
+	}
+}
+
+func (uc *getForAddressUseCase) Execute(address string, pageReq http.PageRequest, query store.FindTransferEventsByAddressQuery) (*ListView, error) {
+	query.Order = pageReq.OrderBy(pageReq.Sort)
+	query.Limit = pageReq.Limit
+	query.Offset = pageReq.Offset()
+
+	transfers, total, err := uc.db.TransferEventSeq.FindByAddress(address, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(transfers, pageReq, total), nil
+}
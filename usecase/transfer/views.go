@@ -0,0 +1,47 @@
+package transfer
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	// ListSortFields are sortable columns of transfer events
+	ListSortFields = []string{"height", "amount"}
+)
+
+type ListItem struct {
+	Height int64          `json:"height"`
+	Time   types.Time     `json:"time"`
+	From   string         `json:"from"`
+	To     string         `json:"to"`
+	Amount types.Quantity `json:"amount"`
+}
+
+type ListView struct {
+	Items []ListItem `json:"items"`
+
+	http.Pagination
+}
+
+func ToListView(transfers []model.TransferEventSeq, pageReq http.PageRequest, total int64) *ListView {
+	var items []ListItem
+	for _, m := range transfers {
+		item := ListItem{
+			Height: m.Height,
+			Time:   m.Time,
+			From:   m.FromAddress,
+			To:     m.ToAddress,
+			Amount: m.Amount,
+		}
+
+		items = append(items, item)
+	}
+
+	return &ListView{
+		Items: items,
+
+		Pagination: http.NewPagination(pageReq, total),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/indexer"
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 	"github.com/pkg/errors"
 )
 
@@ -24,7 +25,7 @@ func NewGetByHeightUseCase(cfg *config.Config, db *store.Store, client *client.C
 	}
 }
 
-func (uc *getByHeightUseCase) Execute(height *int64) (SeqListView, error) {
+func (uc *getByHeightUseCase) Execute(ctx context.Context, height *int64, pageReq http.PageRequest) (SeqListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -48,12 +49,11 @@ func (uc *getByHeightUseCase) Execute(height *int64) (SeqListView, error) {
 
 	seqs, err := uc.db.ValidatorSeq.FindByHeight(*height)
 	if len(seqs) == 0 || err != nil {
-		indexingPipeline, err := indexer.NewPipeline(uc.cfg, uc.db, uc.client)
+		indexingPipeline, err := indexer.NewPipeline(ctx, uc.cfg, uc.db, uc.client)
 		if err != nil {
 			return SeqListView{}, err
 		}
 
-		ctx := context.Background()
 		payload, err := indexingPipeline.Run(ctx, indexer.RunConfig{
 			Height:           *height,
 			DesiredTargetIDs: []int64{indexer.IndexTargetValidatorSequences},
@@ -66,5 +66,5 @@ func (uc *getByHeightUseCase) Execute(height *int64) (SeqListView, error) {
 		seqs = payload.NewValidatorSequences
 	}
 
-	return ToSeqListView(seqs, aggs), nil
+	return ToSeqListView(seqs, aggs, pageReq), nil
 }
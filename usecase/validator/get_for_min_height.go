@@ -2,6 +2,7 @@ package validator
 
 import (
 	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
 	"github.com/pkg/errors"
 )
 
@@ -15,7 +16,7 @@ func NewGetForMinHeightUseCase(db *store.Store) *getForMinHeightUseCase {
 	}
 }
 
-func (uc *getForMinHeightUseCase) Execute(height *int64) (*AggListView, error) {
+func (uc *getForMinHeightUseCase) Execute(height *int64, pageReq http.PageRequest) (*AggListView, error) {
 	// Get last indexed height
 	mostRecentSynced, err := uc.db.Syncables.FindMostRecent()
 	if err != nil {
@@ -37,7 +38,7 @@ func (uc *getForMinHeightUseCase) Execute(height *int64) (*AggListView, error) {
 		return nil, err
 	}
 
-	return ToAggListView(ms), nil
+	return ToAggListView(ms, pageReq), nil
 }
 
 
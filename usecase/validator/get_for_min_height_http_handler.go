@@ -29,6 +29,8 @@ func NewGetForMinHeightHttpHandler(db *store.Store, c *client.Client) *getForMin
 
 type GetForMinHeightRequest struct {
 	Height *int64 `uri:"height" binding:"required"`
+
+	http.PageRequest
 }
 
 func (h *getForMinHeightHttpHandler) Handle(c *gin.Context) {
@@ -37,8 +39,16 @@ func (h *getForMinHeightHttpHandler) Handle(c *gin.Context) {
 		http.BadRequest(c, errors.New("invalid request parameters"))
 		return
 	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid page, limit, sort or/and order"))
+		return
+	}
+	if err := req.PageRequest.ValidateOptional(AggListSortFields, "recent_voting_power", http.OrderDesc); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
 
-	resp, err := h.getUseCase().Execute(req.Height)
+	resp, err := h.getUseCase().Execute(req.Height, req.PageRequest)
 	if http.ShouldReturn(c, err) {
 		return
 	}
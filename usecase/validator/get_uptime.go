@@ -0,0 +1,82 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/pkg/errors"
+)
+
+const (
+	uptimeDefaultRange = 1000
+	uptimeMaxRange     = 10000
+)
+
+var (
+	// uptimeWindows are sizes (in blocks) of rolling windows ending at end height
+	uptimeWindows = []int64{100, 1000, 10000}
+
+	ErrInvalidHeightRange  = errors.New("start height must not be greater than end height")
+	ErrHeightRangeTooLarge = errors.Errorf("height range must not be greater than %d", uptimeMaxRange)
+)
+
+type getUptimeUseCase struct {
+	db *store.Store
+}
+
+func NewGetUptimeUseCase(db *store.Store) *getUptimeUseCase {
+	return &getUptimeUseCase{
+		db: db,
+	}
+}
+
+func (uc *getUptimeUseCase) Execute(address string, startHeight, endHeight *int64) (*UptimeView, error) {
+	if _, err := uc.db.ValidatorAgg.FindByAddress(address); err != nil {
+		return nil, err
+	}
+
+	end, err := uc.getEndHeight(endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	start := end - uptimeDefaultRange + 1
+	if startHeight != nil {
+		start = *startHeight
+	}
+	if start < 1 {
+		start = 1
+	}
+	if start > end {
+		return nil, ErrInvalidHeightRange
+	}
+	if end-start+1 > uptimeMaxRange {
+		return nil, ErrHeightRangeTooLarge
+	}
+
+	precommits, err := uc.db.ValidatorSeq.FindPrecommitsByAddress(address, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]UptimeWindow, len(uptimeWindows))
+	for i, size := range uptimeWindows {
+		row, err := uc.db.ValidatorSeq.FindUptimeByAddress(address, end-size+1, end)
+		if err != nil {
+			return nil, err
+		}
+		windows[i] = ToUptimeWindow(size, row)
+	}
+
+	return ToUptimeView(address, start, end, precommits, windows), nil
+}
+
+func (uc *getUptimeUseCase) getEndHeight(endHeight *int64) (int64, error) {
+	if endHeight != nil {
+		return *endHeight, nil
+	}
+
+	mostRecent, err := uc.db.ValidatorSeq.FindMostRecent()
+	if err != nil {
+		return 0, err
+	}
+	return mostRecent.Height, nil
+}
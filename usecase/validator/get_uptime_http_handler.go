@@ -0,0 +1,64 @@
+package validator
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getUptimeHttpHandler)(nil)
+)
+
+type getUptimeHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getUptimeUseCase
+}
+
+func NewGetUptimeHttpHandler(db *store.Store, c *client.Client) *getUptimeHttpHandler {
+	return &getUptimeHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type GetUptimeRequest struct {
+	Address     string `uri:"address" binding:"required"`
+	StartHeight *int64 `form:"start_height" binding:"-"`
+	EndHeight   *int64 `form:"end_height" binding:"-"`
+}
+
+func (h *getUptimeHttpHandler) Handle(c *gin.Context) {
+	var req GetUptimeRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid address"))
+		return
+	}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid start height or/and end height"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.Address, req.StartHeight, req.EndHeight)
+	if err == ErrInvalidHeightRange || err == ErrHeightRangeTooLarge {
+		http.BadRequest(c, err)
+		return
+	}
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getUptimeHttpHandler) getUseCase() *getUptimeUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetUptimeUseCase(h.db)
+	}
+	return h.useCase
+}
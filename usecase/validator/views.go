@@ -2,16 +2,45 @@ package validator
 
 import (
 	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
 	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+)
+
+var (
+	AggListSortFields = []string{"address", "recent_voting_power", "recent_active_escrow_balance", "recent_total_shares", "recent_commission", "recent_as_validator_height"}
+	SeqListSortFields = []string{"address", "voting_power", "active_escrow_balance", "total_shares", "commission", "rewards"}
 )
 
 type AggListView struct {
 	Items []model.ValidatorAgg `json:"items"`
+
+	http.Pagination
 }
 
-func ToAggListView(ms []model.ValidatorAgg) *AggListView {
+func ToAggListView(ms []model.ValidatorAgg, pageReq http.PageRequest) *AggListView {
+	pageReq.SortSlice(ms, func(i, j int) bool {
+		switch pageReq.Sort {
+		case "recent_voting_power":
+			return ms[i].RecentVotingPower < ms[j].RecentVotingPower
+		case "recent_active_escrow_balance":
+			return ms[i].RecentActiveEscrowBalance.Cmp(ms[j].RecentActiveEscrowBalance) < 0
+		case "recent_total_shares":
+			return ms[i].RecentTotalShares.Cmp(ms[j].RecentTotalShares) < 0
+		case "recent_commission":
+			return ms[i].RecentCommission.Cmp(ms[j].RecentCommission) < 0
+		case "recent_as_validator_height":
+			return ms[i].RecentAsValidatorHeight < ms[j].RecentAsValidatorHeight
+		default:
+			return ms[i].Address < ms[j].Address
+		}
+	})
+
+	start, end := pageReq.Bounds(len(ms))
 	return &AggListView{
-		Items: ms,
+		Items: ms[start:end],
+
+		Pagination: http.NewPagination(pageReq, int64(len(ms))),
 	}
 }
 
@@ -77,9 +106,11 @@ type SeqListItem struct {
 
 type SeqListView struct {
 	Items []SeqListItem `json:"items"`
+
+	http.Pagination
 }
 
-func ToSeqListView(validatorSeqs []model.ValidatorSeq, validatorAggs []model.ValidatorAgg) SeqListView {
+func ToSeqListView(validatorSeqs []model.ValidatorSeq, validatorAggs []model.ValidatorAgg, pageReq http.PageRequest) SeqListView {
 	nameLookup := make(map[string]string)
 	for _, agg := range validatorAggs {
 		nameLookup[agg.Address] = agg.EntityName
@@ -109,7 +140,95 @@ func ToSeqListView(validatorSeqs []model.ValidatorSeq, validatorAggs []model.Val
 		items = append(items, item)
 	}
 
+	pageReq.SortSlice(items, func(i, j int) bool {
+		switch pageReq.Sort {
+		case "voting_power":
+			return items[i].VotingPower < items[j].VotingPower
+		case "active_escrow_balance":
+			return items[i].ActiveEscrowBalance.Cmp(items[j].ActiveEscrowBalance) < 0
+		case "total_shares":
+			return items[i].TotalShares.Cmp(items[j].TotalShares) < 0
+		case "commission":
+			return items[i].Commission.Cmp(items[j].Commission) < 0
+		case "rewards":
+			return items[i].Rewards.Cmp(items[j].Rewards) < 0
+		default:
+			return items[i].Address < items[j].Address
+		}
+	})
+
+	start, end := pageReq.Bounds(len(items))
 	return SeqListView{
-		Items: items,
+		Items: items[start:end],
+
+		Pagination: http.NewPagination(pageReq, int64(len(items))),
+	}
+}
+
+type UptimeView struct {
+	Address     string `json:"address"`
+	StartHeight int64  `json:"start_height"`
+	EndHeight   int64  `json:"end_height"`
+	Signed      int64  `json:"signed"`
+	Missed      int64  `json:"missed"`
+
+	// Runs are consecutive heights with the same precommit status, heights without data break the run
+	Runs    []UptimeRun    `json:"runs"`
+	Windows []UptimeWindow `json:"windows"`
+}
+
+type UptimeRun struct {
+	StartHeight int64 `json:"start_height"`
+	EndHeight   int64 `json:"end_height"`
+	Signed      bool  `json:"signed"`
+}
+
+type UptimeWindow struct {
+	Blocks int64    `json:"blocks"`
+	Count  int64    `json:"count"`
+	Uptime *float64 `json:"uptime"`
+}
+
+func ToUptimeView(address string, startHeight, endHeight int64, precommits []store.PrecommitRow, windows []UptimeWindow) *UptimeView {
+	view := &UptimeView{
+		Address:     address,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Runs:        []UptimeRun{},
+		Windows:     windows,
+	}
+
+	for _, precommit := range precommits {
+		if precommit.PrecommitValidated {
+			view.Signed++
+		} else {
+			view.Missed++
+		}
+
+		last := len(view.Runs) - 1
+		if last >= 0 && view.Runs[last].Signed == precommit.PrecommitValidated && view.Runs[last].EndHeight+1 == precommit.Height {
+			view.Runs[last].EndHeight = precommit.Height
+			continue
+		}
+
+		view.Runs = append(view.Runs, UptimeRun{
+			StartHeight: precommit.Height,
+			EndHeight:   precommit.Height,
+			Signed:      precommit.PrecommitValidated,
+		})
+	}
+
+	return view
+}
+
+func ToUptimeWindow(blocks int64, row *store.UptimeRow) UptimeWindow {
+	window := UptimeWindow{
+		Blocks: blocks,
+		Count:  row.Count,
+	}
+	if row.Count > 0 {
+		uptime := float64(row.Validated) / float64(row.Count)
+		window.Uptime = &uptime
 	}
+	return window
 }
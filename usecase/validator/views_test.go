@@ -0,0 +1,87 @@
+package validator_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/usecase/validator"
+)
+
+func TestToUptimeView(t *testing.T) {
+	tests := []struct {
+		description  string
+		precommits   []store.PrecommitRow
+		expectRuns   []validator.UptimeRun
+		expectSigned int64
+		expectMissed int64
+	}{
+		{description: "returns empty runs when there are no precommits",
+			precommits:   nil,
+			expectRuns:   []validator.UptimeRun{},
+			expectSigned: 0,
+			expectMissed: 0,
+		},
+		{description: "merges consecutive heights with same status",
+			precommits: []store.PrecommitRow{
+				{Height: 10, PrecommitValidated: true},
+				{Height: 11, PrecommitValidated: true},
+				{Height: 12, PrecommitValidated: false},
+				{Height: 13, PrecommitValidated: false},
+				{Height: 14, PrecommitValidated: true},
+			},
+			expectRuns: []validator.UptimeRun{
+				{StartHeight: 10, EndHeight: 11, Signed: true},
+				{StartHeight: 12, EndHeight: 13, Signed: false},
+				{StartHeight: 14, EndHeight: 14, Signed: true},
+			},
+			expectSigned: 3,
+			expectMissed: 2,
+		},
+		{description: "starts new run after gap in heights",
+			precommits: []store.PrecommitRow{
+				{Height: 10, PrecommitValidated: true},
+				{Height: 11, PrecommitValidated: true},
+				{Height: 15, PrecommitValidated: true},
+			},
+			expectRuns: []validator.UptimeRun{
+				{StartHeight: 10, EndHeight: 11, Signed: true},
+				{StartHeight: 15, EndHeight: 15, Signed: true},
+			},
+			expectSigned: 3,
+			expectMissed: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			view := validator.ToUptimeView("addr", 10, 20, tt.precommits, nil)
+
+			if !reflect.DeepEqual(view.Runs, tt.expectRuns) {
+				t.Errorf("unexpected runs, want %v; got %v", tt.expectRuns, view.Runs)
+			}
+			if view.Signed != tt.expectSigned {
+				t.Errorf("unexpected signed, want %d; got %d", tt.expectSigned, view.Signed)
+			}
+			if view.Missed != tt.expectMissed {
+				t.Errorf("unexpected missed, want %d; got %d", tt.expectMissed, view.Missed)
+			}
+		})
+	}
+}
+
+func TestToUptimeWindow(t *testing.T) {
+	window := validator.ToUptimeWindow(100, &store.UptimeRow{Count: 0, Validated: 0})
+	if window.Uptime != nil {
+		t.Errorf("expected nil uptime for empty window; got %v", *window.Uptime)
+	}
+
+	window = validator.ToUptimeWindow(100, &store.UptimeRow{Count: 80, Validated: 60})
+	if window.Uptime == nil || *window.Uptime != 0.75 {
+		t.Errorf("unexpected uptime, want 0.75; got %v", window.Uptime)
+	}
+	if window.Blocks != 100 || window.Count != 80 {
+		t.Errorf("unexpected window, want blocks=100 count=80; got blocks=%d count=%d", window.Blocks, window.Count)
+	}
+}
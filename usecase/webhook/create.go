@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type createUseCase struct {
+	db *store.Store
+}
+
+func NewCreateUseCase(db *store.Store) *createUseCase {
+	return &createUseCase{
+		db: db,
+	}
+}
+
+func (uc *createUseCase) Execute(subscription *model.WebhookSubscription) (*DetailsView, error) {
+	// Only events created after subscription are delivered
+	mostRecent, err := uc.db.SystemEvents.FindMostRecent()
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+	if err == nil {
+		subscription.LastSystemEventID = int64(mostRecent.ID)
+	}
+
+	if err := uc.db.WebhookSubscriptions.Create(subscription); err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(subscription), nil
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*createHttpHandler)(nil)
+)
+
+type createHttpHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *createUseCase
+}
+
+func NewCreateHttpHandler(cfg *config.Config, db *store.Store, c *client.Client) *createHttpHandler {
+	return &createHttpHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+type SubscriptionRequest struct {
+	URL    string                 `json:"url" binding:"required,url"`
+	Actor  *string                `json:"actor" binding:"-"`
+	Kind   *model.SystemEventKind `json:"kind" binding:"-"`
+	Secret string                 `json:"secret" binding:"-"`
+}
+
+func (h *createHttpHandler) Handle(c *gin.Context) {
+	var req SubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Secret == "" {
+		http.BadRequest(c, errors.New("invalid url or/and secret"))
+		return
+	}
+	if err := ValidateURL(req.URL, h.cfg.WebhookAllowPrivateHosts); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(&model.WebhookSubscription{
+		URL:    req.URL,
+		Actor:  req.Actor,
+		Kind:   req.Kind,
+		Secret: req.Secret,
+	})
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *createHttpHandler) getUseCase() *createUseCase {
+	if h.useCase == nil {
+		h.useCase = NewCreateUseCase(h.db)
+	}
+	return h.useCase
+}
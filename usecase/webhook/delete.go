@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type deleteUseCase struct {
+	db *store.Store
+}
+
+func NewDeleteUseCase(db *store.Store) *deleteUseCase {
+	return &deleteUseCase{
+		db: db,
+	}
+}
+
+func (uc *deleteUseCase) Execute(id int64) error {
+	return uc.db.WebhookSubscriptions.DeleteByID(id)
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*deleteHttpHandler)(nil)
+)
+
+type deleteHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *deleteUseCase
+}
+
+func NewDeleteHttpHandler(db *store.Store, c *client.Client) *deleteHttpHandler {
+	return &deleteHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type DeleteResponse struct {
+	Deleted bool `json:"deleted"`
+}
+
+func (h *deleteHttpHandler) Handle(c *gin.Context) {
+	var req IDRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	err := h.getUseCase().Execute(req.ID)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, DeleteResponse{Deleted: true})
+}
+
+func (h *deleteHttpHandler) getUseCase() *deleteUseCase {
+	if h.useCase == nil {
+		h.useCase = NewDeleteUseCase(h.db)
+	}
+	return h.useCase
+}
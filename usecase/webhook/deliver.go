@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+type deliverUseCase struct {
+	cfg *config.Config
+	db  *store.Store
+
+	sender     *Sender
+	enqueueLag time.Duration
+}
+
+func NewDeliverUseCase(cfg *config.Config, db *store.Store) (*deliverUseCase, error) {
+	timeout, err := time.ParseDuration(cfg.WebhookTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	enqueueLag, err := time.ParseDuration(cfg.WebhookEnqueueLag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliverUseCase{
+		cfg: cfg,
+		db:  db,
+
+		sender:     NewSender(timeout, cfg.WebhookAllowPrivateHosts),
+		enqueueLag: enqueueLag,
+	}, nil
+}
+
+func (uc *deliverUseCase) Execute(ctx context.Context) error {
+	if err := uc.enqueue(); err != nil {
+		return err
+	}
+
+	return uc.deliver(ctx)
+}
+
+// enqueue creates pending deliveries for system events created since last run.
+// Ids are assigned on insert, not on commit, so events younger than enqueue lag are left for next run,
+// otherwise cursor could move past event with lower id which is not committed yet.
+func (uc *deliverUseCase) enqueue() error {
+	subscriptions, err := uc.db.WebhookSubscriptions.FindAll()
+	if err != nil {
+		return err
+	}
+
+	createdBefore := time.Now().Add(-uc.enqueueLag)
+	for _, subscription := range subscriptions {
+		systemEvents, err := uc.db.SystemEvents.FindAfterID(subscription.LastSystemEventID, store.FindSystemEventAfterIDQuery{
+			Actor:         subscription.Actor,
+			Kind:          subscription.Kind,
+			CreatedBefore: &createdBefore,
+			Limit:         uc.cfg.WebhookBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(systemEvents) == 0 {
+			continue
+		}
+
+		deliveries := make([]model.WebhookDelivery, len(systemEvents))
+		for i, systemEvent := range systemEvents {
+			deliveries[i] = model.WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				SystemEventID:  systemEvent.ID,
+				Status:         model.WebhookDeliveryStatusPending,
+				NextAttemptAt:  *types.NewTimeFromTime(time.Now()),
+			}
+		}
+
+		lastSystemEventID := int64(systemEvents[len(systemEvents)-1].ID)
+		err = uc.db.WebhookDeliveries.Enqueue(subscription.ID, subscription.LastSystemEventID, deliveries, lastSystemEventID)
+		if err == store.ErrNotFound {
+			logger.Info(fmt.Sprintf("webhook subscription changed while enqueuing, skipping [subscription=%d]", subscription.ID))
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver attempts all pending deliveries which are due
+func (uc *deliverUseCase) deliver(ctx context.Context) error {
+	backoff, err := time.ParseDuration(uc.cfg.WebhookBackoff)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := uc.db.WebhookDeliveries.FindDue(time.Now(), uc.cfg.WebhookBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		body, err := json.Marshal(ToEventPayload(delivery.SystemEvent))
+		if err != nil {
+			return err
+		}
+
+		if err := uc.sender.Send(ctx, delivery.Subscription.URL, delivery.Subscription.Secret, body); err != nil {
+			logger.Info(fmt.Sprintf("webhook delivery failed [delivery=%d] [attempt=%d] [err=%s]", delivery.ID, delivery.Attempts+1, err))
+			delivery.Failed(err, backoff, uc.cfg.WebhookMaxAttempts)
+		} else {
+			delivery.Delivered()
+		}
+
+		if err := uc.db.WebhookDeliveries.UpdateAttempt(&delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
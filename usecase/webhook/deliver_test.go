@@ -0,0 +1,329 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	mock "github.com/figment-networks/oasishub-indexer/mock/store"
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/golang/mock/gomock"
+)
+
+var (
+	errTestDb = errors.New("errTestDb")
+)
+
+func newTestDeliverUseCase(t *testing.T, db *store.Store) *deliverUseCase {
+	cfg := &config.Config{
+		WebhookBatchSize:         10,
+		WebhookMaxAttempts:       3,
+		WebhookBackoff:           "30s",
+		WebhookTimeout:           "1s",
+		WebhookAllowPrivateHosts: true,
+		WebhookEnqueueLag:        "1m",
+	}
+	uc, err := NewDeliverUseCase(cfg, db)
+	if err != nil {
+		t.Fatalf("could not create use case: %v", err)
+	}
+	return uc
+}
+
+func testSubscription(id int64, lastSystemEventID int64) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		Model:             &model.Model{ID: types.ID(id)},
+		URL:               "http://receiver",
+		Secret:            "secret",
+		LastSystemEventID: lastSystemEventID,
+	}
+}
+
+func testSystemEvent(id int64) model.SystemEvent {
+	return model.SystemEvent{
+		Model: &model.Model{ID: types.ID(id)},
+		Kind:  model.SystemEventJoinedActiveSet,
+	}
+}
+
+func TestDeliverUseCase_Enqueue(t *testing.T) {
+	t.Run("skips subscription without new system events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionsMock := mock.NewMockWebhookSubscriptionsStore(ctrl)
+		deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		subscriptionsMock.EXPECT().FindAll().Return([]model.WebhookSubscription{testSubscription(1, 5)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(5), gomock.Any()).Return(nil, nil)
+		deliveriesMock.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		uc := newTestDeliverUseCase(t, &store.Store{
+			WebhookSubscriptions: subscriptionsMock,
+			WebhookDeliveries:    deliveriesMock,
+			SystemEvents:         systemEventsMock,
+		})
+
+		if err := uc.enqueue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("finds only system events older than enqueue lag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionsMock := mock.NewMockWebhookSubscriptionsStore(ctrl)
+		deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		before := time.Now()
+		subscriptionsMock.EXPECT().FindAll().Return([]model.WebhookSubscription{testSubscription(1, 5)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(5), gomock.Any()).
+			DoAndReturn(func(_ int64, query store.FindSystemEventAfterIDQuery) ([]model.SystemEvent, error) {
+				if query.CreatedBefore == nil || query.CreatedBefore.After(before.Add(-time.Minute+time.Second)) || query.CreatedBefore.Before(before.Add(-time.Minute)) {
+					t.Errorf("system events should be created before enqueue lag, got: %v", query.CreatedBefore)
+				}
+				return nil, nil
+			})
+
+		uc := newTestDeliverUseCase(t, &store.Store{
+			WebhookSubscriptions: subscriptionsMock,
+			WebhookDeliveries:    deliveriesMock,
+			SystemEvents:         systemEventsMock,
+		})
+
+		if err := uc.enqueue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("creates deliveries and advances cursor to last system event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionsMock := mock.NewMockWebhookSubscriptionsStore(ctrl)
+		deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		subscriptionsMock.EXPECT().FindAll().Return([]model.WebhookSubscription{testSubscription(1, 5)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(5), gomock.Any()).Return([]model.SystemEvent{testSystemEvent(6), testSystemEvent(9)}, nil)
+		deliveriesMock.EXPECT().Enqueue(types.ID(1), int64(5), gomock.Any(), int64(9)).
+			DoAndReturn(func(_ types.ID, _ int64, deliveries []model.WebhookDelivery, _ int64) error {
+				var ids []types.ID
+				for _, delivery := range deliveries {
+					if delivery.SubscriptionID != 1 || delivery.Status != model.WebhookDeliveryStatusPending {
+						t.Errorf("unexpected delivery: %+v", delivery)
+					}
+					ids = append(ids, delivery.SystemEventID)
+				}
+				if !reflect.DeepEqual(ids, []types.ID{6, 9}) {
+					t.Errorf("unexpected system event ids, want: %v; got: %v", []types.ID{6, 9}, ids)
+				}
+				return nil
+			})
+
+		uc := newTestDeliverUseCase(t, &store.Store{
+			WebhookSubscriptions: subscriptionsMock,
+			WebhookDeliveries:    deliveriesMock,
+			SystemEvents:         systemEventsMock,
+		})
+
+		if err := uc.enqueue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("skips subscription changed concurrently and continues with next one", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionsMock := mock.NewMockWebhookSubscriptionsStore(ctrl)
+		deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		subscriptionsMock.EXPECT().FindAll().Return([]model.WebhookSubscription{testSubscription(1, 5), testSubscription(2, 7)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(5), gomock.Any()).Return([]model.SystemEvent{testSystemEvent(8)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(7), gomock.Any()).Return([]model.SystemEvent{testSystemEvent(8)}, nil)
+		deliveriesMock.EXPECT().Enqueue(types.ID(1), int64(5), gomock.Any(), int64(8)).Return(store.ErrNotFound)
+		deliveriesMock.EXPECT().Enqueue(types.ID(2), int64(7), gomock.Any(), int64(8)).Return(nil)
+
+		uc := newTestDeliverUseCase(t, &store.Store{
+			WebhookSubscriptions: subscriptionsMock,
+			WebhookDeliveries:    deliveriesMock,
+			SystemEvents:         systemEventsMock,
+		})
+
+		if err := uc.enqueue(); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("returns error when enqueue fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		subscriptionsMock := mock.NewMockWebhookSubscriptionsStore(ctrl)
+		deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+		systemEventsMock := mock.NewMockSystemEventsStore(ctrl)
+
+		subscriptionsMock.EXPECT().FindAll().Return([]model.WebhookSubscription{testSubscription(1, 5)}, nil)
+		systemEventsMock.EXPECT().FindAfterID(int64(5), gomock.Any()).Return([]model.SystemEvent{testSystemEvent(6)}, nil)
+		deliveriesMock.EXPECT().Enqueue(types.ID(1), int64(5), gomock.Any(), int64(6)).Return(errTestDb)
+
+		uc := newTestDeliverUseCase(t, &store.Store{
+			WebhookSubscriptions: subscriptionsMock,
+			WebhookDeliveries:    deliveriesMock,
+			SystemEvents:         systemEventsMock,
+		})
+
+		if err := uc.enqueue(); err != errTestDb {
+			t.Errorf("want: %v; got: %v", errTestDb, err)
+		}
+	})
+}
+
+func TestDeliverUseCase_Deliver(t *testing.T) {
+	tests := []struct {
+		description     string
+		receiverStatus  int
+		attempts        int64
+		expectStatus    model.WebhookDeliveryStatus
+		expectAttempts  int64
+		expectNextDelay time.Duration
+		expectLastError bool
+	}{
+		{description: "marks delivery as delivered",
+			receiverStatus: http.StatusOK,
+			attempts:       0,
+			expectStatus:   model.WebhookDeliveryStatusDelivered,
+			expectAttempts: 1,
+		},
+		{description: "marks retried delivery as delivered",
+			receiverStatus: http.StatusOK,
+			attempts:       2,
+			expectStatus:   model.WebhookDeliveryStatusDelivered,
+			expectAttempts: 3,
+		},
+		{description: "schedules first retry after backoff",
+			receiverStatus:  http.StatusInternalServerError,
+			attempts:        0,
+			expectStatus:    model.WebhookDeliveryStatusPending,
+			expectAttempts:  1,
+			expectNextDelay: 30 * time.Second,
+			expectLastError: true,
+		},
+		{description: "doubles backoff for next retry",
+			receiverStatus:  http.StatusInternalServerError,
+			attempts:        1,
+			expectStatus:    model.WebhookDeliveryStatusPending,
+			expectAttempts:  2,
+			expectNextDelay: 60 * time.Second,
+			expectLastError: true,
+		},
+		{description: "moves delivery to dead state after max attempts",
+			receiverStatus:  http.StatusInternalServerError,
+			attempts:        2,
+			expectStatus:    model.WebhookDeliveryStatusDead,
+			expectAttempts:  3,
+			expectLastError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.receiverStatus)
+			}))
+			defer receiver.Close()
+
+			subscription := testSubscription(1, 0)
+			subscription.URL = receiver.URL
+			systemEvent := testSystemEvent(6)
+			delivery := model.WebhookDelivery{
+				Model:          &model.Model{ID: 3},
+				SubscriptionID: subscription.ID,
+				SystemEventID:  systemEvent.ID,
+				Status:         model.WebhookDeliveryStatusPending,
+				Attempts:       tt.attempts,
+				Subscription:   &subscription,
+				SystemEvent:    &systemEvent,
+			}
+
+			deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+			deliveriesMock.EXPECT().FindDue(gomock.Any(), int64(10)).Return([]model.WebhookDelivery{delivery}, nil)
+
+			var got *model.WebhookDelivery
+			deliveriesMock.EXPECT().UpdateAttempt(gomock.Any()).DoAndReturn(func(d *model.WebhookDelivery) error {
+				got = d
+				return nil
+			})
+
+			uc := newTestDeliverUseCase(t, &store.Store{WebhookDeliveries: deliveriesMock})
+
+			before := time.Now()
+			if err := uc.deliver(context.Background()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+
+			if got.Status != tt.expectStatus {
+				t.Errorf("unexpected status, want: %s; got: %s", tt.expectStatus, got.Status)
+			}
+			if got.Attempts != tt.expectAttempts {
+				t.Errorf("unexpected attempts, want: %d; got: %d", tt.expectAttempts, got.Attempts)
+			}
+			if (got.LastError != nil) != tt.expectLastError {
+				t.Errorf("unexpected last error, want set: %v; got: %v", tt.expectLastError, got.LastError)
+			}
+			if (got.DeliveredAt != nil) != (tt.expectStatus == model.WebhookDeliveryStatusDelivered) {
+				t.Errorf("unexpected delivered at: %v", got.DeliveredAt)
+			}
+			if tt.expectNextDelay > 0 {
+				delay := got.NextAttemptAt.Sub(before)
+				if delay < tt.expectNextDelay || delay > tt.expectNextDelay+5*time.Second {
+					t.Errorf("unexpected next attempt delay, want: %s; got: %s", tt.expectNextDelay, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestDeliverUseCase_DeliverUpdateError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer receiver.Close()
+
+	subscription := testSubscription(1, 0)
+	subscription.URL = receiver.URL
+	systemEvent := testSystemEvent(6)
+
+	deliveriesMock := mock.NewMockWebhookDeliveriesStore(ctrl)
+	deliveriesMock.EXPECT().FindDue(gomock.Any(), gomock.Any()).Return([]model.WebhookDelivery{{
+		Model:        &model.Model{ID: 3},
+		Status:       model.WebhookDeliveryStatusPending,
+		Subscription: &subscription,
+		SystemEvent:  &systemEvent,
+	}}, nil)
+	deliveriesMock.EXPECT().UpdateAttempt(gomock.Any()).Return(errTestDb)
+
+	uc := newTestDeliverUseCase(t, &store.Store{WebhookDeliveries: deliveriesMock})
+
+	if err := uc.deliver(context.Background()); err != errTestDb {
+		t.Errorf("want: %v; got: %v", errTestDb, err)
+	}
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+)
+
+var (
+	_ types.WorkerHandler = (*deliverWorkerHandler)(nil)
+)
+
+type deliverWorkerHandler struct {
+	cfg *config.Config
+	db  *store.Store
+
+	useCase *deliverUseCase
+}
+
+func NewDeliverWorkerHandler(cfg *config.Config, db *store.Store) *deliverWorkerHandler {
+	return &deliverWorkerHandler{
+		cfg: cfg,
+		db:  db,
+	}
+}
+
+func (h *deliverWorkerHandler) Handle(ctx context.Context) {
+
+	logger.Info("running webhook delivery use case [handler=worker]")
+
+	useCase, err := h.getUseCase()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	if err := useCase.Execute(ctx); err != nil {
+		logger.Error(err)
+		return
+	}
+}
+
+func (h *deliverWorkerHandler) getUseCase() (*deliverUseCase, error) {
+	if h.useCase == nil {
+		useCase, err := NewDeliverUseCase(h.cfg, h.db)
+		if err != nil {
+			return nil, err
+		}
+		h.useCase = useCase
+	}
+	return h.useCase, nil
+}
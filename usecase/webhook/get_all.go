@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type getAllUseCase struct {
+	db *store.Store
+}
+
+func NewGetAllUseCase(db *store.Store) *getAllUseCase {
+	return &getAllUseCase{
+		db: db,
+	}
+}
+
+func (uc *getAllUseCase) Execute() (*ListView, error) {
+	subscriptions, err := uc.db.WebhookSubscriptions.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	return ToListView(subscriptions), nil
+}
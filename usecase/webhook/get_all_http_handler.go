@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	_ types.HttpHandler = (*getAllHttpHandler)(nil)
+)
+
+type getAllHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getAllUseCase
+}
+
+func NewGetAllHttpHandler(db *store.Store, c *client.Client) *getAllHttpHandler {
+	return &getAllHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *getAllHttpHandler) Handle(c *gin.Context) {
+	resp, err := h.getUseCase().Execute()
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getAllHttpHandler) getUseCase() *getAllUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetAllUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type getByIDUseCase struct {
+	db *store.Store
+}
+
+func NewGetByIDUseCase(db *store.Store) *getByIDUseCase {
+	return &getByIDUseCase{
+		db: db,
+	}
+}
+
+func (uc *getByIDUseCase) Execute(id int64) (*DetailsView, error) {
+	subscription, err := uc.db.WebhookSubscriptions.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(subscription), nil
+}
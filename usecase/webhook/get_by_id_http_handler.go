@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*getByIDHttpHandler)(nil)
+)
+
+type getByIDHttpHandler struct {
+	db     *store.Store
+	client *client.Client
+
+	useCase *getByIDUseCase
+}
+
+func NewGetByIDHttpHandler(db *store.Store, c *client.Client) *getByIDHttpHandler {
+	return &getByIDHttpHandler{
+		db:     db,
+		client: c,
+	}
+}
+
+type IDRequest struct {
+	ID int64 `uri:"id" binding:"required"`
+}
+
+func (h *getByIDHttpHandler) Handle(c *gin.Context) {
+	var req IDRequest
+	if err := c.ShouldBindUri(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(req.ID)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *getByIDHttpHandler) getUseCase() *getByIDUseCase {
+	if h.useCase == nil {
+		h.useCase = NewGetByIDUseCase(h.db)
+	}
+	return h.useCase
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Oasishub-Signature"
+)
+
+// Sender posts signed webhook payloads to subscribers
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender creates sender. Unless allowPrivateHosts is set, requests to private, loopback
+// and link-local addresses are refused.
+func NewSender(timeout time.Duration, allowPrivateHosts bool) *Sender {
+	client := &http.Client{Timeout: timeout}
+	if !allowPrivateHosts {
+		dialer := &net.Dialer{Timeout: timeout, Control: publicOnlyControl}
+		client.Transport = &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+		}
+	}
+
+	return &Sender{
+		client: client,
+	}
+}
+
+// Send posts body to url with HMAC-SHA256 signature of body computed using secret
+func (s *Sender) Send(ctx context.Context, url string, secret string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(secret, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook receiver responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns hex encoded HMAC-SHA256 signature of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,97 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
+)
+
+func TestSender_Send(t *testing.T) {
+	secret := "test-secret"
+	body := []byte(`{"id":1,"kind":"joined_active_set"}`)
+
+	tests := []struct {
+		description    string
+		receiverStatus int
+		expectErr      bool
+	}{
+		{"delivers signed payload", http.StatusOK, false},
+		{"accepts any 2xx response", http.StatusNoContent, false},
+		{"returns error when receiver fails", http.StatusInternalServerError, true},
+		{"returns error when receiver rejects payload", http.StatusBadRequest, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			t.Parallel()
+
+			var gotBody []byte
+			var gotSignature string
+			receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotBody, _ = ioutil.ReadAll(r.Body)
+				gotSignature = r.Header.Get(webhook.SignatureHeader)
+				w.WriteHeader(tt.receiverStatus)
+			}))
+			defer receiver.Close()
+
+			sender := webhook.NewSender(time.Second, true)
+
+			err := sender.Send(context.Background(), receiver.URL, secret, body)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("unexpected error, want error: %v; got: %v", tt.expectErr, err)
+				return
+			}
+
+			if string(gotBody) != string(body) {
+				t.Errorf("unexpected body, want: %s; got: %s", body, gotBody)
+			}
+
+			if gotSignature != webhook.Sign(secret, body) {
+				t.Errorf("unexpected signature, want: %s; got: %s", webhook.Sign(secret, body), gotSignature)
+			}
+		})
+	}
+}
+
+func TestSender_SendUnreachable(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	receiver.Close()
+
+	sender := webhook.NewSender(time.Second, true)
+
+	if err := sender.Send(context.Background(), receiver.URL, "secret", []byte("{}")); err == nil {
+		t.Error("expected error when receiver is unreachable")
+	}
+}
+
+func TestSender_SendPrivateHost(t *testing.T) {
+	called := false
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	sender := webhook.NewSender(time.Second, false)
+
+	if err := sender.Send(context.Background(), receiver.URL, "secret", []byte("{}")); err == nil {
+		t.Error("expected error when receiver is on loopback address")
+	}
+	if called {
+		t.Error("expected request to loopback address not to be sent")
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n '{}' | openssl dgst -sha256 -hmac secret
+	want := "sha256=77325902caca812dc259733aacd046b73817372c777b8d95b402647474516e13"
+
+	if got := webhook.Sign("secret", []byte("{}")); got != want {
+		t.Errorf("want: %s; got: %s", want, got)
+	}
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/store"
+)
+
+type updateUseCase struct {
+	db *store.Store
+}
+
+func NewUpdateUseCase(db *store.Store) *updateUseCase {
+	return &updateUseCase{
+		db: db,
+	}
+}
+
+func (uc *updateUseCase) Execute(id int64, url string, actor *string, kind *model.SystemEventKind, secret string) (*DetailsView, error) {
+	subscription, err := uc.db.WebhookSubscriptions.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.URL = url
+	subscription.Actor = actor
+	subscription.Kind = kind
+
+	// Keep existing secret when new one is not provided
+	if secret != "" {
+		subscription.Secret = secret
+	}
+
+	if err := uc.db.WebhookSubscriptions.UpdateSettings(subscription); err != nil {
+		return nil, err
+	}
+
+	return ToDetailsView(subscription), nil
+}
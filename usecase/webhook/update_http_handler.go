@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/client"
+	"github.com/figment-networks/oasishub-indexer/config"
+	"github.com/figment-networks/oasishub-indexer/store"
+	"github.com/figment-networks/oasishub-indexer/types"
+	"github.com/figment-networks/oasishub-indexer/usecase/http"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+var (
+	_ types.HttpHandler = (*updateHttpHandler)(nil)
+)
+
+type updateHttpHandler struct {
+	cfg    *config.Config
+	db     *store.Store
+	client *client.Client
+
+	useCase *updateUseCase
+}
+
+func NewUpdateHttpHandler(cfg *config.Config, db *store.Store, c *client.Client) *updateHttpHandler {
+	return &updateHttpHandler{
+		cfg:    cfg,
+		db:     db,
+		client: c,
+	}
+}
+
+func (h *updateHttpHandler) Handle(c *gin.Context) {
+	var uri IDRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		http.BadRequest(c, errors.New("invalid id"))
+		return
+	}
+
+	var req SubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http.BadRequest(c, errors.New("invalid url"))
+		return
+	}
+	if err := ValidateURL(req.URL, h.cfg.WebhookAllowPrivateHosts); err != nil {
+		http.BadRequest(c, err)
+		return
+	}
+
+	resp, err := h.getUseCase().Execute(uri.ID, req.URL, req.Actor, req.Kind, req.Secret)
+	if http.ShouldReturn(c, err) {
+		return
+	}
+
+	http.JsonOK(c, resp)
+}
+
+func (h *updateHttpHandler) getUseCase() *updateUseCase {
+	if h.useCase == nil {
+		h.useCase = NewUpdateUseCase(h.db)
+	}
+	return h.useCase
+}
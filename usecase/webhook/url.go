@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"net"
+	"net/url"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidURL  = errors.New("webhook url must be absolute http or https url")
+	ErrPrivateHost = errors.New("webhook url must point to public host")
+
+	// privateNetworks are ranges not reachable from public internet, webhooks must not target them
+	// so that API can not be used to make worker send requests to internal services
+	privateNetworks = mustParseCIDRs(
+		"0.0.0.0/8",
+		"10.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"172.16.0.0/12",
+		"192.0.0.0/24",
+		"192.168.0.0/16",
+		"198.18.0.0/15",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	)
+)
+
+// ValidateURL returns an error if url is not http(s) url or, unless allowPrivateHosts is set,
+// when its host resolves to private, loopback or link-local address
+func ValidateURL(rawURL string, allowPrivateHosts bool) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+
+	if allowPrivateHosts {
+		return nil
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return errors.Wrap(err, "could not resolve webhook host")
+	}
+	for _, ip := range ips {
+		if !isPublicIP(ip) {
+			return ErrPrivateHost
+		}
+	}
+	return nil
+}
+
+// publicOnlyControl rejects connections to non public addresses. It runs after host name is resolved
+// so it also covers DNS records changed after subscription was validated and redirects.
+func publicOnlyControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return ErrPrivateHost
+	}
+	return nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsUnspecified() || ip.IsLoopback() || ip.IsMulticast() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return false
+	}
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
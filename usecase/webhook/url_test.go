@@ -0,0 +1,36 @@
+package webhook_test
+
+import (
+	"testing"
+
+	"github.com/figment-networks/oasishub-indexer/usecase/webhook"
+)
+
+func TestValidateURL(t *testing.T) {
+	tests := []struct {
+		description       string
+		url               string
+		allowPrivateHosts bool
+		expectErr         error
+	}{
+		{"accepts public address", "https://8.8.8.8/hook", false, nil},
+		{"rejects non http scheme", "ftp://8.8.8.8/hook", false, webhook.ErrInvalidURL},
+		{"rejects relative url", "/hook", false, webhook.ErrInvalidURL},
+		{"rejects loopback address", "http://127.0.0.1:8080/hook", false, webhook.ErrPrivateHost},
+		{"rejects ipv6 loopback address", "http://[::1]/hook", false, webhook.ErrPrivateHost},
+		{"rejects private address", "http://10.0.0.5/hook", false, webhook.ErrPrivateHost},
+		{"rejects link-local address", "http://169.254.169.254/latest/meta-data", false, webhook.ErrPrivateHost},
+		{"rejects unspecified address", "http://0.0.0.0/hook", false, webhook.ErrPrivateHost},
+		{"accepts private address when allowed", "http://127.0.0.1:8080/hook", true, nil},
+		{"rejects non http scheme when private hosts allowed", "file:///etc/passwd", true, webhook.ErrInvalidURL},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			if err := webhook.ValidateURL(tt.url, tt.allowPrivateHosts); err != tt.expectErr {
+				t.Errorf("want: %v; got: %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"github.com/figment-networks/oasishub-indexer/model"
+	"github.com/figment-networks/oasishub-indexer/types"
+)
+
+type DetailsView struct {
+	*model.Model
+
+	URL   string                 `json:"url"`
+	Actor *string                `json:"actor"`
+	Kind  *model.SystemEventKind `json:"kind"`
+}
+
+func ToDetailsView(m *model.WebhookSubscription) *DetailsView {
+	return &DetailsView{
+		Model: m.Model,
+
+		URL:   m.URL,
+		Actor: m.Actor,
+		Kind:  m.Kind,
+	}
+}
+
+type ListView struct {
+	Items []DetailsView `json:"items"`
+}
+
+func ToListView(ms []model.WebhookSubscription) *ListView {
+	items := make([]DetailsView, len(ms))
+	for i := range ms {
+		items[i] = *ToDetailsView(&ms[i])
+	}
+
+	return &ListView{
+		Items: items,
+	}
+}
+
+// EventPayload is a body of webhook request
+type EventPayload struct {
+	ID     types.ID    `json:"id"`
+	Height int64       `json:"height"`
+	Time   types.Time  `json:"time"`
+	Actor  string      `json:"actor"`
+	Kind   string      `json:"kind"`
+	Data   types.Jsonb `json:"data"`
+}
+
+func ToEventPayload(m *model.SystemEvent) *EventPayload {
+	return &EventPayload{
+		ID:     m.ID,
+		Height: m.Height,
+		Time:   m.Time,
+		Actor:  m.Actor,
+		Kind:   m.Kind.String(),
+		Data:   m.Data,
+	}
+}
@@ -1,21 +1,44 @@
 package worker
 
-import "github.com/robfig/cron/v3"
+import (
+	"context"
+
+	"github.com/robfig/cron/v3"
+)
 
 func (w *Worker) addIndexerIndexJob() (cron.EntryID, error) {
-	job = cron.FuncJob(w.handlers.IndexerIndex.Handle)
+	job = w.leader.leaderOnly(w.handlers.IndexerIndex.Handle)
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.IndexWorkerInterval, job)
 }
 
 func (w *Worker) addIndexerSummarizeJob() (cron.EntryID, error) {
-	job = cron.FuncJob(w.handlers.IndexerSummarize.Handle)
+	job = w.leader.leaderOnly(w.handlers.IndexerSummarize.Handle)
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.SummarizeWorkerInterval, job)
 }
 
 func (w *Worker) addIndexerPurgeJob() (cron.EntryID, error) {
-	job = cron.FuncJob(w.handlers.IndexerPurge.Handle)
+	job = w.leader.leaderOnly(w.handlers.IndexerPurge.Handle)
 	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
 	return w.cronJob.AddJob(w.cfg.PurgeWorkerInterval, job)
 }
+
+func (w *Worker) addIndexerVerifyJob() (cron.EntryID, error) {
+	job = w.leader.leaderOnly(w.handlers.IndexerVerify.Handle)
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.VerifyWorkerInterval, job)
+}
+
+// addIndexerReindexJob runs on every replica, distributed reindex jobs are claimed by workers independently
+func (w *Worker) addIndexerReindexJob() (cron.EntryID, error) {
+	job = cron.FuncJob(func() { w.handlers.IndexerReindex.Handle(context.Background()) })
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.ReindexWorkerInterval, job)
+}
+
+func (w *Worker) addWebhookDeliverJob() (cron.EntryID, error) {
+	job = w.leader.leaderOnly(w.handlers.WebhookDeliver.Handle)
+	job = cron.NewChain(cron.SkipIfStillRunning(w.logger)).Then(job)
+	return w.cronJob.AddJob(w.cfg.WebhookWorkerInterval, job)
+}
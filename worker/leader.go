@@ -0,0 +1,131 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/figment-networks/oasishub-indexer/utils/logger"
+	"github.com/robfig/cron/v3"
+)
+
+// Lock is a distributed lock held by at most one worker replica at a time
+type Lock interface {
+	TryAcquire(context.Context) (bool, error)
+	Check(context.Context) error
+	Release(context.Context) error
+}
+
+// leader elects worker replica running leader only jobs. Every replica periodically tries to acquire the lock,
+// the one holding it is the leader until its lock connection drops.
+// Leader only jobs run with leadership context which is cancelled as soon as leadership is lost.
+type leader struct {
+	lock     Lock
+	interval time.Duration
+
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newLeader(lock Lock, interval time.Duration) *leader {
+	workerLeader.Set(0)
+
+	return &leader{
+		lock:     lock,
+		interval: interval,
+	}
+}
+
+// IsLeader returns true when replica currently holds leadership
+func (l *leader) IsLeader() bool {
+	_, ok := l.context()
+	return ok
+}
+
+// context returns leadership context, it is valid only while replica holds leadership
+func (l *leader) context() (context.Context, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.ctx == nil {
+		return nil, false
+	}
+	return l.ctx, true
+}
+
+// Run checks leadership at interval until context is done, leadership is given up on exit
+func (l *leader) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+
+	for {
+		l.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			if err := l.lock.Release(context.Background()); err != nil {
+				logger.Error(err)
+			}
+			l.setLeader(false)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *leader) tick(ctx context.Context) {
+	checkCtx, cancel := context.WithTimeout(ctx, l.interval)
+	defer cancel()
+
+	if l.IsLeader() {
+		if err := l.lock.Check(checkCtx); err != nil {
+			logger.Warn(fmt.Sprintf("lost worker leadership [err=%s]", err), logger.Field("app", "worker"))
+			l.setLeader(false)
+		}
+		return
+	}
+
+	acquired, err := l.lock.TryAcquire(checkCtx)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	if acquired {
+		logger.Info("acquired worker leadership", logger.Field("app", "worker"))
+		l.setLeader(true)
+	}
+}
+
+// setLeader creates leadership context when leadership is acquired and cancels it when leadership is lost
+func (l *leader) setLeader(isLeader bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if isLeader {
+		if l.ctx == nil {
+			l.ctx, l.cancel = context.WithCancel(context.Background())
+		}
+		workerLeader.Set(1)
+		return
+	}
+
+	if l.cancel != nil {
+		l.cancel()
+	}
+	l.ctx, l.cancel = nil, nil
+	workerLeader.Set(0)
+}
+
+// leaderOnly skips job on replicas which are not the leader, job context is cancelled when leadership is lost
+func (l *leader) leaderOnly(handle func(context.Context)) cron.Job {
+	return cron.FuncJob(func() {
+		ctx, ok := l.context()
+		if !ok {
+			logger.Debug("skipping leader only job on standby worker", logger.Field("app", "worker"))
+			return
+		}
+		handle(ctx)
+	})
+}
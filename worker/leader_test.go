@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var (
+	errTestLock = errors.New("errTestLock")
+)
+
+type fakeLock struct {
+	acquired   bool
+	acquireErr error
+	checkErr   error
+
+	acquireCalls int
+	checkCalls   int
+}
+
+func (l *fakeLock) TryAcquire(context.Context) (bool, error) {
+	l.acquireCalls++
+	return l.acquired, l.acquireErr
+}
+
+func (l *fakeLock) Check(context.Context) error {
+	l.checkCalls++
+	return l.checkErr
+}
+
+func (l *fakeLock) Release(context.Context) error {
+	return nil
+}
+
+func TestLeader_tick(t *testing.T) {
+	tests := []struct {
+		description        string
+		lock               *fakeLock
+		isLeader           bool
+		expectLeader       bool
+		expectAcquireCalls int
+		expectCheckCalls   int
+	}{
+		{description: "acquires leadership when lock is acquired", lock: &fakeLock{acquired: true}, expectLeader: true, expectAcquireCalls: 1},
+		{description: "stays standby when lock is held by other replica", lock: &fakeLock{acquired: false}, expectAcquireCalls: 1},
+		{description: "stays standby when lock cannot be acquired", lock: &fakeLock{acquireErr: errTestLock}, expectAcquireCalls: 1},
+		{description: "keeps leadership when lock check passes", lock: &fakeLock{}, isLeader: true, expectLeader: true, expectCheckCalls: 1},
+		{description: "loses leadership when lock check fails", lock: &fakeLock{checkErr: errTestLock}, isLeader: true, expectCheckCalls: 1},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			l := newLeader(tt.lock, time.Second)
+			l.setLeader(tt.isLeader)
+
+			l.tick(context.Background())
+
+			if got := l.IsLeader(); got != tt.expectLeader {
+				t.Errorf("unexpected leadership, want: %v; got: %v", tt.expectLeader, got)
+			}
+			if tt.lock.acquireCalls != tt.expectAcquireCalls {
+				t.Errorf("unexpected TryAcquire calls, want: %d; got: %d", tt.expectAcquireCalls, tt.lock.acquireCalls)
+			}
+			if tt.lock.checkCalls != tt.expectCheckCalls {
+				t.Errorf("unexpected Check calls, want: %d; got: %d", tt.expectCheckCalls, tt.lock.checkCalls)
+			}
+		})
+	}
+}
+
+func TestLeader_leaderOnly(t *testing.T) {
+	t.Run("skips job on standby replica", func(t *testing.T) {
+		l := newLeader(&fakeLock{}, time.Second)
+
+		var runs int
+		l.leaderOnly(func(context.Context) { runs++ }).Run()
+
+		if runs != 0 {
+			t.Errorf("job should not run on standby replica, got runs: %d", runs)
+		}
+	})
+
+	t.Run("runs job on leader", func(t *testing.T) {
+		l := newLeader(&fakeLock{acquired: true}, time.Second)
+		l.tick(context.Background())
+
+		var runs int
+		l.leaderOnly(func(ctx context.Context) {
+			runs++
+			if ctx.Err() != nil {
+				t.Errorf("job context should not be done while replica is the leader")
+			}
+		}).Run()
+
+		if runs != 1 {
+			t.Errorf("job should run once on leader, got runs: %d", runs)
+		}
+	})
+
+	t.Run("cancels running job when leadership is lost", func(t *testing.T) {
+		lock := &fakeLock{acquired: true}
+		l := newLeader(lock, time.Second)
+		l.tick(context.Background())
+
+		var jobErr error
+		l.leaderOnly(func(ctx context.Context) {
+			lock.checkErr = errTestLock
+			l.tick(context.Background())
+
+			select {
+			case <-ctx.Done():
+				jobErr = ctx.Err()
+			case <-time.After(time.Second):
+			}
+		}).Run()
+
+		if jobErr != context.Canceled {
+			t.Errorf("job context should be cancelled when leadership is lost, got: %v", jobErr)
+		}
+		if l.IsLeader() {
+			t.Errorf("replica should not be the leader after lock check failed")
+		}
+	})
+
+	t.Run("runs job with new context after leadership is acquired again", func(t *testing.T) {
+		lock := &fakeLock{acquired: true}
+		l := newLeader(lock, time.Second)
+		l.tick(context.Background())
+
+		var lostCtx context.Context
+		l.leaderOnly(func(ctx context.Context) { lostCtx = ctx }).Run()
+
+		lock.checkErr = errTestLock
+		l.tick(context.Background())
+		l.tick(context.Background())
+
+		l.leaderOnly(func(ctx context.Context) {
+			if ctx == lostCtx || ctx.Err() != nil {
+				t.Errorf("job should run with new leadership context")
+			}
+		}).Run()
+
+		if lostCtx.Err() == nil {
+			t.Errorf("previous leadership context should be cancelled")
+		}
+	})
+}
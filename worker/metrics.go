@@ -0,0 +1,14 @@
+package worker
+
+import (
+	"github.com/figment-networks/indexing-engine/metrics"
+)
+
+var (
+	workerLeader = metrics.MustNewGaugeWithTags(metrics.Options{
+		Namespace: "indexers",
+		Subsystem: "oasishub_worker",
+		Name:      "leader",
+		Desc:      "Whether worker replica is the leader running index, summarize, purge, verify and webhook jobs (1) or stands by (0)",
+	}).WithLabels()
+)
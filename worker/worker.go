@@ -1,6 +1,9 @@
 package worker
 
 import (
+	"context"
+	"time"
+
 	"github.com/figment-networks/oasishub-indexer/config"
 	"github.com/figment-networks/oasishub-indexer/usecase"
 	"github.com/figment-networks/oasishub-indexer/utils/logger"
@@ -15,12 +18,18 @@ var (
 type Worker struct {
 	cfg      *config.Config
 	handlers *usecase.WorkerHandlers
+	leader   *leader
 
 	logger  logger.CronLogger
 	cronJob *cron.Cron
 }
 
-func New(cfg *config.Config, handlers *usecase.WorkerHandlers) (*Worker, error) {
+func New(cfg *config.Config, handlers *usecase.WorkerHandlers, lock Lock) (*Worker, error) {
+	leaderCheckInterval, err := time.ParseDuration(cfg.WorkerLeaderCheckInterval)
+	if err != nil {
+		return nil, err
+	}
+
 	log := logger.NewCronLogger()
 	cronJob := cron.New(
 		cron.WithLogger(cron.VerbosePrintfLogger(log)),
@@ -32,6 +41,7 @@ func New(cfg *config.Config, handlers *usecase.WorkerHandlers) (*Worker, error)
 	w := &Worker{
 		cfg:      cfg,
 		handlers: handlers,
+		leader:   newLeader(lock, leaderCheckInterval),
 		logger:   log,
 		cronJob:  cronJob,
 	}
@@ -55,6 +65,21 @@ func (w *Worker) init() (*Worker, error) {
 		return nil, err
 	}
 
+	_, err = w.addIndexerVerifyJob()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.addIndexerReindexJob()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.addWebhookDeliverJob()
+	if err != nil {
+		return nil, err
+	}
+
 	return w, nil
 }
 
@@ -63,6 +88,8 @@ func (w *Worker) Start() error {
 
 	logger.Info("starting worker...", logger.Field("app", "worker"))
 
+	go w.leader.Run(context.Background())
+
 	w.cronJob.Start()
 
 	return nil